@@ -12,8 +12,10 @@ import (
 	"github.com/rs/cors"
 	"github.com/strideynet/bsky-furry-feed/bfflog"
 	"github.com/strideynet/bsky-furry-feed/feed"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
 	"github.com/strideynet/bsky-furry-feed/proto/bff/v1/bffv1pbconnect"
 	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/worker"
 )
 
 func handleErr(w http.ResponseWriter, log *slog.Logger, err error) {
@@ -39,6 +41,7 @@ func jsonHandler(log *slog.Logger, h func(r *http.Request) (any, error)) http.Ha
 type feedService interface {
 	Metas() []feed.Meta
 	GetFeedPosts(ctx context.Context, feedKey string, cursor string, limit int) (posts []feed.Post, err error)
+	ExplainPost(ctx context.Context, feedKey string, uri string) (*v1.ExplainPostResponse, error)
 }
 
 func New(
@@ -50,6 +53,7 @@ func New(
 	pgxStore *store.PGXStore,
 	pdsHost string,
 	authEngine *AuthEngine,
+	followLister worker.RecordLister,
 ) (*http.Server, error) {
 	mux := &http.ServeMux{}
 
@@ -75,12 +79,18 @@ func New(
 	mux.Handle(didEndpointPath, didHandler)
 	mux.Handle(getFeedSkeletonHandler(log, feedService))
 	mux.Handle(describeFeedGeneratorHandler(log, hostname, feedService))
+	mux.Handle(queryLabelsHandler(log, pgxStore))
+	labelBroadcaster := newLabelBroadcaster(log, pgxStore)
+	go labelBroadcaster.run(ctx)
+	mux.Handle(subscribeLabelsHandler(log, pgxStore, labelBroadcaster))
 
 	// Mount Buf Connect services
 	modSvcHandler := &ModerationServiceHandler{
-		store:      pgxStore,
-		log:        log,
-		authEngine: authEngine,
+		store:        pgxStore,
+		log:          log,
+		authEngine:   authEngine,
+		followLister: followLister,
+		feedService:  feedService,
 	}
 	interceptors := connect.WithInterceptors(
 		unaryLoggingInterceptor(log),
@@ -13,6 +13,7 @@ import (
 	indigoTest "github.com/bluesky-social/indigo/testing"
 	"github.com/stretchr/testify/require"
 	"github.com/strideynet/bsky-furry-feed/feed"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
 	"github.com/strideynet/bsky-furry-feed/testenv"
 )
 
@@ -38,6 +39,10 @@ func (m *fakeFeedService) GetFeedPosts(ctx context.Context, feedKey string, curs
 	return nil, fmt.Errorf("unimplemented")
 }
 
+func (m *fakeFeedService) ExplainPost(ctx context.Context, feedKey string, uri string) (*v1.ExplainPostResponse, error) {
+	return nil, fmt.Errorf("unimplemented")
+}
+
 type apiHarness struct {
 	*testenv.Harness
 	APIAddr string
@@ -71,6 +76,7 @@ func startAPIHarness(ctx context.Context, t *testing.T) *apiHarness {
 			TokenValidator: BSkyTokenValidator(harness.PDS.HTTPHost()),
 			ActorGetter:    harness.Store,
 		},
+		nil,
 	)
 	require.NoError(t, err)
 	t.Cleanup(func() {
@@ -69,17 +69,32 @@ var approverPermissions = []string{
 	"/bff.v1.ModerationService/CreateCommentAuditEvent",
 	"/bff.v1.ModerationService/HoldBackPendingActor",
 	"/bff.v1.ModerationService/ListRoles",
+	"/bff.v1.ModerationService/RefreshActorProfile",
+	"/bff.v1.ModerationService/ExplainPost",
 }
 
 var moderatorPermissions = append([]string{
 	"/bff.v1.ModerationService/UnapproveActor",
 	"/bff.v1.ModerationService/ForceApproveActor",
+	"/bff.v1.ModerationService/GetFollowReconciliationReport",
+	"/bff.v1.ModerationService/ListTasks",
+	"/bff.v1.ModerationService/GetTask",
+	"/bff.v1.ModerationService/RetryTask",
+	"/bff.v1.ModerationService/CancelTask",
+	"/bff.v1.ModerationService/ListDeadLetterEvents",
+	"/bff.v1.ModerationService/GetDeadLetterEvent",
+	"/bff.v1.ModerationService/ReplayDeadLetterEvent",
+	"/bff.v1.ModerationService/DiscardDeadLetterEvent",
+	"/bff.v1.ModerationService/ListScoringFlags",
+	"/bff.v1.ModerationService/SetActorNSFWOverride",
 }, approverPermissions...)
 
 var adminPermissions = append([]string{
 	"/bff.v1.ModerationService/BanActor",
 	"/bff.v1.ModerationService/CreateActor",
 	"/bff.v1.ModerationService/AssignRoles",
+	"/bff.v1.ModerationService/PurgeActor",
+	"/bff.v1.ModerationService/RestoreActor",
 }, moderatorPermissions...)
 
 var roleToPermissions = map[string][]string{
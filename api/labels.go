@@ -0,0 +1,373 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/events"
+	"github.com/gorilla/websocket"
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/labeler"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+type labelStore interface {
+	ListLabels(ctx context.Context, opts store.ListLabelsOpts) ([]gen.Label, error)
+	GetLatestLabelSeq(ctx context.Context) (int64, error)
+}
+
+type queryLabelsParams struct {
+	uriPatterns []string
+	sources     []string
+	limit       int
+	cursor      int64
+}
+
+func parseQueryLabelsParams(u *url.URL) (*queryLabelsParams, error) {
+	q := u.Query()
+	params := queryLabelsParams{
+		uriPatterns: q["uriPatterns"],
+		sources:     q["sources"],
+		limit:       50, // Default value
+	}
+	if len(params.uriPatterns) == 0 {
+		return nil, fmt.Errorf("no uriPatterns specified")
+	}
+
+	limitStr := q.Get("limit")
+	if limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert 'limit' param to integer: %w", err)
+		}
+		if limit < 1 {
+			return nil, fmt.Errorf("limit too low (%d)", limit)
+		}
+		if limit > 250 {
+			return nil, fmt.Errorf("limit too high (%d)", limit)
+		}
+		params.limit = limit
+	}
+
+	cursorStr := q.Get("cursor")
+	if cursorStr != "" {
+		cursor, err := strconv.ParseInt(cursorStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert 'cursor' param to integer: %w", err)
+		}
+		params.cursor = cursor
+	}
+
+	return &params, nil
+}
+
+func queryLabelsHandler(
+	log *slog.Logger, labelStore labelStore,
+) (string, http.Handler) {
+	h := jsonHandler(log, func(r *http.Request) (any, error) {
+		params, err := parseQueryLabelsParams(r.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := labelStore.ListLabels(r.Context(), store.ListLabelsOpts{
+			AfterSeq:    params.cursor,
+			URIPatterns: params.uriPatterns,
+			Sources:     params.sources,
+			Limit:       params.limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing labels: %w", err)
+		}
+
+		output := atproto.LabelQueryLabels_Output{
+			Labels: []*atproto.LabelDefs_Label{},
+		}
+		for _, row := range rows {
+			output.Labels = append(output.Labels, labeler.ToLexicon(row))
+		}
+		// Only return a cursor if there may be more labels to fetch.
+		if len(rows) == params.limit {
+			cursor := strconv.FormatInt(rows[len(rows)-1].Seq, 10)
+			output.Cursor = &cursor
+		}
+
+		return output, nil
+	})
+	return "/xrpc/com.atproto.label.queryLabels", otelhttp.NewHandler(h, "query_labels")
+}
+
+const (
+	subscribeLabelsPollInterval = 1 * time.Second
+	subscribeLabelsBatchSize    = 250
+	// subscribeLabelsBufferSize is how many batches a subscriber may fall
+	// behind the broadcaster before it is disconnected.
+	subscribeLabelsBufferSize = 64
+)
+
+var labelsUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// labelBroadcaster polls Postgres for new labels on behalf of every
+// subscribeLabels connection and fans them out, so that the number of
+// subscribers doesn't multiply the load on the database.
+type labelBroadcaster struct {
+	log        *slog.Logger
+	labelStore labelStore
+
+	mu   sync.Mutex
+	seq  int64
+	subs map[chan []gen.Label]struct{}
+}
+
+func newLabelBroadcaster(log *slog.Logger, labelStore labelStore) *labelBroadcaster {
+	return &labelBroadcaster{
+		log:        log,
+		labelStore: labelStore,
+		subs:       map[chan []gen.Label]struct{}{},
+	}
+}
+
+// run polls for labels until ctx is cancelled.
+func (b *labelBroadcaster) run(ctx context.Context) {
+	for {
+		seq, err := b.labelStore.GetLatestLabelSeq(ctx)
+		if err == nil {
+			b.mu.Lock()
+			b.seq = seq
+			b.mu.Unlock()
+			break
+		}
+		b.log.Error("failed to fetch latest label seq", bfflog.Err(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(subscribeLabelsPollInterval):
+		}
+	}
+
+	t := time.NewTicker(subscribeLabelsPollInterval)
+	defer t.Stop()
+	for {
+		if err := b.poll(ctx); err != nil && ctx.Err() == nil {
+			b.log.Error("failed to poll for labels", bfflog.Err(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (b *labelBroadcaster) poll(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		seq := b.seq
+		b.mu.Unlock()
+
+		rows, err := b.labelStore.ListLabels(ctx, store.ListLabelsOpts{
+			AfterSeq: seq,
+			Limit:    subscribeLabelsBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("listing labels: %w", err)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		b.broadcast(rows)
+		// If we received a full batch, there's likely more waiting for us.
+		if len(rows) < subscribeLabelsBatchSize {
+			return nil
+		}
+	}
+}
+
+func (b *labelBroadcaster) broadcast(rows []gen.Label) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq = rows[len(rows)-1].Seq
+	for ch := range b.subs {
+		select {
+		case ch <- rows:
+		default:
+			// The subscriber isn't keeping up, so drop it rather than
+			// holding up everyone else.
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe returns a channel that receives every label broadcast after seq.
+// The channel is closed if the subscriber falls too far behind.
+func (b *labelBroadcaster) subscribe() (ch <-chan []gen.Label, seq int64, unsubscribe func()) {
+	c := make(chan []gen.Label, subscribeLabelsBufferSize)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[c] = struct{}{}
+	return c, b.seq, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subs[c]; ok {
+			delete(b.subs, c)
+			close(c)
+		}
+	}
+}
+
+// subscribeLabelsHandler implements com.atproto.label.subscribeLabels.
+// Subscribers backfill from Postgres using their cursor and then receive new
+// labels from the shared labelBroadcaster.
+func subscribeLabelsHandler(
+	log *slog.Logger, labelStore labelStore, broadcaster *labelBroadcaster,
+) (string, http.Handler) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var cursor *int64
+		if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+			c, err := strconv.ParseInt(cursorStr, 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("failed to convert 'cursor' param to integer"))
+				return
+			}
+			cursor = &c
+		}
+
+		conn, err := labelsUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.Error("failed to upgrade subscribeLabels connection", bfflog.Err(err))
+			return
+		}
+		defer conn.Close()
+
+		// Detect the client going away by reading until an error occurs.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			defer cancel()
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		if err := streamLabels(ctx, conn, labelStore, broadcaster, cursor); err != nil {
+			if ctx.Err() == nil {
+				log.Error("subscribeLabels stream failed", bfflog.Err(err))
+			}
+		}
+	})
+	return "/xrpc/com.atproto.label.subscribeLabels", otelhttp.NewHandler(h, "subscribe_labels")
+}
+
+func streamLabels(
+	ctx context.Context,
+	conn *websocket.Conn,
+	labelStore labelStore,
+	broadcaster *labelBroadcaster,
+	cursor *int64,
+) error {
+	// Subscribe before backfilling so that no labels are missed between the
+	// end of the backfill and the first broadcast.
+	ch, seq, unsubscribe := broadcaster.subscribe()
+	defer unsubscribe()
+
+	if cursor != nil {
+		latest, err := labelStore.GetLatestLabelSeq(ctx)
+		if err != nil {
+			return fmt.Errorf("fetching latest label seq: %w", err)
+		}
+		if *cursor > latest {
+			return writeLabelsErrorFrame(conn, "FutureCursor", "cursor is ahead of the latest label")
+		}
+
+		// Backfill until we've caught up with the broadcaster.
+		seq = *cursor
+		for {
+			rows, err := labelStore.ListLabels(ctx, store.ListLabelsOpts{
+				AfterSeq: seq,
+				Limit:    subscribeLabelsBatchSize,
+			})
+			if err != nil {
+				return fmt.Errorf("listing labels: %w", err)
+			}
+			for _, row := range rows {
+				if err := writeLabelsFrame(conn, row); err != nil {
+					return fmt.Errorf("writing labels frame: %w", err)
+				}
+				seq = row.Seq
+			}
+			if len(rows) < subscribeLabelsBatchSize {
+				break
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case rows, ok := <-ch:
+			if !ok {
+				return writeLabelsErrorFrame(conn, "ConsumerTooSlow", "subscriber fell too far behind")
+			}
+			for _, row := range rows {
+				// Skip anything we've already sent during the backfill.
+				if row.Seq <= seq {
+					continue
+				}
+				if err := writeLabelsFrame(conn, row); err != nil {
+					return fmt.Errorf("writing labels frame: %w", err)
+				}
+				seq = row.Seq
+			}
+		}
+	}
+}
+
+func writeLabelsFrame(conn *websocket.Conn, row gen.Label) error {
+	buf := &bytes.Buffer{}
+	header := events.EventHeader{Op: events.EvtKindMessage, MsgType: "#labels"}
+	if err := header.MarshalCBOR(buf); err != nil {
+		return fmt.Errorf("encoding header: %w", err)
+	}
+	body := atproto.LabelSubscribeLabels_Labels{
+		Seq:    row.Seq,
+		Labels: []*atproto.LabelDefs_Label{labeler.ToLexicon(row)},
+	}
+	if err := body.MarshalCBOR(buf); err != nil {
+		return fmt.Errorf("encoding body: %w", err)
+	}
+	return conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+}
+
+func writeLabelsErrorFrame(conn *websocket.Conn, errName string, message string) error {
+	buf := &bytes.Buffer{}
+	header := events.EventHeader{Op: events.EvtKindErrorFrame}
+	if err := header.MarshalCBOR(buf); err != nil {
+		return fmt.Errorf("encoding header: %w", err)
+	}
+	body := events.ErrorFrame{Error: errName, Message: message}
+	if err := body.MarshalCBOR(buf); err != nil {
+		return fmt.Errorf("encoding body: %w", err)
+	}
+	return conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/events"
+	"github.com/gorilla/websocket"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+)
+
+type fakeLabelStore struct {
+	mu     sync.Mutex
+	labels []gen.Label
+}
+
+func (f *fakeLabelStore) add(l gen.Label) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.labels = append(f.labels, l)
+}
+
+func (f *fakeLabelStore) ListLabels(_ context.Context, opts store.ListLabelsOpts) ([]gen.Label, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := []gen.Label{}
+	for _, l := range f.labels {
+		if l.Seq <= opts.AfterSeq {
+			continue
+		}
+		if len(opts.URIPatterns) > 0 {
+			matched := false
+			for _, p := range opts.URIPatterns {
+				if l.URI == p || (strings.HasSuffix(p, "*") && strings.HasPrefix(l.URI, strings.TrimSuffix(p, "*"))) {
+					matched = true
+				}
+			}
+			if !matched {
+				continue
+			}
+		}
+		out = append(out, l)
+		if len(out) == opts.Limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeLabelStore) GetLatestLabelSeq(_ context.Context) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.labels) == 0 {
+		return 0, nil
+	}
+	return f.labels[len(f.labels)-1].Seq, nil
+}
+
+func newFakeLabelStore() *fakeLabelStore {
+	cts := pgtype.Timestamptz{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	return &fakeLabelStore{
+		labels: []gen.Label{
+			{Seq: 1, Src: "did:plc:labeler", URI: "did:plc:alice", Val: "furryli-st-approved", Cts: cts, Sig: []byte("sig")},
+			{Seq: 2, Src: "did:plc:labeler", URI: "did:plc:bob", Val: "furryli-st-banned", Cts: cts, Sig: []byte("sig")},
+			{Seq: 3, Src: "did:plc:labeler", URI: "did:plc:alice", Val: "furryli-st-approved", Neg: true, Cts: cts, Sig: []byte("sig")},
+		},
+	}
+}
+
+func TestAPI_QueryLabels(t *testing.T) {
+	t.Parallel()
+
+	path, h := queryLabelsHandler(slog.Default(), newFakeLabelStore())
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + path + "?uriPatterns=did:plc:alice&limit=1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	out := atproto.LabelQueryLabels_Output{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	require.Len(t, out.Labels, 1)
+	assert.Equal(t, "did:plc:alice", out.Labels[0].Uri)
+	assert.Equal(t, "2024-01-01T00:00:00Z", out.Labels[0].Cts)
+	assert.Nil(t, out.Labels[0].Neg)
+	require.NotNil(t, out.Cursor)
+	assert.Equal(t, "1", *out.Cursor)
+
+	resp, err = http.Get(srv.URL + path + "?uriPatterns=did:plc:alice&limit=1&cursor=1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	out = atproto.LabelQueryLabels_Output{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	require.Len(t, out.Labels, 1)
+	require.NotNil(t, out.Labels[0].Neg)
+	assert.True(t, *out.Labels[0].Neg)
+}
+
+func TestAPI_SubscribeLabels(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	labelStore := newFakeLabelStore()
+	broadcaster := newLabelBroadcaster(slog.Default(), labelStore)
+	go broadcaster.run(ctx)
+
+	path, h := subscribeLabelsHandler(slog.Default(), labelStore, broadcaster)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	readLabels := func(t *testing.T, conn *websocket.Conn, wantSeqs ...int64) {
+		t.Helper()
+		for _, wantSeq := range wantSeqs {
+			require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+			_, msg, err := conn.ReadMessage()
+			require.NoError(t, err)
+			r := bytes.NewReader(msg)
+			header := events.EventHeader{}
+			require.NoError(t, header.UnmarshalCBOR(r))
+			assert.Equal(t, int64(events.EvtKindMessage), header.Op)
+			assert.Equal(t, "#labels", header.MsgType)
+			body := atproto.LabelSubscribeLabels_Labels{}
+			require.NoError(t, body.UnmarshalCBOR(r))
+			assert.Equal(t, wantSeq, body.Seq)
+			require.Len(t, body.Labels, 1)
+		}
+	}
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + path
+	t.Run("backfill from cursor then live", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL+"?cursor=1", nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		readLabels(t, conn, 2, 3)
+
+		labelStore.add(gen.Label{
+			Seq: 4, Src: "did:plc:labeler", URI: "did:plc:carol", Val: "furryli-st-approved",
+			Cts: pgtype.Timestamptz{Time: time.Now(), Valid: true}, Sig: []byte("sig"),
+		})
+		readLabels(t, conn, 4)
+	})
+	t.Run("future cursor", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL+"?cursor=100", nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, msg, err := conn.ReadMessage()
+		require.NoError(t, err)
+		r := bytes.NewReader(msg)
+		header := events.EventHeader{}
+		require.NoError(t, header.UnmarshalCBOR(r))
+		assert.Equal(t, int64(events.EvtKindErrorFrame), header.Op)
+		body := events.ErrorFrame{}
+		require.NoError(t, body.UnmarshalCBOR(r))
+		assert.Equal(t, "FutureCursor", body.Error)
+	})
+}
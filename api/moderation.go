@@ -2,24 +2,35 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"time"
 
 	"github.com/strideynet/bsky-furry-feed/bfflog"
 	"github.com/strideynet/bsky-furry-feed/bluesky"
+	"github.com/strideynet/bsky-furry-feed/feed"
 	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"connectrpc.com/connect"
 	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/scoring"
 	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/tristate"
+	"github.com/strideynet/bsky-furry-feed/worker"
 )
 
 type ModerationServiceHandler struct {
 	store      *store.PGXStore
 	log        *slog.Logger
 	authEngine *AuthEngine
+	// followLister lists the follows of the feed account. If nil, follow
+	// reconciliation reports are unavailable.
+	followLister worker.RecordLister
+	// feedService is used to explain why posts are or aren't in feeds.
+	feedService feedService
 }
 
 func (m *ModerationServiceHandler) BanActor(ctx context.Context, req *connect.Request[v1.BanActorRequest]) (*connect.Response[v1.BanActorResponse], error) {
@@ -64,6 +75,10 @@ func (m *ModerationServiceHandler) BanActor(ctx context.Context, req *connect.Re
 		return nil, fmt.Errorf("enqueuing unfollow: %w", err)
 	}
 
+	if err := tx.EnqueueRemoveFromLists(ctx, req.Msg.ActorDid); err != nil {
+		return nil, fmt.Errorf("enqueuing removal from lists: %w", err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("committing transaction: %w", err)
 	}
@@ -72,6 +87,99 @@ func (m *ModerationServiceHandler) BanActor(ctx context.Context, req *connect.Re
 	}), nil
 }
 
+func (m *ModerationServiceHandler) PurgeActor(ctx context.Context, req *connect.Request[v1.PurgeActorRequest]) (*connect.Response[v1.PurgeActorResponse], error) {
+	authCtx, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	switch {
+	case req.Msg.ActorDid == "":
+		return nil, fmt.Errorf("actor_did is required")
+	case req.Msg.Reason == "":
+		return nil, fmt.Errorf("reason is required")
+	}
+
+	tx, err := m.store.TX(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	actor, err := tx.DeleteActorData(ctx, req.Msg.ActorDid)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("actor does not exist or their data is already deleted"))
+		}
+		return nil, fmt.Errorf("deleting actor data: %w", err)
+	}
+
+	_, err = tx.CreateAuditEvent(ctx, store.CreateAuditEventOpts{
+		Payload: &v1.ActorDataDeletedAuditPayload{
+			Reason:  req.Msg.Reason,
+			PurgeAt: timestamppb.New(actor.DeletedAt.AsTime().Add(store.ActorPurgeGracePeriod)),
+		},
+		ActorDID:   authCtx.DID,
+		SubjectDID: req.Msg.ActorDid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating audit event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return connect.NewResponse(&v1.PurgeActorResponse{
+		Actor: actor,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) RestoreActor(ctx context.Context, req *connect.Request[v1.RestoreActorRequest]) (*connect.Response[v1.RestoreActorResponse], error) {
+	authCtx, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	switch {
+	case req.Msg.ActorDid == "":
+		return nil, fmt.Errorf("actor_did is required")
+	case req.Msg.Reason == "":
+		return nil, fmt.Errorf("reason is required")
+	}
+
+	tx, err := m.store.TX(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	actor, err := tx.RestoreActorData(ctx, req.Msg.ActorDid)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("actor does not exist, is not deleted or has been purged"))
+		}
+		return nil, fmt.Errorf("restoring actor data: %w", err)
+	}
+
+	_, err = tx.CreateAuditEvent(ctx, store.CreateAuditEventOpts{
+		Payload: &v1.ActorDataRestoredAuditPayload{
+			Reason: req.Msg.Reason,
+		},
+		ActorDID:   authCtx.DID,
+		SubjectDID: req.Msg.ActorDid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating audit event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return connect.NewResponse(&v1.RestoreActorResponse{
+		Actor: actor,
+	}), nil
+}
+
 func (m *ModerationServiceHandler) UnapproveActor(ctx context.Context, req *connect.Request[v1.UnapproveActorRequest]) (*connect.Response[v1.UnapproveActorResponse], error) {
 	authCtx, err := m.authEngine.auth(ctx, req)
 	if err != nil {
@@ -122,6 +230,10 @@ func (m *ModerationServiceHandler) UnapproveActor(ctx context.Context, req *conn
 		return nil, fmt.Errorf("enqueuing unfollow: %w", err)
 	}
 
+	if err := tx.EnqueueRemoveFromLists(ctx, req.Msg.ActorDid); err != nil {
+		return nil, fmt.Errorf("enqueuing removal from lists: %w", err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, fmt.Errorf("committing transaction: %w", err)
 	}
@@ -289,9 +401,14 @@ func (m *ModerationServiceHandler) GetActor(ctx context.Context, req *connect.Re
 	if err != nil {
 		return nil, fmt.Errorf("getting actor: %w", err)
 	}
+	handles, err := m.store.ListActorHandles(ctx, req.Msg.Did)
+	if err != nil {
+		return nil, fmt.Errorf("listing actor handles: %w", err)
+	}
 
 	res := connect.NewResponse(&v1.GetActorResponse{
-		Actor: actor,
+		Actor:         actor,
+		HandleHistory: handles,
 	})
 	return res, nil
 }
@@ -475,6 +592,21 @@ func (m *ModerationServiceHandler) updateProfileAndFollow(ctx context.Context, a
 		return fmt.Errorf("enqueing follow: %w", err)
 	}
 
+	if err := tx.EnqueueAddToLists(ctx, actorDID); err != nil {
+		return fmt.Errorf("enqueing addition to lists: %w", err)
+	}
+
+	// Posts made before approval were dropped by the ingester, so backfill
+	// them.
+	_, err := tx.EnqueueTask(ctx, store.EnqueueTaskOpts{
+		Type:      worker.TaskTypeBackfillActor,
+		Payload:   store.ActorTaskPayload{ActorDID: actorDID},
+		DedupeKey: worker.TaskTypeBackfillActor + ":" + actorDID,
+	})
+	if err != nil && !errors.Is(err, store.ErrTaskAlreadyPending) {
+		return fmt.Errorf("enqueing backfill: %w", err)
+	}
+
 	return nil
 }
 
@@ -559,3 +691,401 @@ func (m *ModerationServiceHandler) AssignRoles(ctx context.Context, req *connect
 
 	return connect.NewResponse(&v1.AssignRolesResponse{}), nil
 }
+
+func (m *ModerationServiceHandler) SetActorNSFWOverride(ctx context.Context, req *connect.Request[v1.SetActorNSFWOverrideRequest]) (*connect.Response[v1.SetActorNSFWOverrideResponse], error) {
+	authCtx, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	actorDID := req.Msg.ActorDid
+	if actorDID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("actor_did is required"))
+	}
+	var override tristate.Tristate
+	switch req.Msg.NsfwOverride {
+	case v1.ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_UNSPECIFIED:
+		override = tristate.Maybe
+	case v1.ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_NSFW:
+		override = tristate.True
+	case v1.ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_SFW:
+		override = tristate.False
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unrecognized nsfw_override %s", req.Msg.NsfwOverride))
+	}
+
+	tx, err := m.store.TX(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	actor, err := tx.GetActorByDID(ctx, actorDID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("actor %s not found", actorDID))
+		}
+		return nil, fmt.Errorf("fetching actor: %w", err)
+	}
+	if actor.NsfwOverride == req.Msg.NsfwOverride {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("nsfw_override is unchanged"))
+	}
+
+	updated, err := tx.SetActorNSFWOverride(ctx, actorDID, override)
+	if err != nil {
+		return nil, fmt.Errorf("setting nsfw override: %w", err)
+	}
+
+	_, err = tx.CreateAuditEvent(ctx, store.CreateAuditEventOpts{
+		Payload: &v1.SetActorNSFWOverrideAuditPayload{
+			NsfwOverrideBefore: actor.NsfwOverride,
+			NsfwOverrideAfter:  updated.NsfwOverride,
+		},
+		ActorDID:   authCtx.DID,
+		SubjectDID: actorDID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating audit event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return connect.NewResponse(&v1.SetActorNSFWOverrideResponse{
+		Actor: updated,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) GetFollowReconciliationReport(ctx context.Context, req *connect.Request[v1.GetFollowReconciliationReportRequest]) (*connect.Response[v1.GetFollowReconciliationReportResponse], error) {
+	_, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	if m.followLister == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("follow reconciliation is not configured"))
+	}
+
+	report, err := worker.ComputeFollowReconciliation(ctx, m.store, m.followLister)
+	if err != nil {
+		return nil, fmt.Errorf("computing follow reconciliation: %w", err)
+	}
+
+	return connect.NewResponse(&v1.GetFollowReconciliationReportResponse{
+		DidsToFollow:   report.ToFollow,
+		DidsToUnfollow: report.ToUnfollow,
+		UntrackedDids:  report.Untracked,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) ListTasks(ctx context.Context, req *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
+	_, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	var filterBeforeID int64
+	if req.Msg.Cursor != "" {
+		filterBeforeID, err = strconv.ParseInt(req.Msg.Cursor, 10, 64)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parsing cursor: %w", err))
+		}
+	}
+	if req.Msg.Limit > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be at most 1000"))
+	}
+
+	out, err := m.store.ListTasks(ctx, store.ListTasksOpts{
+		FilterState:    req.Msg.FilterState,
+		FilterActorDID: req.Msg.FilterActorDid,
+		FilterType:     req.Msg.FilterType,
+		FilterBeforeID: filterBeforeID,
+		Limit:          int32(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing tasks: %w", err)
+	}
+
+	newCursor := ""
+	if len(out) > 0 {
+		newCursor = strconv.FormatInt(out[len(out)-1].Id, 10)
+	}
+
+	return connect.NewResponse(&v1.ListTasksResponse{
+		Tasks:  out,
+		Cursor: newCursor,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) getTask(ctx context.Context, id int64) (*v1.Task, error) {
+	task, err := m.store.GetTask(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("task %d not found", id))
+		}
+		return nil, fmt.Errorf("getting task: %w", err)
+	}
+	return task, nil
+}
+
+func (m *ModerationServiceHandler) GetTask(ctx context.Context, req *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
+	_, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	task, err := m.getTask(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.GetTaskResponse{
+		Task: task,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) RetryTask(ctx context.Context, req *connect.Request[v1.RetryTaskRequest]) (*connect.Response[v1.RetryTaskResponse], error) {
+	authCtx, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	task, err := m.getTask(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	switch task.State {
+	case v1.TaskState_TASK_STATE_RUNNING, v1.TaskState_TASK_STATE_DONE:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task in state %s cannot be retried", task.State))
+	}
+
+	task, err = m.store.RetryTask(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			// The task changed state between fetching and retrying it.
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task can no longer be retried"))
+		}
+		if errors.Is(err, store.ErrTaskAlreadyPending) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return nil, fmt.Errorf("retrying task: %w", err)
+	}
+	m.log.Info("task retried", slog.Int64("task_id", task.Id), slog.String("moderator_did", authCtx.DID))
+
+	return connect.NewResponse(&v1.RetryTaskResponse{
+		Task: task,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) CancelTask(ctx context.Context, req *connect.Request[v1.CancelTaskRequest]) (*connect.Response[v1.CancelTaskResponse], error) {
+	authCtx, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	task, err := m.getTask(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if task.State != v1.TaskState_TASK_STATE_PENDING {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task in state %s cannot be cancelled", task.State))
+	}
+
+	task, err = m.store.CancelTask(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			// The task was claimed or finished between fetching and cancelling it.
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("task can no longer be cancelled"))
+		}
+		return nil, fmt.Errorf("cancelling task: %w", err)
+	}
+	m.log.Info("task cancelled", slog.Int64("task_id", task.Id), slog.String("moderator_did", authCtx.DID))
+
+	return connect.NewResponse(&v1.CancelTaskResponse{
+		Task: task,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) RefreshActorProfile(ctx context.Context, req *connect.Request[v1.RefreshActorProfileRequest]) (*connect.Response[v1.RefreshActorProfileResponse], error) {
+	_, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	if req.Msg.ActorDid == "" {
+		return nil, fmt.Errorf("actor_did is required")
+	}
+
+	if _, err := m.store.GetActorByDID(ctx, req.Msg.ActorDid); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("actor %s not found", req.Msg.ActorDid))
+		}
+		return nil, fmt.Errorf("getting actor: %w", err)
+	}
+
+	task, err := m.store.EnqueueTask(ctx, store.EnqueueTaskOpts{
+		Type:      worker.TaskTypeRefreshProfile,
+		Payload:   store.ActorTaskPayload{ActorDID: req.Msg.ActorDid},
+		DedupeKey: worker.TaskTypeRefreshProfile + ":" + req.Msg.ActorDid,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrTaskAlreadyPending) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("profile refresh already pending for %s", req.Msg.ActorDid))
+		}
+		return nil, fmt.Errorf("enqueuing profile refresh: %w", err)
+	}
+
+	return connect.NewResponse(&v1.RefreshActorProfileResponse{
+		Task: task,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) ListDeadLetterEvents(ctx context.Context, req *connect.Request[v1.ListDeadLetterEventsRequest]) (*connect.Response[v1.ListDeadLetterEventsResponse], error) {
+	_, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	var filterBeforeID int64
+	if req.Msg.Cursor != "" {
+		filterBeforeID, err = strconv.ParseInt(req.Msg.Cursor, 10, 64)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parsing cursor: %w", err))
+		}
+	}
+	if req.Msg.Limit > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be at most 1000"))
+	}
+
+	out, err := m.store.ListDeadLetterEvents(ctx, store.ListDeadLetterEventsOpts{
+		FilterActorDID: req.Msg.FilterActorDid,
+		FilterBeforeID: filterBeforeID,
+		Limit:          int32(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing dead letter events: %w", err)
+	}
+
+	newCursor := ""
+	if len(out) > 0 {
+		newCursor = strconv.FormatInt(out[len(out)-1].Id, 10)
+	}
+
+	return connect.NewResponse(&v1.ListDeadLetterEventsResponse{
+		Events: out,
+		Cursor: newCursor,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) GetDeadLetterEvent(ctx context.Context, req *connect.Request[v1.GetDeadLetterEventRequest]) (*connect.Response[v1.GetDeadLetterEventResponse], error) {
+	_, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	evt, err := m.store.GetDeadLetterEvent(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("dead letter event %d not found", req.Msg.Id))
+		}
+		return nil, fmt.Errorf("getting dead letter event: %w", err)
+	}
+
+	return connect.NewResponse(&v1.GetDeadLetterEventResponse{
+		Event: evt,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) ReplayDeadLetterEvent(ctx context.Context, req *connect.Request[v1.ReplayDeadLetterEventRequest]) (*connect.Response[v1.ReplayDeadLetterEventResponse], error) {
+	authCtx, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	evt, err := m.store.ReplayDeadLetterEvent(ctx, req.Msg.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("dead letter event %d not found", req.Msg.Id))
+		}
+		return nil, fmt.Errorf("replaying dead letter event: %w", err)
+	}
+	m.log.Info("dead letter event replayed", slog.Int64("dead_letter_event_id", evt.Id), slog.String("moderator_did", authCtx.DID))
+
+	return connect.NewResponse(&v1.ReplayDeadLetterEventResponse{
+		Event: evt,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) DiscardDeadLetterEvent(ctx context.Context, req *connect.Request[v1.DiscardDeadLetterEventRequest]) (*connect.Response[v1.DiscardDeadLetterEventResponse], error) {
+	authCtx, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	if err := m.store.DeleteDeadLetterEvent(ctx, req.Msg.Id); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("dead letter event %d not found", req.Msg.Id))
+		}
+		return nil, fmt.Errorf("discarding dead letter event: %w", err)
+	}
+	m.log.Info("dead letter event discarded", slog.Int64("dead_letter_event_id", req.Msg.Id), slog.String("moderator_did", authCtx.DID))
+
+	return connect.NewResponse(&v1.DiscardDeadLetterEventResponse{}), nil
+}
+
+// scoringFlagsLookback is how far back ListScoringFlags looks for dampened
+// posts. It matches the lookback period of the materializer.
+const scoringFlagsLookback = 24 * time.Hour
+
+func (m *ModerationServiceHandler) ListScoringFlags(ctx context.Context, req *connect.Request[v1.ListScoringFlagsRequest]) (*connect.Response[v1.ListScoringFlagsResponse], error) {
+	_, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	if req.Msg.Limit > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be at most 1000"))
+	}
+	limit := int32(req.Msg.Limit)
+	if limit == 0 {
+		limit = 100
+	}
+
+	likers, err := m.store.ListReciprocalLikers(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("listing reciprocal likers: %w", err)
+	}
+	posts, err := m.store.ListDampenedPosts(ctx, time.Now().Add(-scoringFlagsLookback), scoring.DefaultDampeners, limit)
+	if err != nil {
+		return nil, fmt.Errorf("listing dampened posts: %w", err)
+	}
+
+	return connect.NewResponse(&v1.ListScoringFlagsResponse{
+		ReciprocalLikers: likers,
+		DampenedPosts:    posts,
+	}), nil
+}
+
+func (m *ModerationServiceHandler) ExplainPost(ctx context.Context, req *connect.Request[v1.ExplainPostRequest]) (*connect.Response[v1.ExplainPostResponse], error) {
+	_, err := m.authEngine.auth(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating: %w", err)
+	}
+
+	if req.Msg.Uri == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("uri must be specified"))
+	}
+	res, err := m.feedService.ExplainPost(ctx, req.Msg.FeedId, req.Msg.Uri)
+	if err != nil {
+		switch {
+		case errors.Is(err, feed.ErrUnknownFeed):
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown feed %q", req.Msg.FeedId))
+		case errors.Is(err, store.ErrNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("post %q not found", req.Msg.Uri))
+		}
+		return nil, fmt.Errorf("explaining post: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
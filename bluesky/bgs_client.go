@@ -3,7 +3,12 @@ package bluesky
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
 	"github.com/bluesky-social/indigo/api/atproto"
 	"github.com/bluesky-social/indigo/repo"
 	"github.com/bluesky-social/indigo/xrpc"
@@ -12,18 +17,31 @@ import (
 
 const DefaultBGSHost = "https://bsky.network"
 
+// DefaultMaxRepoSize is the largest repo CAR that SyncGetRepo will read.
+const DefaultMaxRepoSize = 128 << 20
+
+// ErrRepoTooLarge is returned by SyncGetRepo when the repo exceeds
+// BGSClient.MaxRepoSize.
+var ErrRepoTooLarge = errors.New("repo too large")
+
 type BGSClient struct {
 	BGSHost string
+	// MaxRepoSize is the largest repo CAR, in bytes, that SyncGetRepo will
+	// read. Defaults to DefaultMaxRepoSize.
+	MaxRepoSize int64
+}
+
+func (c *BGSClient) host() string {
+	if c.BGSHost == "" {
+		return DefaultBGSHost
+	}
+	return c.BGSHost
 }
 
 func (c *BGSClient) xrpcClient() *xrpc.Client {
 	ua := UserAgent
-	host := c.BGSHost
-	if host == "" {
-		host = DefaultBGSHost
-	}
 	return &xrpc.Client{
-		Host:      host,
+		Host:      c.host(),
 		UserAgent: &ua,
 	}
 }
@@ -52,3 +70,69 @@ func (c *BGSClient) SyncGetRecord(
 
 	return record, rr.SignedCommit().Rev, nil
 }
+
+// SyncGetRepo invokes the `SyncGetRepo` RPC against the BGS, and parses the
+// returned CAR into a repo that can be iterated. The CAR is parsed as it is
+// read, rather than buffered first, and ErrRepoTooLarge is returned once it
+// exceeds MaxRepoSize.
+func (c *BGSClient) SyncGetRepo(
+	ctx context.Context, actorDID string,
+) (*repo.Repo, error) {
+	maxSize := c.MaxRepoSize
+	if maxSize == 0 {
+		maxSize = DefaultMaxRepoSize
+	}
+
+	u := c.host() + "/xrpc/com.atproto.sync.getRepo?" + url.Values{"did": {actorDID}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("calling SyncGetRepo: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("calling SyncGetRepo: unexpected status %d: %s", resp.StatusCode, msg)
+	}
+	if resp.ContentLength > maxSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrRepoTooLarge, resp.ContentLength)
+	}
+
+	rr, err := repo.ReadRepoFromCar(ctx, &limitedReader{r: resp.Body, remaining: maxSize})
+	if err != nil {
+		return nil, fmt.Errorf("reading repo from car: %w", err)
+	}
+
+	return rr, nil
+}
+
+// limitedReader is like io.LimitedReader, but returns ErrRepoTooLarge rather
+// than io.EOF once the limit is exceeded, so a truncated repo isn't mistaken
+// for a complete one.
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Check whether the body ended exactly at the limit.
+		var b [1]byte
+		if n, _ := io.ReadFull(l.r, b[:]); n > 0 {
+			return 0, ErrRepoTooLarge
+		}
+		return 0, io.EOF
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
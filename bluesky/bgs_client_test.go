@@ -0,0 +1,92 @@
+package bluesky
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bluesky-social/indigo/carstore"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_limitedReader(t *testing.T) {
+	t.Parallel()
+
+	got, err := io.ReadAll(&limitedReader{r: strings.NewReader("abcd"), remaining: 4})
+	require.NoError(t, err)
+	assert.Equal(t, "abcd", string(got))
+
+	_, err = io.ReadAll(&limitedReader{r: strings.NewReader("abcde"), remaining: 4})
+	require.ErrorIs(t, err, ErrRepoTooLarge)
+}
+
+// carWithBlocks returns a CAR file containing n raw blocks of size bytes.
+func carWithBlocks(t *testing.T, n int, size int) []byte {
+	t.Helper()
+
+	// 0x12 is the multicodec for sha2-256.
+	prefix := cid.Prefix{Version: 1, Codec: cid.Raw, MhType: 0x12, MhLength: -1}
+	buf := &bytes.Buffer{}
+	var blocks [][]byte
+	var cids []cid.Cid
+	for i := 0; i < n; i++ {
+		data := bytes.Repeat([]byte{byte(i)}, size)
+		c, err := prefix.Sum(data)
+		require.NoError(t, err)
+		blocks = append(blocks, data)
+		cids = append(cids, c)
+	}
+	_, err := carstore.WriteCarHeader(buf, cids[0])
+	require.NoError(t, err)
+	for i, data := range blocks {
+		section := append(cids[i].Bytes(), data...)
+		buf.Write(binary.AppendUvarint(nil, uint64(len(section))))
+		buf.Write(section)
+	}
+	return buf.Bytes()
+}
+
+func TestBGSClient_SyncGetRepo_tooLarge(t *testing.T) {
+	t.Parallel()
+
+	car := carWithBlocks(t, 64, 1024)
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{
+			name: "content length",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write(car)
+			},
+		},
+		{
+			name: "chunked",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				// Flushing before writing the body prevents the content
+				// length being set, so the limit is hit whilst reading.
+				w.(http.Flusher).Flush()
+				_, _ = w.Write(car)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(tt.handler)
+			t.Cleanup(srv.Close)
+
+			c := &BGSClient{BGSHost: srv.URL, MaxRepoSize: int64(len(car)) / 2}
+			_, err := c.SyncGetRepo(context.Background(), "did:plc:test")
+			require.ErrorIs(t, err, ErrRepoTooLarge)
+		})
+	}
+}
@@ -157,6 +157,13 @@ func (c *PDSClient) refreshToken(ctx context.Context) error {
 	return nil
 }
 
+// DID returns the DID of the account the client is authenticated as.
+func (c *PDSClient) DID() string {
+	c.tokenInfoMu.Lock()
+	defer c.tokenInfoMu.Unlock()
+	return c.tokenInfo.authInfo.Did
+}
+
 func (c *PDSClient) ResolveHandle(ctx context.Context, handle string) (*atproto.IdentityResolveHandle_Output, error) {
 	xc, err := c.xrpcClient(ctx)
 	if err != nil {
@@ -259,6 +266,33 @@ func (c *PDSClient) DeleteRecord(
 	return nil
 }
 
+// ListRecords lists all records within a collection of the authenticated
+// user's repository, following the cursor until exhausted.
+func (c *PDSClient) ListRecords(
+	ctx context.Context, collection string,
+) ([]*atproto.RepoListRecords_Record, error) {
+	xc, err := c.xrpcClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get xrpc client: %w", err)
+	}
+
+	var records []*atproto.RepoListRecords_Record
+	cursor := ""
+	for {
+		out, err := atproto.RepoListRecords(
+			ctx, xc, collection, cursor, 100, xc.Auth.Did, false, "", "",
+		)
+		if err != nil {
+			return nil, fmt.Errorf("listing records: %w", err)
+		}
+		records = append(records, out.Records...)
+		if out.Cursor == nil || *out.Cursor == "" || len(out.Records) == 0 {
+			return records, nil
+		}
+		cursor = *out.Cursor
+	}
+}
+
 // PurgeFeeds deletes all feeds associated with the authenticated user
 func (c *PDSClient) PurgeFeeds(
 	ctx context.Context,
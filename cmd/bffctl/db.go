@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,6 +33,17 @@ func dbCmd(log *slog.Logger, env *environment) *cli.Command {
 					dbCandidateActorsBackfillProfiles(log, env),
 				},
 			},
+			{
+				Name:    "dead-letters",
+				Usage:   "Manage Jetstream events that the ingester failed to handle",
+				Aliases: []string{"dl"},
+				Subcommands: []*cli.Command{
+					dbDeadLettersList(log, env),
+					dbDeadLettersReplay(log, env),
+					dbDeadLettersDiscard(log, env),
+				},
+			},
+			dbNSFWRulesCmd(log, env),
 		},
 	}
 }
@@ -228,3 +240,131 @@ func dbCandidateActorsBackfillProfiles(log *slog.Logger, env *environment) *cli.
 		},
 	}
 }
+
+func dbDeadLettersList(log *slog.Logger, env *environment) *cli.Command {
+	actorDID := ""
+	limit := 100
+	return &cli.Command{
+		Name:  "ls",
+		Usage: "List dead lettered events, most recent first",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "actor",
+				Usage:       "only list events from this actor DID",
+				Destination: &actorDID,
+			},
+			&cli.IntFlag{
+				Name:        "limit",
+				Value:       limit,
+				Destination: &limit,
+			},
+		},
+		Action: func(cctx *cli.Context) error {
+			conn, err := pgx.Connect(cctx.Context, env.dbURL)
+			if err != nil {
+				return err
+			}
+			defer conn.Close(cctx.Context)
+
+			db := gen.New(conn)
+			events, err := db.ListDeadLetterEvents(cctx.Context, gen.ListDeadLetterEventsParams{
+				ActorDID:   pgtype.Text{String: actorDID, Valid: actorDID != ""},
+				MaxResults: int32(limit),
+			})
+			if err != nil {
+				return err
+			}
+			for _, e := range events {
+				log.Info("dead letter event",
+					slog.Int64("id", e.ID),
+					slog.String("actor_did", e.ActorDID),
+					slog.Int("attempts", int(e.Attempts)),
+					slog.String("last_error", e.LastError),
+					slog.Time("next_attempt_at", e.NextAttemptAt.Time),
+					slog.String("event", string(e.Event)),
+				)
+			}
+			return nil
+		},
+	}
+}
+
+func dbDeadLettersReplay(log *slog.Logger, env *environment) *cli.Command {
+	return &cli.Command{
+		Name:      "replay",
+		Usage:     "Make the ingester retry dead lettered events immediately",
+		ArgsUsage: "<id>...",
+		Action: func(cctx *cli.Context) error {
+			ids, err := parseIDArgs(cctx)
+			if err != nil {
+				return err
+			}
+
+			conn, err := pgx.Connect(cctx.Context, env.dbURL)
+			if err != nil {
+				return err
+			}
+			defer conn.Close(cctx.Context)
+
+			db := gen.New(conn)
+			for _, id := range ids {
+				if _, err := db.ReplayDeadLetterEvent(cctx.Context, id); err != nil {
+					if errors.Is(err, pgx.ErrNoRows) {
+						return fmt.Errorf("dead letter event %d not found", id)
+					}
+					return err
+				}
+				log.Info("scheduled replay", slog.Int64("id", id))
+			}
+			return nil
+		},
+	}
+}
+
+func dbDeadLettersDiscard(log *slog.Logger, env *environment) *cli.Command {
+	return &cli.Command{
+		Name:      "discard",
+		Usage:     "Delete dead lettered events without handling them",
+		ArgsUsage: "<id>...",
+		Action: func(cctx *cli.Context) error {
+			ids, err := parseIDArgs(cctx)
+			if err != nil {
+				return err
+			}
+
+			conn, err := pgx.Connect(cctx.Context, env.dbURL)
+			if err != nil {
+				return err
+			}
+			defer conn.Close(cctx.Context)
+
+			db := gen.New(conn)
+			for _, id := range ids {
+				deleted, err := db.DeleteDeadLetterEvent(cctx.Context, id)
+				if err != nil {
+					return err
+				}
+				if deleted == 0 {
+					return fmt.Errorf("dead letter event %d not found", id)
+				}
+				log.Info("discarded", slog.Int64("id", id))
+			}
+			return nil
+		},
+	}
+}
+
+func parseIDArgs(cctx *cli.Context) ([]int64, error) {
+	if cctx.NArg() == 0 {
+		return nil, fmt.Errorf("at least one id is required")
+	}
+	ids := make([]int64, 0, cctx.NArg())
+	for _, arg := range cctx.Args().Slice() {
+		id, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing id %q: %w", arg, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
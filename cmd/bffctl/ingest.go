@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/ingester"
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+func ingestCmd(log *slog.Logger, env *environment) *cli.Command {
+	return &cli.Command{
+		Name:  "ingest",
+		Usage: "Run parts of the ingester locally",
+		Subcommands: []*cli.Command{
+			ingestReplayCmd(log, env),
+		},
+	}
+}
+
+func ingestReplayCmd(log *slog.Logger, env *environment) *cli.Command {
+	dbURL := ""
+	since := ""
+	until := ""
+	realTime := false
+	return &cli.Command{
+		Name:      "replay",
+		Usage:     "Replay Jetstream events recorded with BFF_JETSTREAM_RECORD_DIR",
+		ArgsUsage: "<recording file or directory>...",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "db-url",
+				Usage:       "database to replay into, instead of the environment's",
+				Destination: &dbURL,
+			},
+			&cli.StringFlag{
+				Name:        "since",
+				Usage:       "only replay events at or after this RFC3339 time",
+				Destination: &since,
+			},
+			&cli.StringFlag{
+				Name:        "until",
+				Usage:       "only replay events at or before this RFC3339 time",
+				Destination: &until,
+			},
+			&cli.BoolFlag{
+				Name:        "real-time",
+				Usage:       "pace events as they were recorded, rather than as fast as possible",
+				Destination: &realTime,
+			},
+		},
+		Action: func(cctx *cli.Context) error {
+			if cctx.NArg() == 0 {
+				return fmt.Errorf("at least one recording file or directory is required")
+			}
+			opts := ingester.ReplayOpts{RealTime: realTime}
+			var err error
+			if since != "" {
+				if opts.Since, err = time.Parse(time.RFC3339, since); err != nil {
+					return fmt.Errorf("parsing since: %w", err)
+				}
+			}
+			if until != "" {
+				if opts.Until, err = time.Parse(time.RFC3339, until); err != nil {
+					return fmt.Errorf("parsing until: %w", err)
+				}
+			}
+
+			var paths []string
+			for _, arg := range cctx.Args().Slice() {
+				info, err := os.Stat(arg)
+				if err != nil {
+					return err
+				}
+				if !info.IsDir() {
+					paths = append(paths, arg)
+					continue
+				}
+				files, err := ingester.RecordingFiles(arg, opts.Since, opts.Until)
+				if err != nil {
+					return fmt.Errorf("listing recordings in %s: %w", arg, err)
+				}
+				paths = append(paths, files...)
+			}
+
+			if dbURL == "" {
+				dbURL = env.dbURL
+			}
+			pgxStore, err := store.ConnectPGXStore(
+				cctx.Context,
+				bfflog.ChildLogger(log, "store"),
+				&store.DirectConnector{URI: dbURL},
+			)
+			if err != nil {
+				return fmt.Errorf("connecting to store: %w", err)
+			}
+			defer pgxStore.Close()
+
+			actorCache := ingester.NewActorCache(bfflog.ChildLogger(log, "actor_cache"), pgxStore)
+			if err := actorCache.Sync(cctx.Context); err != nil {
+				return fmt.Errorf("filling candidate actor cache: %w", err)
+			}
+			fi := ingester.NewFirehoseIngester(bfflog.ChildLogger(log, "ingester"), pgxStore, actorCache)
+
+			stats, err := fi.Replay(cctx.Context, paths, opts)
+			log.Info("replay finished",
+				slog.Int("handled", stats.Handled),
+				slog.Int("failed", stats.Failed),
+			)
+			return err
+		},
+	}
+}
@@ -82,6 +82,7 @@ func main() {
 		Commands: []*cli.Command{
 			dbCmd(log, env),
 			bskyCmd(log, env),
+			ingestCmd(log, env),
 		},
 	}
 	if err := app.Run(os.Args); err != nil {
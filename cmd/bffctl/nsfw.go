@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+	"github.com/urfave/cli/v2"
+)
+
+var nsfwRuleKinds = []gen.NSFWRuleKind{
+	gen.NSFWRuleKindHashtag,
+	gen.NSFWRuleKindPostLabel,
+	gen.NSFWRuleKindProfileLabel,
+}
+
+func dbNSFWRulesCmd(log *slog.Logger, env *environment) *cli.Command {
+	return &cli.Command{
+		Name:  "nsfw-rules",
+		Usage: "Manage the hashtags and self labels that classify posts as NSFW",
+		Subcommands: []*cli.Command{
+			dbNSFWRulesList(log, env),
+			dbNSFWRulesUpdate(log, env, "add", "Add rules, and reclassify existing posts", (*store.PGXTX).CreateNSFWRule),
+			dbNSFWRulesUpdate(log, env, "rm", "Remove rules, and reclassify existing posts", (*store.PGXTX).DeleteNSFWRule),
+		},
+	}
+}
+
+func connectStore(cctx *cli.Context, log *slog.Logger, env *environment) (*store.PGXStore, error) {
+	pgxStore, err := store.ConnectPGXStore(
+		cctx.Context,
+		bfflog.ChildLogger(log, "store"),
+		&store.DirectConnector{URI: env.dbURL},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to store: %w", err)
+	}
+	return pgxStore, nil
+}
+
+func dbNSFWRulesList(log *slog.Logger, env *environment) *cli.Command {
+	return &cli.Command{
+		Name:  "ls",
+		Usage: "List the rules",
+		Action: func(cctx *cli.Context) error {
+			pgxStore, err := connectStore(cctx, log, env)
+			if err != nil {
+				return err
+			}
+			defer pgxStore.Close()
+
+			rules, err := pgxStore.ListNSFWRules(cctx.Context)
+			if err != nil {
+				return err
+			}
+			for _, r := range rules {
+				log.Info("rule",
+					slog.String("kind", string(r.Kind)),
+					slog.String("value", r.Value),
+					slog.Time("created_at", r.CreatedAt.Time),
+				)
+			}
+			return nil
+		},
+	}
+}
+
+// dbNSFWRulesUpdate adds or removes rules. Existing posts are reclassified
+// once by the worker, however many rules are changed.
+func dbNSFWRulesUpdate(
+	log *slog.Logger,
+	env *environment,
+	name string,
+	usage string,
+	update func(tx *store.PGXTX, ctx context.Context, kind gen.NSFWRuleKind, value string) error,
+) *cli.Command {
+	kind := ""
+	return &cli.Command{
+		Name:      name,
+		Usage:     usage,
+		ArgsUsage: "<value>...",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "kind",
+				Usage:       "one of hashtag, post_label or profile_label",
+				Required:    true,
+				Destination: &kind,
+			},
+		},
+		Action: func(cctx *cli.Context) error {
+			if !slices.Contains(nsfwRuleKinds, gen.NSFWRuleKind(kind)) {
+				return fmt.Errorf("unrecognized kind %q", kind)
+			}
+			if cctx.NArg() == 0 {
+				return fmt.Errorf("at least one value is required")
+			}
+
+			pgxStore, err := connectStore(cctx, log, env)
+			if err != nil {
+				return err
+			}
+			defer pgxStore.Close()
+
+			tx, err := pgxStore.TX(cctx.Context)
+			if err != nil {
+				return err
+			}
+			defer tx.Rollback()
+
+			changed := false
+			for _, value := range cctx.Args().Slice() {
+				if kind == string(gen.NSFWRuleKindHashtag) {
+					// Hashtags are stored lowercased and without the #.
+					value = strings.ToLower(strings.TrimPrefix(value, "#"))
+				}
+				log := log.With(slog.String("kind", kind), slog.String("value", value))
+
+				err := update(tx, cctx.Context, gen.NSFWRuleKind(kind), value)
+				if errors.Is(err, store.ErrNotFound) {
+					log.Warn("rule unchanged, no action taken")
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				changed = true
+				log.Info("updated rule")
+			}
+			if !changed {
+				return nil
+			}
+
+			task, err := tx.EnqueueNSFWReclassification(cctx.Context)
+			if err != nil {
+				return err
+			}
+			if err := tx.Commit(cctx.Context); err != nil {
+				return fmt.Errorf("committing transaction: %w", err)
+			}
+			log.Info("enqueued reclassification of existing posts", slog.Int64("task_id", task.Id))
+			return nil
+		},
+	}
+}
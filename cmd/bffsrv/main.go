@@ -7,14 +7,21 @@ import (
 	"log/slog"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"time"
 
+	indigoAPI "github.com/bluesky-social/indigo/api"
+	"github.com/bluesky-social/indigo/did"
+	"github.com/bluesky-social/indigo/plc"
 	"github.com/grafana/pyroscope-go"
 	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/labeler"
 	"github.com/strideynet/bsky-furry-feed/scoring"
 	"github.com/strideynet/bsky-furry-feed/worker"
 
 	"github.com/joho/godotenv"
+	"github.com/rs/xid"
 	"github.com/strideynet/bsky-furry-feed/api"
 	"github.com/strideynet/bsky-furry-feed/bluesky"
 	"github.com/strideynet/bsky-furry-feed/feed"
@@ -122,17 +129,26 @@ func runE(log *slog.Logger) error {
 	if err != nil {
 		return err
 	}
-	bskyCredentials, err := bluesky.CredentialsFromEnv()
-	if err != nil {
-		return fmt.Errorf("loading bsky credentials: %w", err)
-	}
+	// The credentials are only required by the background worker, so a
+	// missing credential is only an error if it's enabled.
+	bskyCredentials, bskyCredentialsErr := bluesky.CredentialsFromEnv()
 
 	ingesterEnabled := os.Getenv("BFF_INGESTER_ENABLED") == "1"
 	apiEnabled := os.Getenv("BFF_API_ENABLED") == "1"
 	scoreMaterializerEnabled := os.Getenv("BFF_SCORE_MATERIALIZER_ENABLED") == "1"
 	backgroundWorkerEnabled := os.Getenv("BFF_BACKGROUND_WORKER_ENABLED") == "1"
+	labelerEnabled := os.Getenv("BFF_LABELER_ENABLED") == "1"
 
-	log.Info("starting bffsrv", slog.String("mode", string(mode)))
+	replicaID, err := newReplicaID()
+	if err != nil {
+		return err
+	}
+
+	log.Info(
+		"starting bffsrv",
+		slog.String("mode", string(mode)),
+		slog.String("replica_id", replicaID),
+	)
 
 	ctx, cancel := signal.NotifyContext(context.Background(), unix.SIGINT)
 	defer cancel()
@@ -233,12 +249,60 @@ func runE(log *slog.Logger) error {
 			return actorCache.Start(ctx)
 		})
 
-		fi := ingester.NewFirehoseIngester(
-			bfflog.ChildLogger(log, "ingester"), pgxStore, actorCache, "",
-		)
-		eg.Go(func() error {
-			return fi.Start(ctx)
-		})
+		switch source := os.Getenv("BFF_INGESTER_SOURCE"); source {
+		case "", "jetstream":
+			fi := ingester.NewFirehoseIngester(
+				bfflog.ChildLogger(log, "ingester"),
+				pgxStore,
+				actorCache,
+				strings.Split(os.Getenv("BFF_JETSTREAM_URLS"), ",")...,
+			)
+			if dir := os.Getenv("BFF_JETSTREAM_RECORD_DIR"); dir != "" {
+				rec, err := ingester.NewEventRecorder(
+					bfflog.ChildLogger(log, "recorder"), dir,
+				)
+				if err != nil {
+					return fmt.Errorf("creating event recorder: %w", err)
+				}
+				defer func() {
+					if err := rec.Close(); err != nil {
+						log.Error("failed to close event recorder", bfflog.Err(err))
+					}
+				}()
+				fi.RecordTo(rec)
+			}
+			if shards := os.Getenv("BFF_INGESTER_SHARDS"); shards != "" {
+				opts, err := ingesterShardOpts(shards, replicaID)
+				if err != nil {
+					return err
+				}
+				log.Info(
+					"sharding ingestion",
+					slog.Int("shards", opts.Count),
+					slog.String("replica_id", opts.ReplicaID),
+				)
+				fi.Shard(opts)
+			}
+			eg.Go(func() error {
+				return fi.Start(ctx)
+			})
+		case "relay":
+			if os.Getenv("BFF_INGESTER_SHARDS") != "" {
+				return fmt.Errorf("BFF_INGESTER_SHARDS is only supported by the jetstream ingester")
+			}
+			ri := ingester.NewRelayIngester(
+				bfflog.ChildLogger(log, "ingester"),
+				pgxStore,
+				actorCache,
+				os.Getenv("BFF_RELAY_URL"),
+				newDIDResolver(),
+			)
+			eg.Go(func() error {
+				return ri.Start(ctx)
+			})
+		default:
+			return fmt.Errorf("unsupported BFF_INGESTER_SOURCE %q", source)
+		}
 	}
 
 	if apiEnabled {
@@ -251,6 +315,26 @@ func runE(log *slog.Logger) error {
 			return fmt.Errorf("BFF_HOSTNAME not set")
 		}
 		listenAddr := ":1337"
+		// The API uses its own client to inspect the feed account's follows
+		// when reporting on follow reconciliation. The report is optional, so
+		// the API still starts if we can't log in.
+		var followLister worker.RecordLister
+		if bskyCredentialsErr != nil {
+			log.Warn(
+				"follow reconciliation report disabled, as bsky credentials could not be loaded",
+				bfflog.Err(bskyCredentialsErr),
+			)
+		} else {
+			pdsClient, err := bluesky.ClientFromCredentials(ctx, bluesky.DefaultPDSHost, bskyCredentials)
+			if err != nil {
+				log.Warn(
+					"follow reconciliation report disabled, as logging in to the pds failed",
+					bfflog.Err(err),
+				)
+			} else {
+				followLister = pdsClient
+			}
+		}
 		srv, err := api.New(
 			ctx,
 			bfflog.ChildLogger(log, "api"),
@@ -264,6 +348,7 @@ func runE(log *slog.Logger) error {
 				TokenValidator: api.BSkyTokenValidator(bluesky.DefaultPDSHost),
 				Log:            bfflog.ChildLogger(log, "auth_engine"),
 			},
+			followLister,
 		)
 		if err != nil {
 			return fmt.Errorf("creating feed server: %w", err)
@@ -283,15 +368,21 @@ func runE(log *slog.Logger) error {
 
 	if scoreMaterializerEnabled {
 		log.Info("starting scoring materializer")
-		hm := scoring.NewMaterializer(
+		hm, err := scoring.NewMaterializer(
 			bfflog.ChildLogger(log, "scoring_materializer"),
 			pgxStore,
 			scoring.Opts{
+				// Only the algorithms which the feeds read are materialized.
+				Algorithms:              feed.ServiceWithDefaultFeeds(pgxStore).ScoreAlgorithms(),
 				MaterializationInterval: 1 * time.Minute,
 				RetentionPeriod:         15 * time.Minute,
 				LookbackPeriod:          24 * time.Hour,
+				ReplicaID:               replicaID,
 			},
 		)
+		if err != nil {
+			return fmt.Errorf("creating scoring materializer: %w", err)
+		}
 		eg.Go(func() error {
 			return hm.Run(ctx)
 		})
@@ -299,6 +390,9 @@ func runE(log *slog.Logger) error {
 
 	if backgroundWorkerEnabled {
 		log.Info("starting background worker")
+		if bskyCredentialsErr != nil {
+			return fmt.Errorf("loading bsky credentials: %w", bskyCredentialsErr)
+		}
 
 		eg.Go(func() error {
 			worker, err := worker.New(
@@ -307,6 +401,16 @@ func runE(log *slog.Logger) error {
 				bluesky.DefaultPDSHost,
 				bskyCredentials,
 				pgxStore,
+				worker.Opts{
+					ArtistListEnabled:       os.Getenv("BFF_ARTIST_LIST_ENABLED") == "1",
+					ListReconcileInterval:   10 * time.Minute,
+					FollowReconcileInterval: 1 * time.Hour,
+					TaskBackoff: worker.Backoff{
+						Initial: 1 * time.Minute,
+						Max:     1 * time.Hour,
+					},
+					ReplicaID: replicaID,
+				},
 			)
 			if err != nil {
 				return fmt.Errorf("initializing worker: %w", err)
@@ -316,6 +420,27 @@ func runE(log *slog.Logger) error {
 		})
 	}
 
+	if labelerEnabled {
+		log.Info("starting labeler")
+		labelerDID, labelerKey, err := labeler.ConfigFromEnv()
+		if err != nil {
+			return fmt.Errorf("loading labeler config: %w", err)
+		}
+		l := labeler.New(
+			bfflog.ChildLogger(log, "labeler"),
+			pgxStore,
+			labelerDID,
+			labelerKey,
+			labeler.Opts{
+				SyncInterval: 1 * time.Minute,
+				ReplicaID:    replicaID,
+			},
+		)
+		eg.Go(func() error {
+			return l.Run(ctx)
+		})
+	}
+
 	// Setup private diagnostics/metrics server
 	debugSrv := debugServer()
 	eg.Go(func() error {
@@ -332,3 +457,39 @@ func runE(log *slog.Logger) error {
 	log.Info("setup complete. running services")
 	return eg.Wait()
 }
+
+// newReplicaID returns a unique ID for this replica, used to hold leases. It
+// defaults to the hostname with a random suffix, so that a restarted replica
+// doesn't inherit leases from before it crashed.
+func newReplicaID() (string, error) {
+	if id := os.Getenv("BFF_REPLICA_ID"); id != "" {
+		return id, nil
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("getting hostname: %w", err)
+	}
+	return hostname + "-" + xid.New().String(), nil
+}
+
+// ingesterShardOpts configures sharding the ingester across replicas.
+// BFF_INGESTER_REPLICA_ID overrides the replica ID for the ingester.
+func ingesterShardOpts(shards string, replicaID string) (ingester.ShardOpts, error) {
+	count, err := strconv.Atoi(shards)
+	if err != nil || count < 1 {
+		return ingester.ShardOpts{}, fmt.Errorf("BFF_INGESTER_SHARDS must be a positive integer, got %q", shards)
+	}
+	if id := os.Getenv("BFF_INGESTER_REPLICA_ID"); id != "" {
+		replicaID = id
+	}
+	return ingester.ShardOpts{Count: count, ReplicaID: replicaID}, nil
+}
+
+// newDIDResolver resolves did:plc via plc.directory and did:web directly, with
+// a cache in front as we resolve the DID of every tracked actor that commits.
+func newDIDResolver() ingester.DIDResolver {
+	mr := did.NewMultiResolver()
+	mr.AddHandler("plc", &indigoAPI.PLCServer{Host: "https://plc.directory"})
+	mr.AddHandler("web", &did.WebResolver{})
+	return plc.NewCachingDidResolver(mr, time.Hour, 100_000)
+}
@@ -0,0 +1,325 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+// blendedChild is one of the feeds mixed into a blended feed.
+type blendedChild struct {
+	// Name identifies the child's cursor within the blended feed's cursor, so
+	// must be unique within the feed and shouldn't be changed.
+	Name string
+	// Weight is how many posts are taken from the child for every post
+	// from a child with weight 1.
+	Weight   int
+	Generate GenerateFunc
+	Explain  ExplainFunc
+}
+
+// blendedSeenPosts is how many of the most recently shown posts are
+// remembered in the cursor, so that posts in more than one child are only
+// shown once.
+const blendedSeenPosts = 100
+
+type blendedCursor struct {
+	// Position is how many posts have been shown.
+	Position int `json:"position"`
+	// Cursors are the cursors of the last post taken from each child.
+	Cursors childCursors `json:"cursors,omitempty"`
+	// SeenHashes are the hashes of the most recently shown URIs, packed by
+	// packHashes.
+	SeenHashes string `json:"seen_hashes,omitempty"`
+}
+
+// childCursors are marshaled with cursors which are JSON objects embedded as
+// they are, rather than escaped into strings, as most of a blended feed's
+// cursor is its children's cursors.
+type childCursors map[string]string
+
+func (c childCursors) MarshalJSON() ([]byte, error) {
+	raw := make(map[string]json.RawMessage, len(c))
+	for name, cursor := range c {
+		if strings.HasPrefix(cursor, "{") && json.Valid([]byte(cursor)) {
+			raw[name] = json.RawMessage(cursor)
+			continue
+		}
+		b, err := json.Marshal(cursor)
+		if err != nil {
+			return nil, err
+		}
+		raw[name] = b
+	}
+	return json.Marshal(raw)
+}
+
+func (c *childCursors) UnmarshalJSON(b []byte) error {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*c = make(childCursors, len(raw))
+	for name, cursor := range raw {
+		if !bytes.HasPrefix(cursor, []byte("{")) {
+			var s string
+			if err := json.Unmarshal(cursor, &s); err != nil {
+				return fmt.Errorf("unmarshaling %s cursor: %w", name, err)
+			}
+			(*c)[name] = s
+			continue
+		}
+		(*c)[name] = string(cursor)
+	}
+	return nil
+}
+
+func hashURI(uri string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(uri))
+	return h.Sum32()
+}
+
+// packHashes encodes hashes compactly, as base64 of their bytes.
+func packHashes(hashes []uint32) string {
+	b := make([]byte, 0, len(hashes)*4)
+	for _, h := range hashes {
+		b = binary.BigEndian.AppendUint32(b, h)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func unpackHashes(packed string) ([]uint32, error) {
+	b, err := base64.RawURLEncoding.DecodeString(packed)
+	if err != nil {
+		return nil, err
+	}
+	if len(b)%4 != 0 {
+		return nil, fmt.Errorf("%d bytes isn't a whole number of hashes", len(b))
+	}
+	hashes := make([]uint32, 0, len(b)/4)
+	for i := 0; i < len(b); i += 4 {
+		hashes = append(hashes, binary.BigEndian.Uint32(b[i:]))
+	}
+	return hashes, nil
+}
+
+// blendSchedule spreads the children's slots evenly through a cycle, in
+// proportion to their weights, using smooth weighted round-robin.
+func blendSchedule(children []blendedChild) []int {
+	total := 0
+	for _, c := range children {
+		total += c.Weight
+	}
+	current := make([]int, len(children))
+	schedule := make([]int, 0, total)
+	for range total {
+		best := 0
+		for i, c := range children {
+			current[i] += c.Weight
+			if current[i] > current[best] {
+				best = i
+			}
+		}
+		current[best] -= total
+		schedule = append(schedule, best)
+	}
+	return schedule
+}
+
+// blendedGenerator interleaves the posts of several feeds. If a child runs
+// out of posts, its slots are given to the next child in the schedule.
+//
+// The cursor holds the cursor of every child, so to keep responses small only
+// the last post of each page is given one. This means a blended feed can't be
+// mixed into another blended feed.
+func blendedGenerator(children []blendedChild) GenerateFunc {
+	schedule := blendSchedule(children)
+	return func(ctx context.Context, pgxStore *store.PGXStore, cursor string, limit int) ([]Post, error) {
+		c := blendedCursor{}
+		if cursor != "" {
+			if err := json.Unmarshal([]byte(cursor), &c); err != nil {
+				return nil, fmt.Errorf("unmarshaling cursor: %w", err)
+			}
+		}
+		if c.Cursors == nil {
+			c.Cursors = childCursors{}
+		}
+		recent, err := unpackHashes(c.SeenHashes)
+		if err != nil {
+			return nil, fmt.Errorf("unpacking seen hashes: %w", err)
+		}
+		seen := map[uint32]bool{}
+		for _, h := range recent {
+			seen[h] = true
+		}
+
+		// Posts are fetched from each child as they're needed, and any that
+		// aren't shown are fetched again for the next page.
+		buffers := make([][]Post, len(children))
+		exhausted := make([]bool, len(children))
+		take := func(i int) (Post, bool, error) {
+			for {
+				if len(buffers[i]) == 0 && !exhausted[i] {
+					child := children[i]
+					posts, err := child.Generate(ctx, pgxStore, c.Cursors[child.Name], limit)
+					if err != nil {
+						return Post{}, false, fmt.Errorf("generating %s: %w", child.Name, err)
+					}
+					buffers[i] = posts
+					exhausted[i] = len(posts) == 0
+				}
+				if len(buffers[i]) == 0 {
+					return Post{}, false, nil
+				}
+				p := buffers[i][0]
+				buffers[i] = buffers[i][1:]
+				c.Cursors[children[i].Name] = p.Cursor
+				if h := hashURI(p.URI); !seen[h] {
+					seen[h] = true
+					recent = append(recent, h)
+					if len(recent) > blendedSeenPosts {
+						recent = recent[len(recent)-blendedSeenPosts:]
+					}
+					return p, true, nil
+				}
+			}
+		}
+
+		posts := make([]Post, 0, limit)
+	fill:
+		for len(posts) < limit {
+			slot := c.Position % len(schedule)
+			for offset := range schedule {
+				p, ok, err := take(schedule[(slot+offset)%len(schedule)])
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+				c.Position++
+				posts = append(posts, Post{URI: p.URI})
+				continue fill
+			}
+			// Every child has run out of posts.
+			break
+		}
+
+		if len(posts) > 0 {
+			c.SeenHashes = packHashes(recent)
+			pageCursor, err := json.Marshal(c)
+			if err != nil {
+				return nil, fmt.Errorf("marshaling cursor: %w", err)
+			}
+			posts[len(posts)-1].Cursor = string(pageCursor)
+		}
+		return posts, nil
+	}
+}
+
+// blendedExplainer explains a post in each of the children. The post is
+// included if any child includes it.
+func blendedExplainer(children []blendedChild) ExplainFunc {
+	return func(ctx context.Context, pgxStore *store.PGXStore, uri string) (*v1.ExplainPostResponse, error) {
+		out := &v1.ExplainPostResponse{}
+		for _, child := range children {
+			res, err := child.Explain(ctx, pgxStore, uri)
+			if err != nil {
+				return nil, fmt.Errorf("explaining %s: %w", child.Name, err)
+			}
+			out.Included = out.Included || res.Included
+			for _, f := range res.Filters {
+				f.Name = child.Name + "/" + f.Name
+				out.Filters = append(out.Filters, f)
+			}
+			if out.Score == nil {
+				out.Score = res.Score
+			}
+		}
+		return out, nil
+	}
+}
+
+// sampledCursor is the position in a sampled feed. The seed is chosen at
+// random for the first page, and kept for the rest.
+type sampledCursor struct {
+	Seed   uint64 `json:"seed"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// sampledMaxPages bounds how many pages of the underlying feed are read to
+// fill one page of a sampled feed.
+const sampledMaxPages = 5
+
+// sampledGenerator shows a random sample of rate of the posts in another
+// feed. Each reader gets a different sample.
+func sampledGenerator(generate GenerateFunc, rate float64) GenerateFunc {
+	return func(ctx context.Context, pgxStore *store.PGXStore, cursor string, limit int) ([]Post, error) {
+		c := sampledCursor{Seed: rand.Uint64()}
+		if cursor != "" {
+			if err := json.Unmarshal([]byte(cursor), &c); err != nil {
+				return nil, fmt.Errorf("unmarshaling cursor: %w", err)
+			}
+		}
+
+		posts := make([]Post, 0, limit)
+		for range sampledMaxPages {
+			page, err := generate(ctx, pgxStore, c.Cursor, limit)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range page {
+				c.Cursor = p.Cursor
+				if !sampled(c.Seed, p.URI, rate) {
+					continue
+				}
+				postCursor, err := json.Marshal(c)
+				if err != nil {
+					return nil, fmt.Errorf("marshaling cursor: %w", err)
+				}
+				posts = append(posts, Post{
+					URI:    p.URI,
+					Cursor: string(postCursor),
+				})
+				if len(posts) == limit {
+					return posts, nil
+				}
+			}
+			if len(page) < limit {
+				break
+			}
+		}
+		return posts, nil
+	}
+}
+
+func sampled(seed uint64, uri string, rate float64) bool {
+	h := fnv.New64a()
+	_, _ = h.Write(strconv.AppendUint(nil, seed, 10))
+	_, _ = h.Write([]byte(uri))
+	return float64(h.Sum64()%10_000) < rate*10_000
+}
+
+func sampledExplainer(explain ExplainFunc, rate float64) ExplainFunc {
+	return func(ctx context.Context, pgxStore *store.PGXStore, uri string) (*v1.ExplainPostResponse, error) {
+		res, err := explain(ctx, pgxStore, uri)
+		if err != nil {
+			return nil, err
+		}
+		res.Filters = append(res.Filters, filterResult(
+			"sampled", true, fmt.Sprintf("%g%% of posts are sampled at random for each reader", rate*100),
+		))
+		return res, nil
+	}
+}
@@ -0,0 +1,174 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strideynet/bsky-furry-feed/bluesky"
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+// sliceGenerator pages through uris, using the index of the next post as
+// the cursor.
+func sliceGenerator(uris ...string) GenerateFunc {
+	return func(_ context.Context, _ *store.PGXStore, cursor string, limit int) ([]Post, error) {
+		start := 0
+		if cursor != "" {
+			var err error
+			start, err = strconv.Atoi(cursor)
+			if err != nil {
+				return nil, err
+			}
+		}
+		posts := []Post{}
+		for i := start; i < len(uris) && len(posts) < limit; i++ {
+			posts = append(posts, Post{URI: uris[i], Cursor: strconv.Itoa(i + 1)})
+		}
+		return posts, nil
+	}
+}
+
+func numberedURIs(prefix string, n int) []string {
+	uris := []string{}
+	for i := range n {
+		uris = append(uris, fmt.Sprintf("%s%d", prefix, i))
+	}
+	return uris
+}
+
+func generateAll(t *testing.T, generate GenerateFunc, limit int) []string {
+	uris := []string{}
+	cursor := ""
+	for {
+		posts, err := generate(context.Background(), nil, cursor, limit)
+		require.NoError(t, err)
+		if len(posts) == 0 {
+			return uris
+		}
+		for _, p := range posts {
+			uris = append(uris, p.URI)
+		}
+		cursor = posts[len(posts)-1].Cursor
+	}
+}
+
+func Test_blendSchedule(t *testing.T) {
+	schedule := blendSchedule([]blendedChild{{Weight: 4}, {Weight: 2}, {Weight: 1}})
+	assert.Equal(t, []int{0, 1, 0, 2, 0, 1, 0}, schedule)
+}
+
+func Test_blendedGenerator(t *testing.T) {
+	generate := blendedGenerator([]blendedChild{
+		{Name: "hot", Weight: 2, Generate: sliceGenerator("h0", "shared", "h1", "h2")},
+		{Name: "new", Weight: 1, Generate: sliceGenerator("shared", "n0", "n1", "n2", "n3")},
+	})
+
+	// Posts are interleaved by weight and only shown once, so hot skips
+	// the post new has already shown. Once hot runs out, its slots go to new.
+	want := []string{"h0", "shared", "h1", "h2", "n0", "n1", "n2", "n3"}
+	for limit := 1; limit <= len(want); limit++ {
+		assert.Equal(t, want, generateAll(t, generate, limit), "limit %d", limit)
+	}
+}
+
+// worstCaseScoredCursor returns the largest cursor a scored feed with the
+// busiest feeds' author diversity limit can give.
+func worstCaseScoredCursor(t *testing.T) string {
+	did := func(i int) string {
+		return fmt.Sprintf("did:plc:%024d", i)
+	}
+	d := diversifier{opts: hotAuthorDiversity}
+	// Posts are only held back by authors over the limit, so at most
+	// (Window-1)/MaxPostsPerAuthor authors can have posts held back.
+	for i := range hotAuthorDiversity.Window - 1 {
+		d.recent = append(d.recent, did(i/hotAuthorDiversity.MaxPostsPerAuthor))
+	}
+	for i := range maxDeferredPosts {
+		author := did(i % ((hotAuthorDiversity.Window - 1) / hotAuthorDiversity.MaxPostsPerAuthor))
+		d.deferred = append(d.deferred, rankedPost{
+			URI:      fmt.Sprintf("at://%s/app.bsky.feed.post/3l%011d", author, i),
+			ActorDID: author,
+		})
+	}
+	b, err := json.Marshal(scoredCursor{
+		GenerationSeq:    1 << 40,
+		AfterScore:       0.123456789,
+		AfterURI:         "at://" + did(99) + "/app.bsky.feed.post/3l00000000000",
+		diversifierState: d.save(),
+		Seed:             strconv.FormatUint(math.MaxUint64, 36),
+	})
+	require.NoError(t, err)
+	return string(b)
+}
+
+// fixedCursorGenerator gives every post the same cursor, and never runs out
+// of posts.
+func fixedCursorGenerator(prefix string, cursor string) GenerateFunc {
+	return func(_ context.Context, _ *store.PGXStore, _ string, limit int) ([]Post, error) {
+		posts := []Post{}
+		for range limit {
+			posts = append(posts, Post{
+				URI:    fmt.Sprintf("at://%s/app.bsky.feed.post/%d", prefix, rand.Uint64()),
+				Cursor: cursor,
+			})
+		}
+		return posts, nil
+	}
+}
+
+// Test_blendedGenerator_cursorSize ensures cursors stay small enough to be
+// passed back in every request, even when every child's state is as large as
+// it can be.
+func Test_blendedGenerator_cursorSize(t *testing.T) {
+	scored := worstCaseScoredCursor(t)
+	chronological := bluesky.FormatTime(time.Now())
+	generate := blendedGenerator([]blendedChild{
+		{Name: "furry-hot", Weight: 4, Generate: fixedCursorGenerator("hot", scored)},
+		{Name: "furry-new", Weight: 2, Generate: fixedCursorGenerator("new", chronological)},
+		{Name: "art-hot", Weight: 2, Generate: fixedCursorGenerator("art", scored)},
+		{
+			Name:     "sampled",
+			Weight:   1,
+			Generate: sampledGenerator(fixedCursorGenerator("sampled", chronological), 0.5),
+		},
+	})
+
+	cursor := ""
+	for range 10 {
+		posts, err := generate(context.Background(), nil, cursor, 30)
+		require.NoError(t, err)
+		require.Len(t, posts, 30)
+		for _, p := range posts[:len(posts)-1] {
+			assert.Empty(t, p.Cursor)
+		}
+		cursor = posts[len(posts)-1].Cursor
+		// furry-for-you's cursor, with every child's cursor as large as
+		// it can be and a full set of seen posts, is around 2.4KB.
+		assert.LessOrEqual(t, len(cursor), 2560)
+	}
+}
+
+func Test_sampledGenerator(t *testing.T) {
+	uris := numberedURIs("post", 1000)
+	generate := sampledGenerator(sliceGenerator(uris...), 0.1)
+
+	all := generateAll(t, generate, 30)
+	assert.InDelta(t, 100, len(all), 40)
+	// Pages continue with the same sample.
+	seen := map[string]bool{}
+	for _, uri := range all {
+		assert.False(t, seen[uri], "%s shown twice", uri)
+		seen[uri] = true
+	}
+
+	// Each reader gets a different sample.
+	assert.NotEqual(t, all, generateAll(t, generate, 30))
+}
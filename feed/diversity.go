@@ -0,0 +1,181 @@
+package feed
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// authorDiversity limits how many of an author's posts are shown close
+// together in a scored feed.
+type authorDiversity struct {
+	// MaxPostsPerAuthor is the most posts by one author that are shown within
+	// any Window consecutive posts. Zero disables the limit.
+	MaxPostsPerAuthor int
+	Window            int
+}
+
+// maxDeferredPosts bounds how many posts can be held back at once, as they're
+// carried over between pages in the cursor. Once it's reached, posts are shown
+// even if their author is over the limit.
+const maxDeferredPosts = 25
+
+type rankedPost struct {
+	URI      string `json:"uri"`
+	ActorDID string `json:"actor_did"`
+}
+
+// diversifier reorders posts so that no author has more than
+// MaxPostsPerAuthor posts within a window. Posts by an author over the limit
+// are held back, and shown as soon as the author falls back under it, before
+// any lower scored posts. Its state is stored in the cursor, so that every
+// page continues the same order.
+type diversifier struct {
+	opts authorDiversity
+	// deferred are the posts held back, in the order they were scored.
+	deferred []rankedPost
+	// recent are the authors of the last Window-1 posts shown.
+	recent []string
+}
+
+func (d *diversifier) allowed(did string) bool {
+	if d.opts.MaxPostsPerAuthor == 0 {
+		return true
+	}
+	n := 0
+	for _, r := range d.recent {
+		if r == did {
+			n++
+		}
+	}
+	return n < d.opts.MaxPostsPerAuthor
+}
+
+func (d *diversifier) show(p rankedPost) rankedPost {
+	if d.opts.MaxPostsPerAuthor == 0 {
+		return p
+	}
+	d.recent = append(d.recent, p.ActorDID)
+	if keep := d.opts.Window - 1; len(d.recent) > keep {
+		d.recent = slices.Clone(d.recent[len(d.recent)-keep:])
+	}
+	return p
+}
+
+// next returns the next post to show, pulling posts in score order from pull
+// as they're needed. pull returns false once there are no more posts, and
+// next does the same once every held back post has been shown.
+func (d *diversifier) next(pull func() (rankedPost, bool, error)) (rankedPost, bool, error) {
+	for i, p := range d.deferred {
+		if d.allowed(p.ActorDID) {
+			d.deferred = slices.Delete(slices.Clone(d.deferred), i, i+1)
+			return d.show(p), true, nil
+		}
+	}
+	for {
+		p, ok, err := pull()
+		if err != nil {
+			return rankedPost{}, false, err
+		}
+		if !ok {
+			break
+		}
+		if d.allowed(p.ActorDID) || len(d.deferred) >= maxDeferredPosts {
+			return d.show(p), true, nil
+		}
+		d.deferred = append(slices.Clone(d.deferred), p)
+	}
+	// Held back posts are shown anyway once every other post has been.
+	if len(d.deferred) == 0 {
+		return rankedPost{}, false, nil
+	}
+	p := d.deferred[0]
+	d.deferred = slices.Clone(d.deferred[1:])
+	return d.show(p), true, nil
+}
+
+// diversifierState is a diversifier's state as it's stored in a cursor. Posts
+// are only held back whilst their author has recently been shown, so each
+// author is listed once, and referred to by index, to keep cursors small.
+type diversifierState struct {
+	Authors []string `json:"authors,omitempty"`
+	// Recent are the indexes of the authors of the last Window-1 posts shown.
+	Recent []int `json:"recent,omitempty"`
+	// Held are the posts held back, each as the index of its author and its
+	// record key, separated by a slash.
+	Held []string `json:"held,omitempty"`
+
+	// Deferred and RecentAuthors hold the state in the format used by
+	// cursors issued before it was compacted, which we still accept.
+	Deferred      []rankedPost `json:"deferred,omitempty"`
+	RecentAuthors []string     `json:"recent_authors,omitempty"`
+}
+
+const postURIPrefix = "/app.bsky.feed.post/"
+
+func (d *diversifier) save() diversifierState {
+	s := diversifierState{}
+	index := func(did string) int {
+		i := slices.Index(s.Authors, did)
+		if i == -1 {
+			s.Authors = append(s.Authors, did)
+			i = len(s.Authors) - 1
+		}
+		return i
+	}
+	for _, did := range d.recent {
+		s.Recent = append(s.Recent, index(did))
+	}
+	for _, p := range d.deferred {
+		ref, ok := strings.CutPrefix(p.URI, "at://"+p.ActorDID+postURIPrefix)
+		if !ok {
+			// Shouldn't happen, but the full URI still works.
+			ref = p.URI
+		}
+		s.Held = append(s.Held, strconv.Itoa(index(p.ActorDID))+"/"+ref)
+	}
+	return s
+}
+
+func (d *diversifier) load(s diversifierState) error {
+	if len(s.Deferred) > 0 || len(s.RecentAuthors) > 0 {
+		d.recent, d.deferred = s.RecentAuthors, s.Deferred
+		return nil
+	}
+
+	author := func(i int) (string, error) {
+		if i < 0 || i >= len(s.Authors) {
+			return "", fmt.Errorf("unknown author %d", i)
+		}
+		return s.Authors[i], nil
+	}
+	d.recent = nil
+	for _, i := range s.Recent {
+		did, err := author(i)
+		if err != nil {
+			return err
+		}
+		d.recent = append(d.recent, did)
+	}
+	d.deferred = nil
+	for _, held := range s.Held {
+		i, ref, _ := strings.Cut(held, "/")
+		n, err := strconv.Atoi(i)
+		if err != nil {
+			return fmt.Errorf("parsing held post %q: %w", held, err)
+		}
+		did, err := author(n)
+		if err != nil {
+			return err
+		}
+		// Record keys can't contain slashes, so anything that does is a full
+		// URI.
+		uri := ref
+		if !strings.Contains(ref, "/") {
+			uri = "at://" + did + postURIPrefix + ref
+		}
+		d.deferred = append(d.deferred, rankedPost{URI: uri, ActorDID: did})
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package feed
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// postsByAuthors returns a post for each author, in score order.
+func postsByAuthors(authors string) []rankedPost {
+	posts := []rankedPost{}
+	for i, author := range strings.Split(authors, "") {
+		posts = append(posts, rankedPost{
+			URI:      fmt.Sprintf("at://%s/app.bsky.feed.post/%d", author, i),
+			ActorDID: author,
+		})
+	}
+	return posts
+}
+
+func authorsOf(posts []rankedPost) string {
+	out := ""
+	for _, p := range posts {
+		out += p.ActorDID
+	}
+	return out
+}
+
+// diversify pages through posts limit at a time, carrying the diversifier's
+// state between pages in a cursor, as the generator does.
+func diversify(t *testing.T, opts authorDiversity, posts []rankedPost, limit int) []rankedPost {
+	out := []rankedPost{}
+	pos := 0
+	cursor := scoredCursor{}
+	for {
+		d := diversifier{opts: opts}
+		require.NoError(t, d.load(cursor.diversifierState))
+		page := 0
+		for ; page < limit; page++ {
+			p, ok, err := d.next(func() (rankedPost, bool, error) {
+				if pos == len(posts) {
+					return rankedPost{}, false, nil
+				}
+				pos++
+				return posts[pos-1], true, nil
+			})
+			require.NoError(t, err)
+			if !ok {
+				return out
+			}
+			out = append(out, p)
+		}
+		b, err := json.Marshal(scoredCursor{diversifierState: d.save()})
+		require.NoError(t, err)
+		cursor = scoredCursor{}
+		require.NoError(t, json.Unmarshal(b, &cursor))
+	}
+}
+
+func Test_diversifier(t *testing.T) {
+	opts := authorDiversity{MaxPostsPerAuthor: 2, Window: 4}
+	tests := []struct {
+		name    string
+		opts    authorDiversity
+		authors string
+		want    string
+	}{
+		{
+			name:    "disabled",
+			authors: "aaaab",
+			want:    "aaaab",
+		},
+		{
+			name:    "diverse",
+			opts:    opts,
+			authors: "abcabc",
+			want:    "abcabc",
+		},
+		{
+			name:    "prolific author held back",
+			opts:    opts,
+			authors: "aaaabcdef",
+			want:    "aabcaadef",
+		},
+		{
+			name:    "held back posts shown at the end",
+			opts:    opts,
+			authors: "aaaaab",
+			want:    "aabaaa",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posts := postsByAuthors(tt.authors)
+			got := diversify(t, tt.opts, posts, len(posts))
+			assert.Equal(t, tt.want, authorsOf(got))
+			assert.ElementsMatch(t, posts, got)
+		})
+	}
+}
+
+func Test_diversifier_pages(t *testing.T) {
+	opts := authorDiversity{MaxPostsPerAuthor: 2, Window: 5}
+	posts := postsByAuthors("aaaaabbbacaaadbbbbefaaag")
+	want := diversify(t, opts, posts, len(posts))
+	// The order mustn't depend on where pages start.
+	for limit := 1; limit < len(posts); limit++ {
+		assert.Equal(t, want, diversify(t, opts, posts, limit), "limit %d", limit)
+	}
+}
+
+func Test_diversifier_maxDeferredPosts(t *testing.T) {
+	opts := authorDiversity{MaxPostsPerAuthor: 1, Window: 10}
+	posts := postsByAuthors(strings.Repeat("a", maxDeferredPosts+5))
+	d := diversifier{opts: opts}
+	pos := 0
+	for range 3 {
+		_, ok, err := d.next(func() (rankedPost, bool, error) {
+			pos++
+			return posts[pos-1], true, nil
+		})
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+	assert.Len(t, d.deferred, maxDeferredPosts)
+}
+
+func Test_diversifier_save(t *testing.T) {
+	d := diversifier{
+		recent: []string{"did:plc:a", "did:plc:b", "did:plc:a"},
+		deferred: []rankedPost{
+			{URI: "at://did:plc:a/app.bsky.feed.post/3k1", ActorDID: "did:plc:a"},
+			{URI: "at://did:plc:c/app.bsky.feed.post/3k2", ActorDID: "did:plc:c"},
+			{URI: "at://did:plc:a/app.bsky.feed.generator/odd", ActorDID: "did:plc:a"},
+		},
+	}
+	state := d.save()
+	assert.Equal(t, diversifierState{
+		Authors: []string{"did:plc:a", "did:plc:b", "did:plc:c"},
+		Recent:  []int{0, 1, 0},
+		Held: []string{
+			"0/3k1",
+			"2/3k2",
+			"0/at://did:plc:a/app.bsky.feed.generator/odd",
+		},
+	}, state)
+
+	loaded := diversifier{}
+	require.NoError(t, loaded.load(state))
+	assert.Equal(t, d.recent, loaded.recent)
+	assert.Equal(t, d.deferred, loaded.deferred)
+
+	assert.Error(t, loaded.load(diversifierState{Recent: []int{1}}))
+}
+
+func Test_diversifier_loadLegacy(t *testing.T) {
+	cursor := scoredCursor{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"generation_seq": 1,
+		"deferred": [{"uri": "at://did:plc:a/app.bsky.feed.post/3k1", "actor_did": "did:plc:a"}],
+		"recent_authors": ["did:plc:a", "did:plc:b"]
+	}`), &cursor))
+
+	d := diversifier{}
+	require.NoError(t, d.load(cursor.diversifierState))
+	assert.Equal(t, []string{"did:plc:a", "did:plc:b"}, d.recent)
+	assert.Equal(t, []rankedPost{
+		{URI: "at://did:plc:a/app.bsky.feed.post/3k1", ActorDID: "did:plc:a"},
+	}, d.deferred)
+}
@@ -0,0 +1,307 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExplainFunc reports whether a post passes each of a feed's filters.
+type ExplainFunc func(ctx context.Context, pgxStore *store.PGXStore, uri string) (*v1.ExplainPostResponse, error)
+
+// maxPostAge is how old a post can be before it drops out of every feed.
+const maxPostAge = 7 * 24 * time.Hour
+
+func overlaps(a, b []string) bool {
+	return slices.ContainsFunc(a, func(v string) bool {
+		return slices.Contains(b, v)
+	})
+}
+
+func formatHashtags(hashtags []string) string {
+	return "#" + strings.Join(hashtags, ", #")
+}
+
+func filterResult(name string, passed bool, detail string) *v1.PostFilterResult {
+	return &v1.PostFilterResult{Name: name, Passed: passed, Detail: detail}
+}
+
+// explainBase reports the filters that apply to every feed.
+func explainBase(post gen.CandidatePost, actor *v1.Actor, now time.Time) []*v1.PostFilterResult {
+	accountDetail := "account is active"
+	if actor.AccountStatus != "" {
+		accountDetail = fmt.Sprintf("account is %s", actor.AccountStatus)
+	}
+	return []*v1.PostFilterResult{
+		filterResult(
+			"actor_status",
+			actor.Status == v1.ActorStatus_ACTOR_STATUS_APPROVED,
+			fmt.Sprintf("actor is %s", actor.Status),
+		),
+		filterResult("account_active", actor.AccountStatus == "", accountDetail),
+		filterResult("is_hidden", !post.IsHidden, fmt.Sprintf("is_hidden is %t", post.IsHidden)),
+		filterResult("deleted", !post.DeletedAt.Valid, fmt.Sprintf("deleted is %t", post.DeletedAt.Valid)),
+		filterResult(
+			"age",
+			now.Sub(post.IndexedAt.Time) < maxPostAge && now.Sub(post.CreatedAt.Time) < maxPostAge,
+			fmt.Sprintf(
+				"created %s and indexed %s ago, posts are shown for %s",
+				now.Sub(post.CreatedAt.Time).Round(time.Minute),
+				now.Sub(post.IndexedAt.Time).Round(time.Minute),
+				maxPostAge,
+			),
+		),
+	}
+}
+
+// explain reports the content filters configured by the generatorOpts.
+func (o generatorOpts) explain(post gen.CandidatePost) []*v1.PostFilterResult {
+	var out []*v1.PostFilterResult
+
+	if len(o.Hashtags) == 0 {
+		out = append(out, filterResult("hashtags", true, "no hashtags are required"))
+	} else {
+		out = append(out, filterResult(
+			"hashtags",
+			overlaps(o.Hashtags, post.Hashtags),
+			fmt.Sprintf("requires one of %s", formatHashtags(o.Hashtags)),
+		))
+	}
+
+	if len(o.DisallowedHashtags) == 0 {
+		out = append(out, filterResult("disallowed_hashtags", true, "no hashtags are disallowed"))
+	} else {
+		out = append(out, filterResult(
+			"disallowed_hashtags",
+			!overlaps(o.DisallowedHashtags, post.Hashtags),
+			fmt.Sprintf("disallows %s", formatHashtags(o.DisallowedHashtags)),
+		))
+	}
+
+	hasMedia, hasVideo := post.HasMedia.Bool, post.HasVideo.Bool
+	if len(o.AllowedEmbeds) == 0 {
+		out = append(out, filterResult("embeds", true, "any embed is allowed"))
+	} else {
+		passed := false
+		allowed := make([]string, 0, len(o.AllowedEmbeds))
+		for _, embed := range o.AllowedEmbeds {
+			allowed = append(allowed, string(embed))
+			switch embed {
+			case EmbedNone:
+				passed = passed || (!hasMedia && !hasVideo)
+			case EmbedImage:
+				passed = passed || hasMedia
+			case EmbedVideo:
+				passed = passed || hasVideo
+			}
+		}
+		out = append(out, filterResult(
+			"embeds",
+			passed,
+			fmt.Sprintf(
+				"allows %s, post has media %t and video %t",
+				strings.Join(allowed, ", "), hasMedia, hasVideo,
+			),
+		))
+	}
+
+	nsfw := post.IsNSFW
+	if o.IsNSFW == nil {
+		out = append(out, filterResult("nsfw", true, fmt.Sprintf("any post is allowed, post is NSFW %t", nsfw)))
+	} else {
+		out = append(out, filterResult(
+			"nsfw",
+			nsfw == *o.IsNSFW,
+			fmt.Sprintf("requires NSFW %t, post is NSFW %t", *o.IsNSFW, nsfw),
+		))
+	}
+
+	return out
+}
+
+// explainActor reports the actor filters configured by the generatorOpts.
+func (o generatorOpts) explainActor(actor *v1.Actor) []*v1.PostFilterResult {
+	var out []*v1.PostFilterResult
+
+	if o.IsArtist == nil {
+		out = append(out, filterResult("is_artist", true, fmt.Sprintf("any actor is allowed, actor is artist %t", actor.IsArtist)))
+	} else {
+		out = append(out, filterResult(
+			"is_artist",
+			actor.IsArtist == *o.IsArtist,
+			fmt.Sprintf("requires artist %t, actor is artist %t", *o.IsArtist, actor.IsArtist),
+		))
+	}
+
+	if len(o.ActorRoles) == 0 {
+		out = append(out, filterResult("actor_roles", true, "no roles are required"))
+	} else {
+		out = append(out, filterResult(
+			"actor_roles",
+			overlaps(o.ActorRoles, actor.Roles),
+			fmt.Sprintf("requires one of the roles %s", strings.Join(o.ActorRoles, ", ")),
+		))
+	}
+
+	return out
+}
+
+func included(filters []*v1.PostFilterResult) bool {
+	for _, f := range filters {
+		if !f.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+func getPostAndActor(ctx context.Context, pgxStore *store.PGXStore, uri string) (gen.CandidatePost, *v1.Actor, error) {
+	post, err := pgxStore.GetPostByURI(ctx, uri)
+	if err != nil {
+		return post, nil, fmt.Errorf("getting post: %w", err)
+	}
+	actor, err := pgxStore.GetActorByDID(ctx, post.ActorDID)
+	if err != nil {
+		return post, nil, fmt.Errorf("getting actor: %w", err)
+	}
+	return post, actor, nil
+}
+
+func chronologicalExplainer(opts chronologicalGeneratorOpts) ExplainFunc {
+	return func(ctx context.Context, pgxStore *store.PGXStore, uri string) (*v1.ExplainPostResponse, error) {
+		post, actor, err := getPostAndActor(ctx, pgxStore, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		filters := explainBase(post, actor, time.Now())
+		filters = append(filters, opts.generatorOpts.explainActor(actor)...)
+		content := opts.generatorOpts.explain(post)
+		// Posts by pinned actors skip the content filters.
+		if slices.Contains(opts.PinnedDIDs, post.ActorDID) {
+			for _, f := range content {
+				if !f.Passed {
+					f.Passed = true
+					f.Detail += " (ignored, as the actor is pinned)"
+				}
+			}
+		}
+		filters = append(filters, content...)
+
+		return &v1.ExplainPostResponse{
+			Included: included(filters),
+			Filters:  filters,
+		}, nil
+	}
+}
+
+func preScoredExplainer(opts preScoredGeneratorOpts) ExplainFunc {
+	return func(ctx context.Context, pgxStore *store.PGXStore, uri string) (*v1.ExplainPostResponse, error) {
+		post, actor, err := getPostAndActor(ctx, pgxStore, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		filters := explainBase(post, actor, time.Now())
+		filters = append(filters, opts.generatorOpts.explainActor(actor)...)
+		filters = append(filters, opts.generatorOpts.explain(post)...)
+
+		alg := opts.scoreAlg()
+		seq, err := pgxStore.GetLatestScoreGeneration(ctx, alg)
+		if err != nil {
+			return nil, fmt.Errorf("executing GetLatestScoreGeneration: %w", err)
+		}
+		score, err := pgxStore.GetPostScore(ctx, uri, alg, seq)
+		if errors.Is(err, store.ErrNotFound) {
+			filters = append(filters, filterResult(
+				"scored", false, fmt.Sprintf("not scored in %s generation %d", alg, seq),
+			))
+			return &v1.ExplainPostResponse{
+				Included: false,
+				Filters:  filters,
+			}, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("getting post score: %w", err)
+		}
+		filters = append(filters, filterResult(
+			"scored", true, fmt.Sprintf("scored in %s generation %d", alg, seq),
+		))
+
+		allowedEmbeds := []string{}
+		for _, embed := range opts.AllowedEmbeds {
+			allowedEmbeds = append(allowedEmbeds, string(embed))
+		}
+		above, err := pgxStore.CountScoredPostsAbove(ctx, store.ListPostsForHotFeedOpts{
+			Hashtags:           opts.Hashtags,
+			DisallowedHashtags: opts.DisallowedHashtags,
+			IsNSFW:             opts.IsNSFW,
+			AllowedEmbeds:      allowedEmbeds,
+			Alg:                alg,
+			IsArtist:           opts.IsArtist,
+			ActorRoles:         opts.ActorRoles,
+			Cursor: store.ListPostsForHotFeedCursor{
+				GenerationSeq: seq,
+				AfterScore:    score.Score,
+				AfterURI:      score.URI,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("counting posts above: %w", err)
+		}
+
+		return &v1.ExplainPostResponse{
+			Included: included(filters),
+			Filters:  filters,
+			Score:    explainScore(opts, seq, score, post, above+1),
+		}, nil
+	}
+}
+
+// explainScore breaks down a post's score, following the materialization
+// query for the algorithm.
+func explainScore(
+	opts preScoredGeneratorOpts, seq int64, score gen.PostScore, post gen.CandidatePost, rank int64,
+) *v1.PostScoreExplanation {
+	out := &v1.PostScoreExplanation{
+		Alg:           opts.scoreAlg(),
+		GenerationSeq: seq,
+		GeneratedAt:   timestamppb.New(score.GeneratedAt.Time),
+		Score:         float64(score.Score),
+		Rank:          rank,
+		// The rank is counted by score, but jitter and the author diversity
+		// limit move posts around when the feed is read.
+		RankApproximate: opts.Jitter != 0 || opts.AuthorDiversity.MaxPostsPerAuthor != 0,
+	}
+	ageHours := score.GeneratedAt.Time.Sub(post.IndexedAt.Time).Hours()
+
+	switch opts.scoreAlg() {
+	case "classic", "classic_dampened", "network":
+		// Scores are the like count divided by an age penalty.
+		out.AgeHours = ageHours
+		out.AgePenalty = math.Pow(ageHours+2, 1.85)
+		out.Formula = "likes / (age_hours + 2)^1.85"
+		// The score is stored as a REAL, so round off the error.
+		out.Likes = math.Round(float64(score.Score)*out.AgePenalty*100) / 100
+		if score.Likes.Valid {
+			out.Likes = float64(score.Likes.Int64)
+		}
+	case "discovery":
+		// Scores fall with both likes and age, so there's no single age
+		// penalty.
+		out.AgeHours = ageHours
+		out.Formula = "1 / ((1 + likes / (age_hours + 1)) * sqrt(age_hours + 2))"
+		if score.Likes.Valid {
+			out.Likes = float64(score.Likes.Int64)
+		}
+	}
+	return out
+}
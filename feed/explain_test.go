@@ -0,0 +1,162 @@
+package feed
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+	"github.com/strideynet/bsky-furry-feed/tristate"
+)
+
+func failedFilters(filters []*v1.PostFilterResult) []string {
+	failed := []string{}
+	for _, f := range filters {
+		if !f.Passed {
+			failed = append(failed, f.Name)
+		}
+	}
+	return failed
+}
+
+func Test_generatorOpts_explain(t *testing.T) {
+	tests := []struct {
+		name string
+		opts generatorOpts
+		post gen.CandidatePost
+		want []string
+	}{
+		{
+			name: "no filters",
+			post: gen.CandidatePost{Hashtags: []string{"aiart"}},
+			want: []string{},
+		},
+		{
+			name: "missing hashtag",
+			opts: generatorOpts{Hashtags: []string{"furryart"}},
+			post: gen.CandidatePost{Hashtags: []string{"fursuit"}},
+			want: []string{"hashtags"},
+		},
+		{
+			name: "disallowed hashtag",
+			opts: generatorOpts{
+				Hashtags:           []string{"furryart"},
+				DisallowedHashtags: defaultDisallowedHashtags,
+			},
+			post: gen.CandidatePost{Hashtags: []string{"furryart", "aiart"}},
+			want: []string{"disallowed_hashtags"},
+		},
+		{
+			name: "text post in image feed",
+			opts: generatorOpts{AllowedEmbeds: allowImageAndVideo},
+			post: gen.CandidatePost{},
+			want: []string{"embeds"},
+		},
+		{
+			name: "video in video feed",
+			opts: generatorOpts{AllowedEmbeds: allowVideoOnly},
+			post: gen.CandidatePost{HasVideo: pgtype.Bool{Bool: true, Valid: true}},
+			want: []string{},
+		},
+		{
+			name: "nsfw post in clean feed",
+			opts: generatorOpts{IsNSFW: tristate.False},
+			post: gen.CandidatePost{IsNSFW: true},
+			want: []string{"nsfw"},
+		},
+		{
+			name: "unmarked post in nsfw feed",
+			opts: generatorOpts{IsNSFW: tristate.True},
+			post: gen.CandidatePost{Hashtags: []string{"furryart"}},
+			want: []string{"nsfw"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, failedFilters(tt.opts.explain(tt.post)))
+		})
+	}
+}
+
+func Test_explainBase(t *testing.T) {
+	now := time.Now()
+	approved := &v1.Actor{Status: v1.ActorStatus_ACTOR_STATUS_APPROVED}
+	recent := gen.CandidatePost{
+		CreatedAt: pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+		IndexedAt: pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+	}
+
+	assert.Empty(t, failedFilters(explainBase(recent, approved, now)))
+
+	old := recent
+	old.CreatedAt.Time = now.Add(-8 * 24 * time.Hour)
+	hidden := recent
+	hidden.IsHidden = true
+	deactivated := &v1.Actor{
+		Status:        v1.ActorStatus_ACTOR_STATUS_APPROVED,
+		AccountStatus: "deactivated",
+	}
+	pending := &v1.Actor{Status: v1.ActorStatus_ACTOR_STATUS_PENDING}
+	assert.Equal(t, []string{"age"}, failedFilters(explainBase(old, approved, now)))
+	assert.Equal(t, []string{"is_hidden"}, failedFilters(explainBase(hidden, approved, now)))
+	assert.Equal(t, []string{"account_active"}, failedFilters(explainBase(recent, deactivated, now)))
+	assert.Equal(t, []string{"actor_status"}, failedFilters(explainBase(recent, pending, now)))
+}
+
+func Test_generatorOpts_explainActor(t *testing.T) {
+	artist := &v1.Actor{IsArtist: true, Roles: []string{"admin"}}
+	furry := &v1.Actor{}
+
+	assert.Empty(t, failedFilters(generatorOpts{}.explainActor(furry)))
+	artistsOnly := generatorOpts{IsArtist: tristate.True}
+	assert.Empty(t, failedFilters(artistsOnly.explainActor(artist)))
+	assert.Equal(t, []string{"is_artist"}, failedFilters(artistsOnly.explainActor(furry)))
+	admins := generatorOpts{ActorRoles: []string{"admin", "moderator"}}
+	assert.Empty(t, failedFilters(admins.explainActor(artist)))
+	assert.Equal(t, []string{"actor_roles"}, failedFilters(admins.explainActor(furry)))
+}
+
+func Test_explainScore(t *testing.T) {
+	now := time.Now()
+	post := gen.CandidatePost{
+		IndexedAt: pgtype.Timestamptz{Time: now.Add(-2 * time.Hour), Valid: true},
+	}
+	score := func(s float32, likes int64) gen.PostScore {
+		return gen.PostScore{
+			Score:       s,
+			GeneratedAt: pgtype.Timestamptz{Time: now, Valid: true},
+			Likes:       pgtype.Int8{Int64: likes, Valid: true},
+		}
+	}
+
+	classic := explainScore(
+		preScoredGeneratorOpts{Alg: "classic"}, 7, score(float32(10/math.Pow(4, 1.85)), 10), post, 3,
+	)
+	assert.Equal(t, int64(7), classic.GenerationSeq)
+	assert.InDelta(t, 2, classic.AgeHours, 0.01)
+	assert.InDelta(t, math.Pow(4, 1.85), classic.AgePenalty, 0.01)
+	assert.Equal(t, 10.0, classic.Likes)
+	assert.False(t, classic.RankApproximate)
+
+	discovery := explainScore(
+		preScoredGeneratorOpts{
+			Alg:             "discovery",
+			AuthorDiversity: hotAuthorDiversity,
+			Jitter:          0.3,
+		},
+		7, score(float32(1/((1+3.0/3)*2)), 3), post, 3,
+	)
+	assert.InDelta(t, 2, discovery.AgeHours, 0.01)
+	assert.Zero(t, discovery.AgePenalty)
+	assert.Equal(t, 3.0, discovery.Likes)
+	assert.Contains(t, discovery.Formula, "sqrt")
+	assert.True(t, discovery.RankApproximate)
+
+	unknown := explainScore(preScoredGeneratorOpts{Alg: "unknown"}, 7, score(0.5, 3), post, 3)
+	assert.Equal(t, 0.5, unknown.Score)
+	assert.Zero(t, unknown.Likes)
+	assert.Empty(t, unknown.Formula)
+}
@@ -3,14 +3,21 @@ package feed
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"math"
+	"math/rand/v2"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/strideynet/bsky-furry-feed/bluesky"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
 	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
 	"github.com/strideynet/bsky-furry-feed/tristate"
 )
 
@@ -19,6 +26,9 @@ var feedRequestMetric = promauto.NewSummaryVec(prometheus.SummaryOpts{
 	Help: "A very rudimentary way of tracking how many feed skeletons have been requested and how long it takes to serve.",
 }, []string{"feed_name", "status"})
 
+// ErrUnknownFeed is returned when a feed ID isn't registered.
+var ErrUnknownFeed = errors.New("unrecognized feed")
+
 type Meta struct {
 	// ID is the rkey that is used to identify the Feed in generation requests.
 	ID string
@@ -40,6 +50,7 @@ type Meta struct {
 type feed struct {
 	meta     Meta
 	generate GenerateFunc
+	explain  ExplainFunc
 }
 
 type Post struct {
@@ -53,16 +64,56 @@ type Service struct {
 	// TODO: Locking on feeds to avoid data races
 	feeds map[string]*feed
 	store *store.PGXStore
+	// scoreAlgs are the scoring algorithms read by the pre-scored feeds.
+	scoreAlgs map[string]struct{}
 }
 
-func (s *Service) Register(m Meta, generateFunc GenerateFunc) {
+func (s *Service) Register(m Meta, generateFunc GenerateFunc, explainFunc ExplainFunc) {
 	if s.feeds == nil {
 		s.feeds = map[string]*feed{}
 	}
 	s.feeds[m.ID] = &feed{
 		meta:     m,
 		generate: generateFunc,
+		explain:  explainFunc,
+	}
+}
+
+func (s *Service) registerChronological(m Meta, opts chronologicalGeneratorOpts) {
+	s.Register(m, chronologicalGenerator(opts), chronologicalExplainer(opts))
+}
+
+func (s *Service) registerPreScored(m Meta, opts preScoredGeneratorOpts) {
+	if s.scoreAlgs == nil {
+		s.scoreAlgs = map[string]struct{}{}
+	}
+	s.scoreAlgs[opts.scoreAlg()] = struct{}{}
+	s.Register(m, preScoredGenerator(opts), preScoredExplainer(opts))
+}
+
+func (s *Service) registerBlended(m Meta, children []blendedChild) {
+	s.Register(m, blendedGenerator(children), blendedExplainer(children))
+}
+
+// blendedFeed returns an already registered feed, for mixing into a blended
+// feed.
+func (s *Service) blendedFeed(id string, weight int) blendedChild {
+	f, ok := s.feeds[id]
+	if !ok {
+		panic(fmt.Sprintf("feed %q must be registered before it's blended", id))
 	}
+	return blendedChild{
+		Name:     id,
+		Weight:   weight,
+		Generate: f.generate,
+		Explain:  f.explain,
+	}
+}
+
+// ScoreAlgorithms returns the scoring algorithms the registered feeds read, so
+// that only those are materialized.
+func (s *Service) ScoreAlgorithms() []string {
+	return slices.Sorted(maps.Keys(s.scoreAlgs))
 }
 
 func (s *Service) Metas() []Meta {
@@ -87,17 +138,32 @@ func (s *Service) GetFeedPosts(ctx context.Context, feedKey string, cursor strin
 
 	f, ok := s.feeds[feedKey]
 	if !ok {
-		return nil, fmt.Errorf("unrecognized feed")
+		return nil, ErrUnknownFeed
 	}
 
 	return f.generate(ctx, s.store, cursor, limit)
 }
 
+// ExplainPost reports whether a post passes each of a feed's filters, and
+// for scored feeds, how it was scored.
+func (s *Service) ExplainPost(ctx context.Context, feedKey string, uri string) (*v1.ExplainPostResponse, error) {
+	f, ok := s.feeds[feedKey]
+	if !ok {
+		return nil, ErrUnknownFeed
+	}
+
+	return f.explain(ctx, s.store, uri)
+}
+
 type generatorOpts struct {
 	Hashtags           []string
 	DisallowedHashtags []string
 	IsNSFW             tristate.Tristate
 	AllowedEmbeds      []EmbedType
+	// IsArtist limits the feed to posts by artists, or by non-artists.
+	IsArtist tristate.Tristate
+	// ActorRoles limits the feed to posts by actors with any of these roles.
+	ActorRoles []string
 }
 
 type chronologicalGeneratorOpts struct {
@@ -138,6 +204,8 @@ func chronologicalGenerator(opts chronologicalGeneratorOpts) GenerateFunc {
 			AllowedEmbeds:      allowedEmbeds,
 			PinnedDIDs:         opts.PinnedDIDs,
 			CursorTime:         cursorTime,
+			IsArtist:           opts.IsArtist,
+			ActorRoles:         opts.ActorRoles,
 		}
 
 		storePosts, err := pgxStore.ListPostsForNewFeed(ctx, params)
@@ -159,16 +227,38 @@ func chronologicalGenerator(opts chronologicalGeneratorOpts) GenerateFunc {
 
 type preScoredGeneratorOpts struct {
 	generatorOpts
-	Alg string
+	Alg             string
+	AuthorDiversity authorDiversity
+	// Jitter randomly moves each post's score up or down by up to this
+	// fraction, so that each reader sees a different order. The order is
+	// chosen on the first page, and kept for the rest.
+	Jitter float32
+	// Dampened reads scores from the dampened variant of Alg, which discounts
+	// likes that look like they're gaming the scores. Only "classic" has one.
+	Dampened bool
+}
+
+// scoreAlg is the name of the scoring algorithm the feed reads.
+func (opts preScoredGeneratorOpts) scoreAlg() string {
+	if opts.Dampened {
+		return opts.Alg + "_dampened"
+	}
+	return opts.Alg
+}
+
+// scoredCursor is the position in a scored feed. The diversifierState is only
+// set for feeds with an author diversity limit, and carries the state of the
+// diversifier over to the next page. Seed is only set for feeds with jitter.
+type scoredCursor struct {
+	GenerationSeq int64   `json:"generation_seq"`
+	AfterScore    float32 `json:"after_score"`
+	AfterURI      string  `json:"after_uri"`
+	diversifierState
+	Seed string `json:"seed,omitempty"`
 }
 
 func preScoredGenerator(opts preScoredGeneratorOpts) GenerateFunc {
 	return func(ctx context.Context, pgxStore *store.PGXStore, cursor string, limit int) ([]Post, error) {
-		type cursorValues struct {
-			GenerationSeq int64   `json:"generation_seq"`
-			AfterScore    float32 `json:"after_score"`
-			AfterURI      string  `json:"after_uri"`
-		}
 		allowedEmbeds := []string{}
 		for _, embed := range opts.AllowedEmbeds {
 			allowedEmbeds = append(allowedEmbeds, string(embed))
@@ -179,10 +269,14 @@ func preScoredGenerator(opts preScoredGeneratorOpts) GenerateFunc {
 			DisallowedHashtags: opts.DisallowedHashtags,
 			IsNSFW:             opts.IsNSFW,
 			AllowedEmbeds:      allowedEmbeds,
-			Alg:                opts.Alg,
+			Alg:                opts.scoreAlg(),
+			IsArtist:           opts.IsArtist,
+			ActorRoles:         opts.ActorRoles,
 		}
+		d := diversifier{opts: opts.AuthorDiversity}
+		seed := ""
 		if cursor == "" {
-			seq, err := pgxStore.GetLatestScoreGeneration(ctx, opts.Alg)
+			seq, err := pgxStore.GetLatestScoreGeneration(ctx, opts.scoreAlg())
 			if err != nil {
 				return nil, fmt.Errorf("executing GetLatestScoreGeneration: %w", err)
 			}
@@ -191,8 +285,11 @@ func preScoredGenerator(opts preScoredGeneratorOpts) GenerateFunc {
 				AfterScore:    float32(math.Inf(1)),
 				AfterURI:      "",
 			}
+			if opts.Jitter != 0 {
+				seed = strconv.FormatUint(rand.Uint64(), 36)
+			}
 		} else {
-			var p cursorValues
+			var p scoredCursor
 			if err := json.Unmarshal([]byte(cursor), &p); err != nil {
 				return nil, fmt.Errorf("unmarshaling cursor: %w", err)
 			}
@@ -201,18 +298,53 @@ func preScoredGenerator(opts preScoredGeneratorOpts) GenerateFunc {
 				AfterScore:    p.AfterScore,
 				AfterURI:      p.AfterURI,
 			}
+			if err := d.load(p.diversifierState); err != nil {
+				return nil, fmt.Errorf("loading cursor: %w", err)
+			}
+			seed = p.Seed
 		}
-		storePosts, err := pgxStore.ListScoredPosts(ctx, params)
-		if err != nil {
-			return nil, fmt.Errorf("executing ListPostsForHotFeed: %w", err)
+		params.Seed, params.Jitter = seed, opts.Jitter
+
+		// Posts are fetched a page at a time, although more pages may be
+		// needed if posts are held back by the diversifier. params.Cursor
+		// follows the last post pulled, rather than the last one shown.
+		var (
+			batch     []gen.ListScoredPostsRow
+			exhausted bool
+		)
+		pull := func() (rankedPost, bool, error) {
+			if len(batch) == 0 && !exhausted {
+				var err error
+				batch, err = pgxStore.ListScoredPosts(ctx, params)
+				if err != nil {
+					return rankedPost{}, false, fmt.Errorf("executing ListPostsForHotFeed: %w", err)
+				}
+				exhausted = len(batch) < limit
+			}
+			if len(batch) == 0 {
+				return rankedPost{}, false, nil
+			}
+			p := batch[0]
+			batch = batch[1:]
+			params.Cursor.AfterScore, params.Cursor.AfterURI = p.Score, p.URI
+			return rankedPost{URI: p.URI, ActorDID: p.ActorDID}, true, nil
 		}
 
-		posts := make([]Post, 0, len(storePosts))
-		for _, p := range storePosts {
-			postCursor, err := json.Marshal(cursorValues{
-				GenerationSeq: params.Cursor.GenerationSeq,
-				AfterScore:    p.Score,
-				AfterURI:      p.URI,
+		posts := make([]Post, 0, limit)
+		for len(posts) < limit {
+			p, ok, err := d.next(pull)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			postCursor, err := json.Marshal(scoredCursor{
+				GenerationSeq:    params.Cursor.GenerationSeq,
+				AfterScore:       params.Cursor.AfterScore,
+				AfterURI:         params.Cursor.AfterURI,
+				diversifierState: d.save(),
+				Seed:             seed,
 			})
 			if err != nil {
 				return nil, fmt.Errorf("marshaling cursor: %w", err)
@@ -229,6 +361,10 @@ func preScoredGenerator(opts preScoredGeneratorOpts) GenerateFunc {
 
 var defaultDisallowedHashtags = []string{"ai", "aiart", "aiartist", "aigenerated", "stablediffusion", "sdxl"}
 
+// hotAuthorDiversity stops a single prolific author from taking over the
+// top of the busiest hot feeds.
+var hotAuthorDiversity = authorDiversity{MaxPostsPerAuthor: 2, Window: 10}
+
 // ServiceWithDefaultFeeds instantiates a registry with all the standard
 // bksy-furry-feed feeds.
 // TODO: This really doesn't belong here, ideally, these feeds would be defined
@@ -239,173 +375,200 @@ func ServiceWithDefaultFeeds(pgxStore *store.PGXStore) *Service {
 	}
 
 	// Hot based feeds
-	r.Register(Meta{
+	r.registerPreScored(Meta{
 		ID:          "furry-hot",
 		DisplayName: "🐾 Hot",
 		Description: "Furry\nHottest posts by furries across Bluesky. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
 		Priority:    100,
-	}, preScoredGenerator(preScoredGeneratorOpts{
-		Alg: "classic",
+	}, preScoredGeneratorOpts{
+		Alg:             "classic",
+		AuthorDiversity: hotAuthorDiversity,
 		generatorOpts: generatorOpts{
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerPreScored(Meta{
 		ID:          "hot-nsfw",
 		DisplayName: "🐾 Hot 🌙",
 		Description: "Furry\nHottest NSFW posts by furries across Bluesky. Contains only NSFW content.\n\nJoin the furry feeds by following @furryli.st",
 		Priority:    100,
-	}, preScoredGenerator(preScoredGeneratorOpts{
-		Alg: "classic",
+	}, preScoredGeneratorOpts{
+		Alg:             "classic",
+		AuthorDiversity: hotAuthorDiversity,
 		generatorOpts: generatorOpts{
 			DisallowedHashtags: defaultDisallowedHashtags,
 			IsNSFW:             tristate.True,
 		},
-	}))
+	})
 
 	// Reverse chronological based feeds
-	r.Register(Meta{
+	r.registerChronological(Meta{
 		ID:          "furry-new",
 		DisplayName: "🐾 New",
 		Description: "Furry\nPosts by furries across Bluesky. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
 		Priority:    101,
-	}, chronologicalGenerator(chronologicalGeneratorOpts{}))
-	r.Register(Meta{
+	}, chronologicalGeneratorOpts{})
+	r.registerChronological(Meta{
 		ID:          "furry-fursuit",
 		DisplayName: "🐾 Fursuits",
 		Description: "Furry\nPosts by furries with #fursuit.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           []string{"fursuit", "fursuitfriday"},
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowImageAndVideo,
 		},
-	},
-	))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "fursuit-nsfw",
 		DisplayName: "🐾 Murrsuits 🌙",
 		Description: "Furry\nPosts by furries that have an image and #murrsuit or #fursuit.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           []string{"fursuit", "fursuitfriday", "murrsuit", "mursuit"},
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowImageAndVideo,
 			IsNSFW:             tristate.True,
 		},
-	},
-	))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "fursuit-clean",
 		DisplayName: "🐾 Fursuits 🧼",
 		Description: "Furry\nPosts by furries with #fursuit that haven't been marked NSFW.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           []string{"fursuit", "fursuitfriday"},
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowImageAndVideo,
 			IsNSFW:             tristate.False,
 		},
-	},
-	))
+	})
 	var furryArtHashtags = []string{"furryart"}
-	r.Register(Meta{
+	r.registerChronological(Meta{
 		ID:          "furry-art",
 		DisplayName: "🐾 Art",
 		Description: "Furry\nPosts by furries with #furryart. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           furryArtHashtags,
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowImageAndVideo,
 		},
-	},
-	))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "art-clean",
 		DisplayName: "🐾 Art 🧼",
 		Description: "Furry\nPosts by furries with #furryart and that haven't been marked NSFW.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           furryArtHashtags,
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowImageAndVideo,
 			IsNSFW:             tristate.False,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "art-nsfw",
 		DisplayName: "🐾 Art 🌙",
 		Description: "Furry\nPosts by furries with #furryart and marked NSFW.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           furryArtHashtags,
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowImageAndVideo,
 			IsNSFW:             tristate.True,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerPreScored(Meta{
 		ID:          "art-hot",
 		DisplayName: "🐾 Hot Art",
 		Description: "Furry\nHottest posts by furries with #furryart. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
-	}, preScoredGenerator(preScoredGeneratorOpts{
-		Alg: "classic",
+	}, preScoredGeneratorOpts{
+		Alg:             "classic",
+		AuthorDiversity: hotAuthorDiversity,
 		generatorOpts: generatorOpts{
 			Hashtags:           furryArtHashtags,
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowImageAndVideo,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerPreScored(Meta{
 		ID:          "art-hot-nsfw",
 		DisplayName: "🐾 Hot Art 🌙",
 		Description: "Furry\nHottest posts by furries with #furryart and marked NSFW.\n\nJoin the furry feeds by following @furryli.st",
-	}, preScoredGenerator(preScoredGeneratorOpts{
-		Alg: "classic",
+	}, preScoredGeneratorOpts{
+		Alg:             "classic",
+		AuthorDiversity: hotAuthorDiversity,
 		generatorOpts: generatorOpts{
 			Hashtags:           furryArtHashtags,
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowImageAndVideo,
 			IsNSFW:             tristate.True,
 		},
-	}))
-	r.Register(Meta{
+	})
+
+	// Artist feeds, by actors marked as artists when they were approved.
+	r.registerChronological(Meta{
+		ID:          "artists-new",
+		DisplayName: "🐾 Artists New",
+		Description: "Furry\nLatest image and video posts by furry artists across Bluesky. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
+	}, chronologicalGeneratorOpts{
+		generatorOpts: generatorOpts{
+			DisallowedHashtags: defaultDisallowedHashtags,
+			AllowedEmbeds:      allowImageAndVideo,
+			IsArtist:           tristate.True,
+		},
+	})
+	r.registerPreScored(Meta{
+		ID:          "artists-hot",
+		DisplayName: "🐾 Artists Hot",
+		Description: "Furry\nHottest image and video posts by furry artists across Bluesky. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
+	}, preScoredGeneratorOpts{
+		Alg:             "classic",
+		AuthorDiversity: hotAuthorDiversity,
+		generatorOpts: generatorOpts{
+			DisallowedHashtags: defaultDisallowedHashtags,
+			AllowedEmbeds:      allowImageAndVideo,
+			IsArtist:           tristate.True,
+		},
+	})
+
+	r.registerChronological(Meta{
 		ID:          "furry-nsfw",
 		DisplayName: "🐾 New 🌙",
 		Description: "Furry\nPosts by furries that have been marked NSFW.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowImageAndVideo,
 			IsNSFW:             tristate.True,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "furry-comms",
 		DisplayName: "🐾 #CommsOpen",
 		Description: "Furry\nPosts by furries that have #commsopen.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           []string{"commsopen"},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "con-denfur",
 		DisplayName: "🐾 DenFur 2024",
 		Description: "Furry\nA feed for all things DenFur! Use #denfur or #denfur2024 to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           []string{"denfur", "denfur2024"},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "con-eurofurence",
 		DisplayName: "🐾 Eurofurence 2024",
 		Description: "Furry\nA feed for all things Eurofurence! Use #eurofurence, #eurofurence2024, #eurofurence28, #ef, #ef2024, or #ef28 to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags: []string{
 				"eurofurence", "ef",
@@ -416,36 +579,36 @@ func ServiceWithDefaultFeeds(pgxStore *store.PGXStore) *Service {
 			},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "con-blfc",
 		DisplayName: "🐾 BLFC 2024",
 		Description: "Furry\nA feed for all things BLFC! Use #blfc, #blfc24, or #blfc2024 to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags: []string{
 				"blfc", "blfc24", "blfc2024",
 			},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "con-mff",
 		DisplayName: "🐾 MFF 2024",
 		Description: "Furry\nA feed for all things MFF! Use #furfest, #mff, #mff24, or #mff2024 to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags: []string{
 				"furfest", "furfest24", "furfest2024", "mff", "mff24", "mff2024",
 			},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "con-fc",
 		DisplayName: "🐾 FC 2025",
 		Description: "Furry\nA feed for all things FC! Use #fc, #fc25, or #fc2025 to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags: []string{
 				"fc", "fc25", "fc2025", "furcon25", "furcon2025",
@@ -453,12 +616,12 @@ func ServiceWithDefaultFeeds(pgxStore *store.PGXStore) *Service {
 			},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "con-nfc",
 		DisplayName: "🐾 NFC 2025",
 		Description: "Furry\nA feed for all things NFC! Use #nfc, #nfc25, or #nfc2025 to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags: []string{
 				"nfc", "nfc25", "nfc2025",
@@ -466,24 +629,24 @@ func ServiceWithDefaultFeeds(pgxStore *store.PGXStore) *Service {
 			},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "con-fwa",
 		DisplayName: "🐾 FWA 2025",
 		Description: "Furry\nA feed for all things FWA! Use #fwa, #fwa25, #fwa2025, or #furryweekend to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags: []string{
 				"fwa", "fwa25", "fwa2025", "furryweekend",
 			},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "con-ac",
 		DisplayName: "🐾 Anthrocon 2024",
 		Description: "Furry\nA feed for all things Anthrocon! Use #anthrocon, #anthrocon2024, or #ac to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags: []string{
 				"anthrocon", "anthrocon2024", "anthrocon24",
@@ -491,42 +654,42 @@ func ServiceWithDefaultFeeds(pgxStore *store.PGXStore) *Service {
 			},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "merch",
 		DisplayName: "🐾 #FurSale",
 		Description: "Furry\nBuy and sell furry merch on the FurSale feed. Use #fursale or #merch to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           []string{"fursale", "merch"},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "streamers",
 		DisplayName: "🐾 Streamers",
 		Description: "Furry\nFind furs going live on streaming platforms. Use #goinglive or #furrylive to include a post in the feed.\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           []string{"goinglive", "furrylive"},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "games",
 		DisplayName: "🐾 Games",
 		Description: "Furry\nA feed for talking about and showing off furry visual novels and games. Use #FurryVN or #FurryGame to include a post in the feed. \n\nSponsored by @MinoHotel.bsky.social\n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags:           []string{"furryvn", "furrygames", "furrygame"},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "literature",
 		DisplayName: "🐾 Literature",
 		Description: "Furry\nA feed for talking about and showing off furry literature. Use #FurFic, #FurLit or #FurryWriting to include a post in the feed. \n\nJoin the furry feeds by following @furryli.st",
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			Hashtags: []string{
 				"furfic",
@@ -539,69 +702,109 @@ func ServiceWithDefaultFeeds(pgxStore *store.PGXStore) *Service {
 			},
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerPreScored(Meta{
 		ID:          "furry-test",
 		DisplayName: "🐾 Test 🚨🛠️",
 		Description: "Experimental version of the '🐾 Hot' feed.\ntest\ntest\n\ndouble break",
 		Priority:    -1,
-	}, preScoredGenerator(preScoredGeneratorOpts{
+	}, preScoredGeneratorOpts{
 		Alg: "classic",
 		generatorOpts: generatorOpts{
 			DisallowedHashtags: defaultDisallowedHashtags,
 		},
-	}))
+	})
 
-	r.Register(Meta{
+	r.registerPreScored(Meta{
 		ID:          "video-hot",
 		DisplayName: "🐾 Hot videos",
 		Description: "Furry\nHottest video posts by furries across Bluesky. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
 		Priority:    100,
 		VideoOnly:   true,
-	}, preScoredGenerator(preScoredGeneratorOpts{
+	}, preScoredGeneratorOpts{
 		Alg: "classic",
 		generatorOpts: generatorOpts{
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowVideoOnly,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "video-new",
 		DisplayName: "🐾 New videos",
 		Description: "Furry\nLatest video posts by furries across Bluesky. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
 		VideoOnly:   true,
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowVideoOnly,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerPreScored(Meta{
 		ID:          "video-hot-nsfw",
 		DisplayName: "🐾 Hot videos 🌙",
 		Description: "Furry\nHottest NSFW video posts by furries across Bluesky. Contains only NSFW content.\n\nJoin the furry feeds by following @furryli.st",
 		Priority:    100,
 		VideoOnly:   true,
-	}, preScoredGenerator(preScoredGeneratorOpts{
+	}, preScoredGeneratorOpts{
 		Alg: "classic",
 		generatorOpts: generatorOpts{
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowVideoOnly,
 			IsNSFW:             tristate.True,
 		},
-	}))
-	r.Register(Meta{
+	})
+	r.registerChronological(Meta{
 		ID:          "video-new-nsfw",
 		DisplayName: "🐾 New videos 🌙",
 		Description: "Furry\nLatest NSFW video posts by furries across Bluesky. Contains only NSFW content.\n\nJoin the furry feeds by following @furryli.st",
 		VideoOnly:   true,
-	}, chronologicalGenerator(chronologicalGeneratorOpts{
+	}, chronologicalGeneratorOpts{
 		generatorOpts: generatorOpts{
 			DisallowedHashtags: defaultDisallowedHashtags,
 			AllowedEmbeds:      allowVideoOnly,
 			IsNSFW:             tristate.True,
 		},
-	}))
+	})
+
+	// Discovery feeds
+	r.registerPreScored(Meta{
+		ID:          "furry-discover",
+		DisplayName: "🐾 Discover",
+		Description: "Furry\nPosts by furries across Bluesky that haven't had much love yet. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
+		Priority:    100,
+	}, preScoredGeneratorOpts{
+		Alg:             "discovery",
+		AuthorDiversity: hotAuthorDiversity,
+		Jitter:          0.3,
+		generatorOpts: generatorOpts{
+			DisallowedHashtags: defaultDisallowedHashtags,
+			AllowedEmbeds:      []EmbedType{EmbedImage},
+		},
+	})
+
+	// Blended feeds. These must be registered after the feeds they blend.
+	sampledOpts := chronologicalGeneratorOpts{
+		generatorOpts: generatorOpts{
+			DisallowedHashtags: defaultDisallowedHashtags,
+			AllowedEmbeds:      allowImageAndVideo,
+		},
+	}
+	r.registerBlended(Meta{
+		ID:          "furry-for-you",
+		DisplayName: "🐾 For You",
+		Description: "Furry\nA mix of hot, new and hot art posts by furries across Bluesky, with a few older posts picked at random. Contains a mix of SFW and NSFW content.\n\nJoin the furry feeds by following @furryli.st",
+		Priority:    100,
+	}, []blendedChild{
+		r.blendedFeed("furry-hot", 4),
+		r.blendedFeed("furry-new", 2),
+		r.blendedFeed("art-hot", 2),
+		{
+			Name:     "sampled",
+			Weight:   1,
+			Generate: sampledGenerator(chronologicalGenerator(sampledOpts), 0.1),
+			Explain:  sampledExplainer(chronologicalExplainer(sampledOpts), 0.1),
+		},
+	})
 
 	return r
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/bluesky-social/indigo/api/bsky"
 	indigoTest "github.com/bluesky-social/indigo/testing"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	bffv1pb "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
 	"github.com/strideynet/bsky-furry-feed/store"
@@ -266,7 +267,7 @@ func TestGenerator(t *testing.T) {
 	t.Run("prescored", func(t *testing.T) {
 		t.Parallel()
 
-		_, err = harness.Store.MaterializeClassicPostScores(ctx, time.Time{})
+		_, err = harness.Store.RescoreClassicPostScores(ctx, time.Time{}, 0.05)
 		require.NoError(t, err)
 
 		for _, test := range []struct {
@@ -386,3 +387,15 @@ func TestGenerator(t *testing.T) {
 		}
 	})
 }
+
+func TestService_ScoreAlgorithms(t *testing.T) {
+	t.Parallel()
+
+	// Only the algorithms read by the default feeds should be materialized.
+	assert.Equal(t, []string{"classic", "discovery"}, ServiceWithDefaultFeeds(nil).ScoreAlgorithms())
+
+	// Feeds which opt into dampening read the dampened variant instead.
+	s := &Service{}
+	s.registerPreScored(Meta{ID: "dampened"}, preScoredGeneratorOpts{Alg: "classic", Dampened: true})
+	assert.Equal(t, []string{"classic_dampened"}, s.ScoreAlgorithms())
+}
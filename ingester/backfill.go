@@ -0,0 +1,180 @@
+package ingester
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/bluesky-social/indigo/repo"
+	"github.com/ipfs/go-cid"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/bluesky"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+// DefaultBackfillWindow is how far back a backfill reaches into an actor's
+// repo.
+const DefaultBackfillWindow = 7 * 24 * time.Hour
+
+// backfillCollections are the collections replayed during a backfill. Profiles
+// are excluded as the worker already fetches these on approval.
+var backfillCollections = []string{
+	"app.bsky.feed.post",
+	"app.bsky.feed.like",
+	"app.bsky.graph.follow",
+}
+
+type repoGetter interface {
+	SyncGetRepo(ctx context.Context, actorDID string) (*repo.Repo, error)
+}
+
+// Backfiller fetches the repo of a newly approved actor and replays their
+// recent records through the same handlers used for live ingestion. Without
+// this, only records created after approval reach the feeds.
+//
+// Replays are idempotent, as inserting an already ingested record is a no-op.
+type Backfiller struct {
+	log        *slog.Logger
+	store      *store.PGXStore
+	repoGetter repoGetter
+	window     time.Duration
+}
+
+func NewBackfiller(
+	log *slog.Logger, store *store.PGXStore, repoGetter repoGetter,
+) *Backfiller {
+	return &Backfiller{
+		log:        log,
+		store:      store,
+		repoGetter: repoGetter,
+		window:     DefaultBackfillWindow,
+	}
+}
+
+// staticActorCache satisfies actorCacher for the single actor being
+// backfilled.
+type staticActorCache struct {
+	actor *v1.Actor
+}
+
+func (s staticActorCache) GetByDID(did string) *v1.Actor {
+	if s.actor.Did != did {
+		return nil
+	}
+	return s.actor
+}
+
+func (s staticActorCache) CreatePendingCandidateActor(_ context.Context, _ string) error {
+	return nil
+}
+
+// BackfillActor replays the posts, likes and follows an approved actor has
+// created within the backfill window.
+func (b *Backfiller) BackfillActor(ctx context.Context, actorDID string) (err error) {
+	ctx, span := tracer.Start(ctx, "backfiller.backfill_actor")
+	defer func() {
+		endSpan(span, err)
+	}()
+	span.SetAttributes(actorDIDAttr(actorDID))
+	log := b.log.With(bfflog.ActorDID(actorDID))
+
+	actor, err := b.store.GetActorByDID(ctx, actorDID)
+	if err != nil {
+		return fmt.Errorf("getting actor: %w", err)
+	}
+	if actor.Status != v1.ActorStatus_ACTOR_STATUS_APPROVED {
+		log.Info("skipping backfill as actor is no longer approved")
+		return nil
+	}
+
+	rr, err := b.repoGetter.SyncGetRepo(ctx, actorDID)
+	if errors.Is(err, bluesky.ErrRepoTooLarge) {
+		// Retrying won't help, and their new records are still ingested.
+		log.Warn("skipping backfill as repo is too large", bfflog.Err(err))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting repo: %w", err)
+	}
+
+	records, err := recentRecords(ctx, rr, time.Now().Add(-b.window))
+	if err != nil {
+		return fmt.Errorf("reading records: %w", err)
+	}
+
+	fi := &FirehoseIngester{
+		log:        log,
+		actorCache: staticActorCache{actor: actor},
+		store:      b.store,
+	}
+	for _, r := range records {
+		uri := fmt.Sprintf("at://%s/%s", actorDID, r.path)
+		if err := fi.handleRecordCreate(ctx, actorDID, uri, r.collection, r.record); err != nil {
+			return fmt.Errorf("handling record create (%s): %w", uri, err)
+		}
+	}
+
+	log.Info("backfilled actor", slog.Int("records", len(records)))
+	return nil
+}
+
+type backfillRecord struct {
+	collection string
+	// path is the collection and rkey, e.g "app.bsky.feed.post/3k...".
+	path   string
+	record json.RawMessage
+}
+
+// recentRecords returns the records from the backfill collections whose
+// TID record key is at or after since. Records with non-TID keys are skipped
+// as their age cannot be determined without decoding them.
+func recentRecords(
+	ctx context.Context, rr *repo.Repo, since time.Time,
+) ([]backfillRecord, error) {
+	var out []backfillRecord
+	for _, collection := range backfillCollections {
+		prefix := collection + "/"
+		err := rr.ForEach(ctx, prefix, func(k string, _ cid.Cid) error {
+			// ForEach walks from the prefix to the end of the repo, so we
+			// stop once we've left the collection.
+			if !strings.HasPrefix(k, prefix) {
+				return repo.ErrDoneIterating
+			}
+
+			tid, err := syntax.ParseTID(strings.TrimPrefix(k, prefix))
+			if err != nil {
+				return nil
+			}
+			if tid.Time().Before(since) {
+				return nil
+			}
+
+			_, rec, err := rr.GetRecord(ctx, k)
+			if err != nil {
+				return fmt.Errorf("getting record (%s): %w", k, err)
+			}
+			raw, err := json.Marshal(rec)
+			if err != nil {
+				return fmt.Errorf("marshalling record (%s): %w", k, err)
+			}
+			out = append(out, backfillRecord{
+				collection: collection,
+				path:       k,
+				record:     raw,
+			})
+			return nil
+		})
+		// The MST wraps ErrDoneIterating, so ForEach doesn't recognise it.
+		if err != nil && !errors.Is(err, repo.ErrDoneIterating) {
+			return nil, fmt.Errorf("iterating %s: %w", collection, err)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,59 @@
+package ingester
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/bluesky-social/indigo/repo"
+	"github.com/ipfs/go-datastore"
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_recentRecords(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Now()
+	since := now.Add(-DefaultBackfillWindow)
+	recentTID := syntax.NewTID(now.Add(-time.Hour).UnixMicro(), 0).String()
+	oldTID := syntax.NewTID(now.Add(-30*24*time.Hour).UnixMicro(), 0).String()
+
+	rr := repo.NewRepo(ctx, "did:plc:test", blockstore.NewBlockstore(datastore.NewMapDatastore()))
+	put := func(path string, rec repo.CborMarshaler) {
+		_, err := rr.PutRecord(ctx, path, rec)
+		require.NoError(t, err)
+	}
+	put("app.bsky.actor.profile/self", &bsky.ActorProfile{})
+	put("app.bsky.feed.like/"+recentTID, &bsky.FeedLike{CreatedAt: "2024-01-01T00:00:00Z"})
+	put("app.bsky.feed.post/"+oldTID, &bsky.FeedPost{Text: "old"})
+	put("app.bsky.feed.post/"+recentTID, &bsky.FeedPost{Text: "recent"})
+	put("app.bsky.feed.post/not-a-tid", &bsky.FeedPost{Text: "custom rkey"})
+	put("app.bsky.feed.repost/"+recentTID, &bsky.FeedRepost{})
+	_, _, err := rr.Commit(ctx, func(context.Context, string, []byte) ([]byte, error) {
+		return []byte("sig"), nil
+	})
+	require.NoError(t, err)
+
+	got, err := recentRecords(ctx, rr, since)
+	require.NoError(t, err)
+
+	paths := []string{}
+	for _, r := range got {
+		paths = append(paths, r.path)
+	}
+	assert.Equal(t, []string{
+		"app.bsky.feed.post/" + recentTID,
+		"app.bsky.feed.like/" + recentTID,
+	}, paths)
+
+	post := &bsky.FeedPost{}
+	require.NoError(t, json.Unmarshal(got[0].record, post))
+	assert.Equal(t, "recent", post.Text)
+	assert.Equal(t, "app.bsky.feed.post", got[0].collection)
+}
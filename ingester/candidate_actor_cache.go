@@ -2,25 +2,55 @@ package ingester
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
 	"time"
 
 	"github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/strideynet/bsky-furry-feed/bfflog"
 	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
 	"github.com/strideynet/bsky-furry-feed/store"
 )
 
-// ActorCache holds a view of the candidate actors from
-// the database, refreshing itself every minute. It's designed to be safely
-// called concurrently. This prevents us needing to hit the database for every
-// event which would produce significant load on the db and also increase the
-// amount of time it takes to handle an event we aren't interested in.
-// The only downside to this approach is that it takes up to a minute for
-// new candidate repositories to be monitored.
+var actorCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "bff_ingester_actor_cache_actors",
+	Help: "The number of candidate actors held in the actor cache.",
+})
+
+var actorCacheLastSync = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "bff_ingester_actor_cache_last_sync_timestamp_seconds",
+	Help: "The time the actor cache was last fully refreshed from the database.",
+})
+
+var actorCacheListening = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "bff_ingester_actor_cache_listening",
+	Help: "Whether the actor cache is listening for changes to actors.",
+})
+
+var actorCacheChanges = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bff_ingester_actor_cache_changes_total",
+	Help: "The total number of actor change notifications handled by the actor cache, by result.",
+}, []string{"result"})
+
+var actorCacheChangeLag = promauto.NewHistogram(prometheus.HistogramOpts{
+	Name: "bff_ingester_actor_cache_change_lag_seconds",
+	Help: "The time between an actor being changed in the database and the change being applied to the actor cache.",
+})
+
+// ActorCache holds a view of the candidate actors from the database. It's
+// designed to be safely called concurrently. This prevents us needing to hit
+// the database for every event which would produce significant load on the db
+// and also increase the amount of time it takes to handle an event we aren't
+// interested in.
+//
+// Changes to actors are pushed to the cache by Postgres notifications, so
+// they usually take effect within a second. As notifications can be missed
+// whilst reconnecting, the whole cache is also periodically refreshed.
 //
 // TODO: Move this to the store as a wrapper around *store.PGXStore ?
 type ActorCache struct {
@@ -29,9 +59,12 @@ type ActorCache struct {
 
 	clock clockwork.Clock
 
-	// period is how often to attempt to fresh the list of candidate
-	// actors.
+	// period is how often to attempt to refresh the entire list of candidate
+	// actors. This is a safety net for any changes we weren't notified of.
 	period time.Duration
+	// reconnectDelay is how long to wait before listening for changes again
+	// after the connection fails.
+	reconnectDelay time.Duration
 	// refreshTimeout is how long to give any attempt to complete. This is
 	// necessary to prevent a hung iteration from blocking the loop.
 	// Realistically, we don't expect this process to take any longer than
@@ -44,6 +77,9 @@ type ActorCache struct {
 	cached map[string]*v1.Actor
 	// mu protects cached to prevent concurrent access leading to corruption.
 	mu sync.RWMutex
+	// syncMu serializes full syncs with the handling of changes, so that a
+	// sync which started before a change can't overwrite it with stale data.
+	syncMu sync.Mutex
 }
 
 func NewActorCache(
@@ -54,7 +90,8 @@ func NewActorCache(
 		store:          store,
 		clock:          clockwork.NewRealClock(),
 		log:            log,
-		period:         time.Minute,
+		period:         time.Minute * 10,
+		reconnectDelay: time.Second * 5,
 		refreshTimeout: time.Second * 10,
 	}
 }
@@ -71,7 +108,11 @@ func (crc *ActorCache) GetByDID(
 	return nil
 }
 
+// Sync replaces the contents of the cache with every candidate actor.
 func (crc *ActorCache) Sync(ctx context.Context) error {
+	crc.syncMu.Lock()
+	defer crc.syncMu.Unlock()
+
 	crc.log.Info("starting cache sync")
 	data, err := crc.store.ListActors(ctx, store.ListActorsOpts{})
 	if err != nil {
@@ -86,11 +127,22 @@ func (crc *ActorCache) Sync(ctx context.Context) error {
 	crc.mu.Lock()
 	defer crc.mu.Unlock()
 	crc.cached = mapped
+	actorCacheSize.Set(float64(len(mapped)))
+	actorCacheLastSync.SetToCurrentTime()
 	crc.log.Info("finished cache sync", slog.Int("count", len(mapped)))
 	return nil
 }
 
+// Start keeps the cache up to date until ctx is cancelled. Sync should be
+// called first to fill the cache.
 func (crc *ActorCache) Start(ctx context.Context) error {
+	listenDone := make(chan struct{})
+	go func() {
+		defer close(listenDone)
+		crc.listen(ctx)
+	}()
+	defer func() { <-listenDone }()
+
 	ticker := crc.clock.NewTicker(crc.period)
 	defer ticker.Stop()
 	for {
@@ -109,6 +161,77 @@ func (crc *ActorCache) Start(ctx context.Context) error {
 	}
 }
 
+// listen applies changes to actors as we're notified of them, reconnecting
+// until ctx is cancelled.
+func (crc *ActorCache) listen(ctx context.Context) {
+	for {
+		err := crc.store.ListenForActorChanges(
+			ctx,
+			func() {
+				actorCacheListening.Set(1)
+				// We may have missed changes whilst we weren't listening.
+				ctx, cancel := context.WithTimeout(ctx, crc.refreshTimeout)
+				defer cancel()
+				if err := crc.Sync(ctx); err != nil {
+					crc.log.Error("failed to fill cache", bfflog.Err(err))
+				}
+			},
+			func(change store.ActorChange) {
+				crc.applyChange(ctx, change)
+			},
+		)
+		actorCacheListening.Set(0)
+		if ctx.Err() != nil {
+			return
+		}
+		crc.log.Warn(
+			"failed to listen for actor changes, retrying",
+			slog.Duration("delay", crc.reconnectDelay),
+			bfflog.Err(err),
+		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-crc.clock.After(crc.reconnectDelay):
+		}
+	}
+}
+
+// applyChange refreshes a single actor that we've been notified has changed.
+// If this fails, the actor is stale until the next full sync.
+func (crc *ActorCache) applyChange(ctx context.Context, change store.ActorChange) {
+	crc.syncMu.Lock()
+	defer crc.syncMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, crc.refreshTimeout)
+	defer cancel()
+	log := crc.log.With(bfflog.ActorDID(change.DID))
+	actor, err := crc.store.GetActorByDID(ctx, change.DID)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		actorCacheChanges.WithLabelValues("error").Inc()
+		log.Error("failed to refresh changed actor", bfflog.Err(err))
+		return
+	}
+
+	crc.mu.Lock()
+	if crc.cached == nil {
+		crc.cached = map[string]*v1.Actor{}
+	}
+	if actor == nil {
+		delete(crc.cached, change.DID)
+	} else {
+		crc.cached[change.DID] = actor
+	}
+	actorCacheSize.Set(float64(len(crc.cached)))
+	crc.mu.Unlock()
+
+	actorCacheChanges.WithLabelValues("ok").Inc()
+	if !change.ChangedAt.IsZero() {
+		actorCacheChangeLag.Observe(time.Since(change.ChangedAt).Seconds())
+	}
+	log.Debug("refreshed changed actor")
+}
+
 func (crc *ActorCache) CreatePendingCandidateActor(ctx context.Context, did string) (err error) {
 	ctx, span := tracer.Start(ctx, "actor_cache.create_pending_actor")
 	defer func() {
@@ -128,5 +251,6 @@ func (crc *ActorCache) CreatePendingCandidateActor(ctx context.Context, did stri
 	crc.mu.Lock()
 	defer crc.mu.Unlock()
 	crc.cached[ca.Did] = ca
+	actorCacheSize.Set(float64(len(crc.cached)))
 	return nil
 }
@@ -72,16 +72,8 @@ func TestCandidateActorCache(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	// Ensure this doesn't take immediate effect
-	got = cac.GetByDID(createdDID)
-	require.NotNil(t, got)
-	require.Equal(t, createdDID, got.Did)
-	require.Equal(t, bffv1pb.ActorStatus_ACTOR_STATUS_PENDING, got.Status)
-
-	// Magically advance time
-	clock.Advance(time.Minute * 2)
-
-	// Ensure that within a few milliseconds this changes to approved
+	// Ensure that this is pushed to the cache without waiting for a full
+	// sync.
 	require.EventuallyWithT(t, func(t *assert.CollectT) {
 		got = cac.GetByDID(createdDID)
 		if !assert.NotNil(t, got) {
@@ -91,6 +83,16 @@ func TestCandidateActorCache(t *testing.T) {
 		assert.Equal(t, bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED, got.Status)
 	}, time.Second, time.Millisecond*100)
 
+	// Create an actor behind the cache's back, this should also be picked up.
+	_, err = pgxStore.CreateActor(ctx, store.CreateActorOpts{
+		Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+		DID:    "created-elsewhere",
+	})
+	require.NoError(t, err)
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		assert.NotNil(t, cac.GetByDID("created-elsewhere"))
+	}, time.Second, time.Millisecond*100)
+
 	// Check shut down is clean (e.g it doesn't hang)
 	cacStop()
 	select {
@@ -0,0 +1,287 @@
+package ingester
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bluesky-social/jetstream/pkg/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+)
+
+const (
+	// deadLetterMaxAttempts is the number of times an event is handled,
+	// including the first, before we give up on it.
+	deadLetterMaxAttempts  = 8
+	deadLetterInitialDelay = time.Minute
+	deadLetterMaxDelay     = 6 * time.Hour
+	// deadLetterLease is how long a claimed event is left alone by other
+	// ingesters whilst it is retried.
+	deadLetterLease     = 5 * time.Minute
+	deadLetterBatchSize = 100
+)
+
+var deadLetterEventsWritten = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "bff_ingester_dead_letter_events_written_total",
+	Help: "The total number of events written to the dead letter table after failing to be handled.",
+})
+
+var deadLetterRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bff_ingester_dead_letter_retries_total",
+	Help: "The total number of retries of dead lettered events, by result.",
+}, []string{"result"})
+
+var deadLetterEvents = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bff_ingester_dead_letter_events",
+	Help: "The number of events in the dead letter table, by whether they will be retried.",
+}, []string{"state"})
+
+// deadLetterRetryDelay returns how long to wait before retrying an event which
+// has been attempted the given number of times.
+func deadLetterRetryDelay(attempts int32) time.Duration {
+	delay := deadLetterInitialDelay
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= deadLetterMaxDelay {
+			return deadLetterMaxDelay
+		}
+	}
+	return delay
+}
+
+// deadLetter records an event which failed to be handled, so it can be retried
+// later rather than lost.
+func (fi *FirehoseIngester) deadLetter(
+	ctx context.Context, evt *models.Event, handleErr error,
+) error {
+	raw, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("marshaling event: %w", err)
+	}
+	if err := fi.store.CreateDeadLetterEvent(
+		ctx, evt.Did, raw, handleErr, fi.clock.Now().Add(deadLetterRetryDelay(1)),
+	); err != nil {
+		return fmt.Errorf("creating dead letter event: %w", err)
+	}
+	deadLetterEventsWritten.Inc()
+	return nil
+}
+
+// errEarlierEventDeadLettered is recorded against events which were dead
+// lettered because an earlier event for the same repo is awaiting a retry.
+var errEarlierEventDeadLettered = errors.New("earlier event for repo is awaiting a retry")
+
+// deadLetteredRepos tracks the repos with dead lettered events awaiting a
+// retry. Later events for these repos are dead lettered behind them, so that a
+// repo's events are always handled in order, e.g. a post is not created by a
+// retry after it was deleted.
+type deadLetteredRepos struct {
+	mu    sync.Mutex
+	repos map[string]bool
+	// changed records the repos added or removed since a refresh started,
+	// which take precedence over what the refresh read.
+	changed map[string]bool
+}
+
+func newDeadLetteredRepos() *deadLetteredRepos {
+	return &deadLetteredRepos{repos: map[string]bool{}}
+}
+
+func (d *deadLetteredRepos) has(repo string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.repos[repo]
+}
+
+func (d *deadLetteredRepos) set(repo string, pending bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if pending {
+		d.repos[repo] = true
+	} else {
+		delete(d.repos, repo)
+	}
+	if d.changed != nil {
+		d.changed[repo] = pending
+	}
+}
+
+// beginRefresh must be called before reading the repos from the database, so
+// that changes made whilst reading aren't lost by endRefresh.
+func (d *deadLetteredRepos) beginRefresh() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.changed = map[string]bool{}
+}
+
+func (d *deadLetteredRepos) endRefresh(repos []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.repos = map[string]bool{}
+	for _, repo := range repos {
+		d.repos[repo] = true
+	}
+	for repo, pending := range d.changed {
+		if pending {
+			d.repos[repo] = true
+		} else {
+			delete(d.repos, repo)
+		}
+	}
+	d.changed = nil
+}
+
+// retryDeadLetters periodically queues a retry of the dead lettered events of
+// the owned repos which are due, until ctx is cancelled. The retries should be
+// handled by the repo's worker, so that they're handled in order with the
+// repo's live events.
+func (fi *FirehoseIngester) retryDeadLetters(
+	ctx context.Context,
+	deadLettered *deadLetteredRepos,
+	owns func(repo string) bool,
+	queueRetry func(ctx context.Context, repo string) error,
+) error {
+	for {
+		if err := fi.queueDueDeadLetters(ctx, deadLettered, owns, queueRetry); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			fi.log.Error("failed to queue dead letter event retries", bfflog.Err(err))
+		}
+		fi.updateDeadLetterMetrics(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-fi.clock.After(fi.deadLetterRetryInterval):
+		}
+	}
+}
+
+// queueDueDeadLetters refreshes which of the owned repos have dead lettered
+// events, and queues a retry of those whose oldest event is due.
+func (fi *FirehoseIngester) queueDueDeadLetters(
+	ctx context.Context,
+	deadLettered *deadLetteredRepos,
+	owns func(repo string) bool,
+	queueRetry func(ctx context.Context, repo string) error,
+) error {
+	deadLettered.beginRefresh()
+	actors, err := fi.store.ListRetryingDeadLetterActors(ctx)
+	if err != nil {
+		deadLettered.endRefresh(nil)
+		return fmt.Errorf("listing actors: %w", err)
+	}
+
+	repos := []string{}
+	due := []string{}
+	now := fi.clock.Now()
+	for _, actor := range actors {
+		if !owns(actor.ActorDID) {
+			continue
+		}
+		repos = append(repos, actor.ActorDID)
+		if !actor.NextAttemptAt.Time.After(now) {
+			due = append(due, actor.ActorDID)
+		}
+	}
+	deadLettered.endRefresh(repos)
+
+	for _, repo := range due {
+		if err := queueRetry(ctx, repo); err != nil {
+			return fmt.Errorf("queueing retry: %w", err)
+		}
+	}
+	return nil
+}
+
+// retryRepoDeadLetters retries a repo's dead lettered events, oldest first. It
+// stops at the first which fails, as the later events must wait for it. It
+// must only be called from the repo's worker.
+func (fi *FirehoseIngester) retryRepoDeadLetters(
+	ctx context.Context, deadLettered *deadLetteredRepos, repo string,
+) error {
+	claimed, err := fi.store.ClaimDeadLetterEventsForActor(ctx, repo, deadLetterBatchSize, deadLetterLease)
+	if err != nil {
+		return fmt.Errorf("claiming events: %w", err)
+	}
+
+	for _, dle := range claimed {
+		log := fi.log.With(
+			slog.Int64("dead_letter_event_id", dle.ID),
+			bfflog.ActorDID(dle.ActorDID),
+		)
+
+		handleErr := fi.handleDeadLetterEvent(ctx, dle.Event)
+		if handleErr == nil {
+			deadLetterRetries.WithLabelValues("success").Inc()
+			log.Info("handled dead letter event")
+			if err := fi.store.DeleteDeadLetterEvent(ctx, dle.ID); err != nil {
+				// Carrying on would handle the later events before this
+				// one is handled again when its claim expires.
+				return fmt.Errorf("deleting handled event: %w", err)
+			}
+			continue
+		}
+		if ctx.Err() != nil {
+			// The claim will expire, and the event be retried by the next
+			// ingester to start.
+			return ctx.Err()
+		}
+
+		deadLetterRetries.WithLabelValues("failure").Inc()
+		attempts := dle.Attempts + 1
+		var nextAttemptAt time.Time
+		if attempts < deadLetterMaxAttempts {
+			nextAttemptAt = fi.clock.Now().Add(deadLetterRetryDelay(attempts))
+		}
+		log.Error(
+			"failed to handle dead letter event",
+			bfflog.Err(handleErr),
+			slog.Int("attempts", int(attempts)),
+			slog.Time("next_attempt_at", nextAttemptAt),
+		)
+		// The later events remain claimed, and are retried after this one,
+		// or once their claim expires if this has exhausted its attempts.
+		if err := fi.store.MarkDeadLetterEventFailed(ctx, dle.ID, handleErr, nextAttemptAt); err != nil {
+			return fmt.Errorf("marking event failed: %w", err)
+		}
+		return nil
+	}
+
+	// If nothing was claimed, the oldest event wasn't due after all. If a
+	// whole batch was handled, there may be more events left, which are
+	// retried the next time they're due.
+	if len(claimed) > 0 && len(claimed) < deadLetterBatchSize {
+		deadLettered.set(repo, false)
+	}
+	return nil
+}
+
+func (fi *FirehoseIngester) handleDeadLetterEvent(ctx context.Context, raw []byte) error {
+	evt := &models.Event{}
+	if err := json.Unmarshal(raw, evt); err != nil {
+		return fmt.Errorf("unmarshaling event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fi.workItemTimeout)
+	defer cancel()
+	return fi.handleEvent(ctx, evt)
+}
+
+func (fi *FirehoseIngester) updateDeadLetterMetrics(ctx context.Context) {
+	counts, err := fi.store.CountDeadLetterEvents(ctx)
+	if err != nil {
+		fi.log.Error("failed to count dead letter events", bfflog.Err(err))
+		return
+	}
+	deadLetterEvents.WithLabelValues("retrying").Set(float64(counts.Retrying))
+	deadLetterEvents.WithLabelValues("exhausted").Set(float64(counts.Exhausted))
+}
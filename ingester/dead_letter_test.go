@@ -0,0 +1,204 @@
+package ingester
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/jetstream/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bffv1pb "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+func Test_deadLetterRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Minute, deadLetterRetryDelay(1))
+	assert.Equal(t, 2*time.Minute, deadLetterRetryDelay(2))
+	assert.Equal(t, 4*time.Minute, deadLetterRetryDelay(3))
+	assert.Equal(t, deadLetterMaxDelay, deadLetterRetryDelay(deadLetterMaxAttempts*2))
+}
+
+func TestFirehoseIngester_retryRepoDeadLetters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	const actorDID = "did:plc:furry"
+	_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+		Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+		DID:    actorDID,
+	})
+	require.NoError(t, err)
+	cac := NewActorCache(slog.Default(), harness.Store)
+	require.NoError(t, cac.Sync(ctx))
+	fi := NewFirehoseIngester(slog.Default(), harness.Store, cac)
+	deadLettered := newDeadLetteredRepos()
+
+	postEvent := func(rkey string, record json.RawMessage) []byte {
+		raw, err := json.Marshal(&models.Event{
+			Did:    actorDID,
+			TimeUS: time.Now().UnixMicro(),
+			Kind:   models.EventKindCommit,
+			Commit: &models.Commit{
+				Operation:  models.CommitOperationCreate,
+				Collection: "app.bsky.feed.post",
+				RKey:       rkey,
+				Record:     record,
+			},
+		})
+		require.NoError(t, err)
+		return raw
+	}
+	goodRecord, err := json.Marshal(&bsky.FeedPost{
+		LexiconTypeID: "app.bsky.feed.post",
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339Nano),
+		Text:          "paws",
+	})
+	require.NoError(t, err)
+
+	// One event which failed transiently, and one which will never succeed.
+	due := time.Now().Add(-time.Second)
+	require.NoError(t, harness.Store.CreateDeadLetterEvent(
+		ctx, actorDID, postEvent("good", goodRecord), errors.New("connection reset"), due,
+	))
+	require.NoError(t, harness.Store.CreateDeadLetterEvent(
+		ctx, actorDID, postEvent("bad", json.RawMessage(`{"text": 1}`)), errors.New("bad record"), due,
+	))
+
+	require.NoError(t, fi.retryRepoDeadLetters(ctx, deadLettered, actorDID))
+
+	_, err = harness.Store.GetPostByURI(ctx, "at://"+actorDID+"/app.bsky.feed.post/good")
+	require.NoError(t, err)
+
+	remaining, err := harness.Store.ListDeadLetterEvents(ctx, store.ListDeadLetterEventsOpts{})
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Contains(t, remaining[0].Event, `"rkey":"bad"`)
+	assert.Equal(t, int32(2), remaining[0].Attempts)
+	assert.Contains(t, remaining[0].LastError, "unmarshal")
+	assert.True(t, remaining[0].NextAttemptAt.AsTime().After(time.Now()))
+
+	// The failed event is not due again until its backoff has passed.
+	require.NoError(t, fi.retryRepoDeadLetters(ctx, deadLettered, actorDID))
+	again, err := harness.Store.GetDeadLetterEvent(ctx, remaining[0].Id)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), again.Attempts)
+
+	// Unless it is replayed manually.
+	_, err = harness.Store.ReplayDeadLetterEvent(ctx, remaining[0].Id)
+	require.NoError(t, err)
+	require.NoError(t, fi.retryRepoDeadLetters(ctx, deadLettered, actorDID))
+	again, err = harness.Store.GetDeadLetterEvent(ctx, remaining[0].Id)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), again.Attempts)
+}
+
+func TestFirehoseIngester_deadLettersInOrder(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	const actorDID = "did:plc:furry"
+	const postURI = "at://" + actorDID + "/app.bsky.feed.post/paws"
+	_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+		Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+		DID:    actorDID,
+	})
+	require.NoError(t, err)
+	cac := NewActorCache(slog.Default(), harness.Store)
+	require.NoError(t, cac.Sync(ctx))
+	fi := NewFirehoseIngester(slog.Default(), harness.Store, cac)
+	deadLettered := newDeadLetteredRepos()
+
+	record, err := json.Marshal(&bsky.FeedPost{
+		LexiconTypeID: "app.bsky.feed.post",
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339Nano),
+		Text:          "paws",
+	})
+	require.NoError(t, err)
+	create := &models.Event{
+		Did:    actorDID,
+		TimeUS: time.Now().UnixMicro(),
+		Kind:   models.EventKindCommit,
+		Commit: &models.Commit{
+			Operation:  models.CommitOperationCreate,
+			Collection: "app.bsky.feed.post",
+			RKey:       "paws",
+			Record:     record,
+		},
+	}
+	del := &models.Event{
+		Did:    actorDID,
+		TimeUS: time.Now().UnixMicro(),
+		Kind:   models.EventKindCommit,
+		Commit: &models.Commit{
+			Operation:  models.CommitOperationDelete,
+			Collection: "app.bsky.feed.post",
+			RKey:       "paws",
+		},
+	}
+
+	// The create fails, and is dead lettered.
+	require.NoError(t, fi.deadLetter(ctx, create, errors.New("connection reset")))
+	deadLettered.set(actorDID, true)
+
+	// The delete must not be handled before the create, else the retried
+	// create would bring the post back, so it's dead lettered behind it.
+	require.NoError(t, fi.handleLiveEvent(ctx, deadLettered, del))
+	events, err := harness.Store.ListDeadLetterEvents(ctx, store.ListDeadLetterEventsOpts{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Contains(t, events[0].LastError, errEarlierEventDeadLettered.Error())
+
+	// Once the create is due, both are retried in order.
+	_, err = harness.Store.ReplayDeadLetterEvent(ctx, events[1].Id)
+	require.NoError(t, err)
+	require.NoError(t, fi.retryRepoDeadLetters(ctx, deadLettered, actorDID))
+
+	post, err := harness.Store.GetPostByURI(ctx, postURI)
+	require.NoError(t, err)
+	assert.True(t, post.DeletedAt.Valid)
+	events, err = harness.Store.ListDeadLetterEvents(ctx, store.ListDeadLetterEventsOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.False(t, deadLettered.has(actorDID))
+}
+
+func Test_deadLetteredRepos(t *testing.T) {
+	t.Parallel()
+
+	d := newDeadLetteredRepos()
+	d.set("did:plc:a", true)
+	d.set("did:plc:b", true)
+
+	// Changes whilst refreshing win over the stale view read from the
+	// database.
+	d.beginRefresh()
+	d.set("did:plc:a", false)
+	d.set("did:plc:c", true)
+	d.endRefresh([]string{"did:plc:a", "did:plc:d"})
+
+	assert.False(t, d.has("did:plc:a"))
+	assert.False(t, d.has("did:plc:b"))
+	assert.True(t, d.has("did:plc:c"))
+	assert.True(t, d.has("did:plc:d"))
+}
@@ -0,0 +1,178 @@
+package ingester
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// invalidHandle is reported in identity events when a handle fails
+// verification. It isn't a change of handle by the actor, so isn't recorded.
+const invalidHandle = "handle.invalid"
+
+// accountStatusDeleted is the status of an account event when the account has
+// been deleted. Unlike other statuses, this is permanent.
+const accountStatusDeleted = "deleted"
+
+// handleAccount records a tracked actor's account being deactivated, taken
+// down or restored. Posts by actors with inactive accounts are hidden from
+// feeds.
+func (fi *FirehoseIngester) handleAccount(
+	ctx context.Context, evt *atproto.SyncSubscribeRepos_Account,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "firehose_ingester.handle_account")
+	defer func() {
+		endSpan(span, err)
+	}()
+	span.SetAttributes(actorDIDAttr(evt.Did))
+
+	if fi.actorCache.GetByDID(evt.Did) == nil {
+		return nil
+	}
+
+	status := ""
+	if !evt.Active && evt.Status != nil {
+		status = *evt.Status
+	}
+
+	tx, err := fi.store.TX(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.UpdateActorAccountStatus(ctx, evt.Did, evt.Active, status); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			// Their status hasn't changed, e.g. we've seen this event before.
+			return nil
+		}
+		return fmt.Errorf("updating account status: %w", err)
+	}
+	_, err = tx.CreateAuditEvent(ctx, store.CreateAuditEventOpts{
+		ActorDID:   evt.Did,
+		SubjectDID: evt.Did,
+		Payload: &v1.AccountStatusChangedAuditPayload{
+			Active: evt.Active,
+			Status: status,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("creating audit event: %w", err)
+	}
+	if status == accountStatusDeleted {
+		if err := fi.deleteActorData(ctx, tx, evt.Did); err != nil {
+			return fmt.Errorf("deleting actor data: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	fi.log.Info(
+		"actor account status changed",
+		bfflog.ActorDID(evt.Did),
+		slog.Bool("active", evt.Active),
+		slog.String("status", status),
+	)
+	return nil
+}
+
+// deleteActorData hides the data of an actor whose account has been deleted,
+// and schedules it to be purged.
+func (fi *FirehoseIngester) deleteActorData(ctx context.Context, tx *store.PGXTX, did string) error {
+	actor, err := tx.DeleteActorData(ctx, did)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			// Their data has already been deleted, e.g. by a moderator.
+			return nil
+		}
+		return err
+	}
+	_, err = tx.CreateAuditEvent(ctx, store.CreateAuditEventOpts{
+		ActorDID:   did,
+		SubjectDID: did,
+		Payload: &v1.ActorDataDeletedAuditPayload{
+			Reason:  "account deleted",
+			PurgeAt: timestamppb.New(actor.DeletedAt.AsTime().Add(store.ActorPurgeGracePeriod)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("creating audit event: %w", err)
+	}
+	return nil
+}
+
+// handleIdentity records a change to a tracked actor's handle.
+func (fi *FirehoseIngester) handleIdentity(
+	ctx context.Context, evt *atproto.SyncSubscribeRepos_Identity,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "firehose_ingester.handle_identity")
+	defer func() {
+		endSpan(span, err)
+	}()
+	span.SetAttributes(actorDIDAttr(evt.Did))
+
+	if fi.actorCache.GetByDID(evt.Did) == nil {
+		return nil
+	}
+	// Identity events are also emitted for changes to keys or PDS, which
+	// don't concern us.
+	if evt.Handle == nil || *evt.Handle == invalidHandle {
+		return nil
+	}
+	handle := *evt.Handle
+
+	seenAt, err := time.Parse(time.RFC3339, evt.Time)
+	if err != nil {
+		seenAt = time.Now()
+	}
+
+	tx, err := fi.store.TX(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	actor, err := tx.GetActorByDID(ctx, evt.Did)
+	if err != nil {
+		return fmt.Errorf("getting actor: %w", err)
+	}
+	if actor.Handle == handle {
+		return nil
+	}
+
+	if _, err := tx.UpdateActorHandle(ctx, evt.Did, handle, seenAt); err != nil {
+		return fmt.Errorf("updating handle: %w", err)
+	}
+	_, err = tx.CreateAuditEvent(ctx, store.CreateAuditEventOpts{
+		ActorDID:   evt.Did,
+		SubjectDID: evt.Did,
+		Payload: &v1.HandleChangedAuditPayload{
+			HandleBefore: actor.Handle,
+			HandleAfter:  handle,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("creating audit event: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	fi.log.Info(
+		"actor handle changed",
+		bfflog.ActorDID(evt.Did),
+		slog.String("handle_before", actor.Handle),
+		slog.String("handle_after", handle),
+	)
+	return nil
+}
@@ -0,0 +1,130 @@
+package ingester
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/jetstream/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bffv1pb "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+func TestFirehoseIngester_handleAccountAndIdentity(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	const actorDID = "did:plc:furry"
+	_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+		Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+		DID:    actorDID,
+	})
+	require.NoError(t, err)
+	cac := NewActorCache(slog.Default(), harness.Store)
+	require.NoError(t, cac.Sync(ctx))
+	fi := NewFirehoseIngester(slog.Default(), harness.Store, cac)
+
+	const postURI = "at://" + actorDID + "/app.bsky.feed.post/paws"
+	require.NoError(t, harness.Store.CreatePost(ctx, store.CreatePostOpts{
+		URI:       postURI,
+		ActorDID:  actorDID,
+		CreatedAt: time.Now(),
+		IndexedAt: time.Now(),
+	}))
+	newFeedURIs := func() []string {
+		posts, err := harness.Store.ListPostsForNewFeed(ctx, store.ListPostsForNewFeedOpts{
+			CursorTime:         time.Now().Add(time.Hour),
+			Hashtags:           []string{},
+			DisallowedHashtags: []string{},
+			AllowedEmbeds:      []string{},
+			PinnedDIDs:         []string{},
+		})
+		require.NoError(t, err)
+		uris := []string{}
+		for _, p := range posts {
+			uris = append(uris, p.URI)
+		}
+		return uris
+	}
+	require.Equal(t, []string{postURI}, newFeedURIs())
+
+	accountEvent := func(active bool, status string) *models.Event {
+		acct := &atproto.SyncSubscribeRepos_Account{
+			Did:    actorDID,
+			Active: active,
+			Time:   time.Now().UTC().Format(time.RFC3339),
+		}
+		if status != "" {
+			acct.Status = &status
+		}
+		return &models.Event{
+			Did:     actorDID,
+			TimeUS:  time.Now().UnixMicro(),
+			Kind:    models.EventKindAccount,
+			Account: acct,
+		}
+	}
+
+	// Deactivating the account hides their posts.
+	require.NoError(t, fi.handleEvent(ctx, accountEvent(false, "takendown")))
+	actor, err := harness.Store.GetActorByDID(ctx, actorDID)
+	require.NoError(t, err)
+	assert.Equal(t, "takendown", actor.AccountStatus)
+	assert.Empty(t, newFeedURIs())
+
+	// A repeated event is not audited twice.
+	require.NoError(t, fi.handleEvent(ctx, accountEvent(false, "takendown")))
+
+	// Reactivating restores them.
+	require.NoError(t, fi.handleEvent(ctx, accountEvent(true, "")))
+	actor, err = harness.Store.GetActorByDID(ctx, actorDID)
+	require.NoError(t, err)
+	assert.Empty(t, actor.AccountStatus)
+	assert.Equal(t, []string{postURI}, newFeedURIs())
+
+	identityEvent := func(handle string) *models.Event {
+		return &models.Event{
+			Did:    actorDID,
+			TimeUS: time.Now().UnixMicro(),
+			Kind:   models.EventKindIdentity,
+			Identity: &atproto.SyncSubscribeRepos_Identity{
+				Did:    actorDID,
+				Handle: &handle,
+				Time:   time.Now().UTC().Format(time.RFC3339),
+			},
+		}
+	}
+	require.NoError(t, fi.handleEvent(ctx, identityEvent("furry.example.com")))
+	require.NoError(t, fi.handleEvent(ctx, identityEvent("furry.example.com")))
+	require.NoError(t, fi.handleEvent(ctx, identityEvent(invalidHandle)))
+	require.NoError(t, fi.handleEvent(ctx, identityEvent("fox.example.com")))
+
+	actor, err = harness.Store.GetActorByDID(ctx, actorDID)
+	require.NoError(t, err)
+	assert.Equal(t, "fox.example.com", actor.Handle)
+	handles, err := harness.Store.ListActorHandles(ctx, actorDID)
+	require.NoError(t, err)
+	require.Len(t, handles, 2)
+
+	events, err := harness.Store.ListAuditEvents(ctx, store.ListAuditEventsOpts{
+		FilterSubjectDID: actorDID,
+		FilterTypes: []bffv1pb.AuditEventType{
+			bffv1pb.AuditEventType_ACCOUNT_STATUS_CHANGED,
+			bffv1pb.AuditEventType_HANDLE_CHANGED,
+		},
+	})
+	require.NoError(t, err)
+	assert.Len(t, events, 4)
+}
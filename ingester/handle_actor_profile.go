@@ -59,17 +59,29 @@ func (fi *FirehoseIngester) handleActorProfileUpdate(
 	return nil
 }
 
+// handleActorProfileDelete deletes the profile history of an actor when they
+// delete their profile, as they no longer want it to be shown.
 func (fi *FirehoseIngester) handleActorProfileDelete(
 	ctx context.Context,
-	recordUri string,
+	repoDID string,
 ) (err error) {
 	ctx, span := tracer.Start(ctx, "firehose_ingester.handle_actor_profile_delete")
-	_ = ctx
 	defer func() {
 		endSpan(span, err)
 	}()
 
-	// TODO: Maybe purge the actor from the database?
+	tx, err := fi.store.TX(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := tx.DeleteActorProfiles(ctx, repoDID); err != nil {
+		return fmt.Errorf("deleting profiles: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
 
 	return nil
 }
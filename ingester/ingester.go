@@ -3,7 +3,6 @@ package ingester
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"log/slog"
 	"sync/atomic"
@@ -11,11 +10,10 @@ import (
 	"time"
 
 	"github.com/bluesky-social/indigo/util"
+	"github.com/jonboulle/clockwork"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
-	jsclient "github.com/bluesky-social/jetstream/pkg/client"
-	jsparallel "github.com/bluesky-social/jetstream/pkg/client/schedulers/parallel"
 	"github.com/bluesky-social/jetstream/pkg/models"
 
 	"github.com/strideynet/bsky-furry-feed/bfflog"
@@ -54,140 +52,241 @@ type FirehoseIngester struct {
 	log        *slog.Logger
 	actorCache actorCacher
 	store      *store.PGXStore
+	clock      clockwork.Clock
 
 	// configuration
-	jetstreamURL        string
+	jetstreamPool       *jetstreamPool
 	workerCount         int
 	workItemTimeout     time.Duration
 	cursorFlushInterval time.Duration
+	reconnectDelay      time.Duration
+	// jetstreamCompress requests zstd compressed events.
+	jetstreamCompress bool
+	// jetstreamStallTimeout is how long a connection may go without an event
+	// before we fail over.
+	jetstreamStallTimeout time.Duration
+	// jetstreamMaxLag is how far behind a connection may fall before we fail
+	// over, if it isn't catching up.
+	jetstreamMaxLag        time.Duration
+	jetstreamCheckInterval time.Duration
+	// deadLetterRetryInterval is how often to check for dead lettered events
+	// which are due a retry.
+	deadLetterRetryInterval time.Duration
+	// recorder, if set, is sent every event received from Jetstream.
+	recorder *EventRecorder
+	// networkCounts, if set, counts likes and reposts of candidate posts from
+	// anyone on the network.
+	networkCounts *networkCounter
+
+	// shardCount, if set, splits repos into this many shards, and only the
+	// events from the shards this replica holds a lease on are handled.
+	shardCount int
+	replicaID  string
+	// shardLeaseTTL is how long a lease on a shard lasts without being
+	// renewed, and so how long a dead replica's shards go unhandled.
+	shardLeaseTTL      time.Duration
+	shardRenewInterval time.Duration
 }
 
 const DefaultJetstreamURL = "wss://jetstream1.us-east.bsky.network/subscribe"
 
+// jetstreamCollections are the collections requested from Jetstream.
+var jetstreamCollections = []string{
+	"app.bsky.actor.profile",
+	"app.bsky.feed.like",
+	"app.bsky.feed.post",
+	"app.bsky.feed.repost",
+	"app.bsky.graph.follow",
+}
+
+// NewFirehoseIngester creates an ingester that consumes from the given
+// Jetstream endpoints, failing over between them based on their health. If
+// none are provided, DefaultJetstreamURLs is used.
 func NewFirehoseIngester(
-	log *slog.Logger, store *store.PGXStore, crc *ActorCache, jetstreamURL string,
+	log *slog.Logger, store *store.PGXStore, crc *ActorCache, jetstreamURLs ...string,
 ) *FirehoseIngester {
-	if jetstreamURL == "" {
-		jetstreamURL = DefaultJetstreamURL
+	urls := []string{}
+	for _, u := range jetstreamURLs {
+		if u != "" {
+			urls = append(urls, u)
+		}
+	}
+	if len(urls) == 0 {
+		urls = DefaultJetstreamURLs
 	}
+	clock := clockwork.NewRealClock()
 
 	return &FirehoseIngester{
 		log:        log,
 		actorCache: crc,
 		store:      store,
+		clock:      clock,
+
+		networkCounts: newNetworkCounter(bfflog.ChildLogger(log, "network_counter"), store),
+
+		jetstreamPool:          newJetstreamPool(clock, urls),
+		workerCount:            20,
+		workItemTimeout:        time.Second * 30,
+		cursorFlushInterval:    time.Second * 10,
+		reconnectDelay:         time.Second,
+		jetstreamCompress:      true,
+		jetstreamStallTimeout:  time.Minute,
+		jetstreamMaxLag:        time.Minute * 5,
+		jetstreamCheckInterval: time.Second * 10,
+
+		deadLetterRetryInterval: time.Second * 30,
 
-		jetstreamURL:        jetstreamURL,
-		workerCount:         20,
-		workItemTimeout:     time.Second * 30,
-		cursorFlushInterval: time.Second * 10,
+		shardLeaseTTL:      time.Second * 30,
+		shardRenewInterval: time.Second * 10,
 	}
 }
 
+// RecordTo tees the events received from Jetstream to rec.
+func (fi *FirehoseIngester) RecordTo(rec *EventRecorder) {
+	fi.recorder = rec
+}
+
 func (fi *FirehoseIngester) Start(ctx context.Context) (err error) {
 	eg, ctx := errgroup.WithContext(ctx)
 
-	jsCfg := jsclient.DefaultClientConfig()
-	jsCfg.WebsocketURL = fi.jetstreamURL
-	jsCfg.WantedCollections = []string{
-		"app.bsky.actor.profile",
-		"app.bsky.feed.like",
-		"app.bsky.feed.post",
-		"app.bsky.graph.follow",
+	if fi.networkCounts != nil {
+		eg.Go(func() error {
+			fi.networkCounts.run(ctx)
+			return nil
+		})
 	}
 
-	var activeCursor atomic.Int64
-	sched := jsparallel.NewScheduler(
-		fi.workerCount,
-		"jetstream",
-		bfflog.ChildLogger(fi.log, "jetstream_scheduler"),
-		func(ctx context.Context, e *models.Event) error {
-			start := time.Now()
-			ctx, cancel := context.WithTimeout(ctx, fi.workItemTimeout)
-			defer cancel()
+	eg.Go(func() error {
+		if fi.shardCount > 0 {
+			return fi.ingestShards(ctx)
+		}
+
+		initCursor, err := fi.store.GetJetstreamCursor(ctx)
+		if err != nil {
+			return fmt.Errorf("get jetstream cursor: %w", err)
+		}
+		return fi.ingest(ctx, shardSet{
+			count:   1,
+			cursors: map[int]int64{0: initCursor},
+			save: func(ctx context.Context, _ int, cursor int64) error {
+				return fi.store.SetJetstreamCursor(ctx, cursor)
+			},
+		})
+	})
+
+	return eg.Wait()
+}
+
+// ingest reads events from Jetstream for the repos in the given shards, and
+// periodically persists how far through each shard we've got, until ctx is
+// cancelled.
+func (fi *FirehoseIngester) ingest(ctx context.Context, shards shardSet) (err error) {
+	eg, ctx := errgroup.WithContext(ctx)
+
+	// activeCursors holds the cursor of the last event handled from each
+	// shard, and initCursors where each shard was resumed from.
+	activeCursors := map[int]*atomic.Int64{}
+	initCursors := map[int]int64{}
+	filter := map[int]bool{}
+	for shard, cursor := range shards.cursors {
+		if cursor == -1 {
+			cursor = time.Now().UnixMicro()
+		}
+		// Step back a few minutes to allow recovery
+		initCursors[shard] = time.UnixMicro(cursor).Add(-1 * time.Minute).UnixMicro()
+		activeCursors[shard] = &atomic.Int64{}
+		filter[shard] = true
+	}
 
-			// Ignore events other than commit.
-			if e.Commit == nil {
+	deadLettered := newDeadLetteredRepos()
+	sched := newRepoScheduler(
+		ctx,
+		bfflog.ChildLogger(fi.log, "jetstream_scheduler"),
+		"jetstream",
+		fi.workerCount,
+		100,
+		func(ctx context.Context, item workItem) error {
+			if item.retryRepo != "" {
+				if err := fi.retryRepoDeadLetters(ctx, deadLettered, item.retryRepo); err != nil {
+					return fmt.Errorf("retrying dead letter events: %w", err)
+				}
 				return nil
 			}
 
-			if err := fi.handleCommit(ctx, e); err != nil {
-				fi.log.Error(
-					"failed to handle commit",
-					bfflog.Err(err),
-					slog.Any("evt", e),
-				)
-				return fmt.Errorf("handling commit: %w", err)
+			e := item.evt
+			start := time.Now()
+			if err := fi.handleLiveEvent(ctx, deadLettered, e); err != nil {
+				return err
 			}
-
-			activeCursor.Store(e.TimeUS)
+			activeCursors[shardForDID(e.Did, shards.count)].Store(e.TimeUS)
 			workItemsProcessed.
-				WithLabelValues("repo_commit").
+				WithLabelValues("repo_" + e.Kind).
 				Observe(time.Since(start).Seconds())
 			return nil
 		},
 	)
+	defer sched.Shutdown()
+	filtered := &shardFilter{next: sched, count: shards.count, shards: filter}
 
-	jsClient, err := jsclient.NewClient(
-		jsCfg, bfflog.ChildLogger(fi.log, "jetstream_client"), sched,
-	)
-	if err != nil {
-		return fmt.Errorf("creating jetstream client: %w", err)
-	}
-
-	initCursor, err := fi.store.GetJetstreamCursor(ctx)
-	if err != nil {
-		return fmt.Errorf("get jetstream cursor: %w", err)
-	}
-	if initCursor == -1 {
-		initCursor = time.Now().UnixMicro()
-	}
-	// Step back a few minutes to allow recovery
-	initCursor = time.UnixMicro(initCursor).Add(-1 * time.Minute).UnixMicro()
+	eg.Go(func() error {
+		return fi.retryDeadLetters(ctx, deadLettered, filtered.owns, func(ctx context.Context, repo string) error {
+			return sched.AddWork(ctx, repo, workItem{retryRepo: repo})
+		})
+	})
 
 	eg.Go(func() error {
-		for {
+		return fi.readJetstream(ctx, filtered, func() int64 {
+			// Resume from the shard that is furthest behind. The others
+			// will see some events again, which is harmless.
+			var oldest int64
+			for shard, activeCursor := range activeCursors {
+				cursor := activeCursor.Load()
+				if cursor == 0 {
+					cursor = initCursors[shard]
+				}
+				if oldest == 0 || cursor < oldest {
+					oldest = cursor
+				}
+			}
+			return oldest
+		})
+	})
+
+	flushCursor := func(ctx context.Context) {
+		var flushed int64
+		for shard, activeCursor := range activeCursors {
+			log := fi.log
+			if shards.count > 1 {
+				log = log.With(slog.Int("shard", shard))
+			}
 			cursor := activeCursor.Load()
 			if cursor == 0 {
-				cursor = initCursor
+				log.Warn("no cursor value to persist")
+				continue
+			}
+			if cursor <= initCursors[shard] {
+				// attempt to avoid a scenario where a crash loop sends the
+				// cursor further and further into the past.
+				log.Warn("not setting cursor to avoid regression")
+				continue
+			}
+			if err := shards.save(ctx, shard, cursor); err != nil {
+				log.Warn("failed to flush cursor", bfflog.Err(err))
+				continue
 			}
 
-			fi.log.Info(
-				"starting ingestion",
+			log.Info(
+				"successfully flushed cursor",
 				slog.Int64("cursor", cursor),
 				slog.String("cursor_time", time.UnixMicro(cursor).String()),
 			)
-			if err := jsClient.ConnectAndRead(ctx, &cursor); err != nil {
-				if errors.Is(err, context.Canceled) {
-					return nil
-				}
-				fi.log.Error("jetstream client encountered an error, restarting", bfflog.Err(err))
+			if flushed == 0 || cursor < flushed {
+				flushed = cursor
 			}
 		}
-	})
-
-	flushCursor := func(ctx context.Context) {
-		cursor := activeCursor.Load()
-		if cursor == 0 {
-			fi.log.Warn("no cursor value to persist")
-			return
-		}
-		if cursor <= initCursor {
-			// attempt to avoid a scenario where a crash loop sends the
-			// cursor further and further into the past.
-			fi.log.Warn("not setting cursor to avoid regression")
-			return
+		if flushed != 0 {
+			flushedWorkerCursor.Set(float64(flushed))
 		}
-		if err := fi.store.SetJetstreamCursor(ctx, cursor); err != nil {
-			fi.log.Warn("failed to flush cursor", bfflog.Err(err))
-			return
-		}
-
-		fi.log.Info(
-			"successfully flushed cursor",
-			slog.Int64("cursor", cursor),
-			slog.String("cursor_time", time.UnixMicro(cursor).String()),
-		)
-		flushedWorkerCursor.Set(float64(cursor))
 	}
 
 	eg.Go(func() error {
@@ -217,6 +316,69 @@ func (fi *FirehoseIngester) Start(ctx context.Context) (err error) {
 	return err
 }
 
+// workItem is handled by a repo's worker. It's either an event from Jetstream,
+// or a request to retry the repo's dead lettered events.
+type workItem struct {
+	evt *models.Event
+	// retryRepo is set to the repo whose dead lettered events are due a retry.
+	retryRepo string
+}
+
+// handleLiveEvent handles an event from Jetstream. If it fails, or an earlier
+// event for the repo is awaiting a retry, the event is dead lettered. An error
+// is only returned if that fails too.
+func (fi *FirehoseIngester) handleLiveEvent(
+	ctx context.Context, deadLettered *deadLetteredRepos, e *models.Event,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, fi.workItemTimeout)
+	defer cancel()
+
+	if e.Kind == models.EventKindCommit && e.Commit != nil &&
+		e.Commit.Operation == models.CommitOperationCreate {
+		fi.countNetworkInteraction(
+			fmt.Sprintf("at://%s/%s/%s", e.Did, e.Commit.Collection, e.Commit.RKey),
+			e.Commit.Collection,
+			e.Commit.Record,
+		)
+	}
+
+	err := errEarlierEventDeadLettered
+	if !deadLettered.has(e.Did) {
+		err = fi.handleEvent(ctx, e)
+		if err == nil {
+			return nil
+		}
+		fi.log.Error(
+			"failed to handle event, writing to dead letter table",
+			bfflog.Err(err),
+			slog.Any("evt", e),
+		)
+	}
+	// The event may have failed as ctx was cancelled, e.g on shutdown, so we
+	// still want to record it.
+	dlCtx, dlCancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*10)
+	defer dlCancel()
+	if dlErr := fi.deadLetter(dlCtx, e, err); dlErr != nil {
+		return fmt.Errorf("handling event: %w (dead lettering: %w)", err, dlErr)
+	}
+	deadLettered.set(e.Did, true)
+	return nil
+}
+
+// handleEvent dispatches a Jetstream event to the handler for its kind.
+func (fi *FirehoseIngester) handleEvent(ctx context.Context, evt *models.Event) error {
+	switch {
+	case evt.Kind == models.EventKindCommit && evt.Commit != nil:
+		return fi.handleCommit(ctx, evt)
+	case evt.Kind == models.EventKindAccount && evt.Account != nil:
+		return fi.handleAccount(ctx, evt.Account)
+	case evt.Kind == models.EventKindIdentity && evt.Identity != nil:
+		return fi.handleIdentity(ctx, evt.Identity)
+	default:
+		return nil
+	}
+}
+
 func (fi *FirehoseIngester) handleCommit(
 	ctx context.Context, evt *models.Event,
 ) (err error) {
@@ -291,20 +453,14 @@ func (fi *FirehoseIngester) handleRecordCreate(
 
 	actor := fi.actorCache.GetByDID(repoDID)
 	if actor == nil {
-		// Check if it's a follow of the furry feed
-		if recordCollection != "app.bsky.graph.follow" {
-			return nil
-		}
-		data := &bsky.GraphFollow{}
-		if err := json.Unmarshal(record, data); err != nil {
-			return fmt.Errorf("unmarshalling app.bsky.graph.follow: %w", err)
-		}
 		// If it's an unknown actor, and they've interacted, add em to
 		// the candidate actor store with pending status. Otherwise, ignore
 		// them.
-		// TODO: Make this not hard coded
-		// https://bsky.app/profile/furryli.st
-		if data.Subject != "did:plc:jdkvwye2lf4mingzk7qdebzc" {
+		discovered, err := isDiscoveryFollow(recordCollection, record)
+		if err != nil {
+			return fmt.Errorf("unmarshalling app.bsky.graph.follow: %w", err)
+		}
+		if !discovered {
 			return nil
 		}
 		fi.log.Info(
@@ -323,6 +479,10 @@ func (fi *FirehoseIngester) handleRecordCreate(
 	if !(actor.Status == v1.ActorStatus_ACTOR_STATUS_APPROVED) {
 		return nil
 	}
+	// Or whose data we haven't been asked to delete.
+	if actor.DeletedAt != nil {
+		return nil
+	}
 
 	switch recordCollection {
 	case "app.bsky.feed.post":
@@ -359,6 +519,36 @@ func (fi *FirehoseIngester) handleRecordCreate(
 	return nil
 }
 
+// isDiscoveryFollow reports whether a record is a follow of the furryli.st
+// account, which adds unknown actors as pending.
+func isDiscoveryFollow(collection string, record json.RawMessage) (bool, error) {
+	if collection != "app.bsky.graph.follow" {
+		return false, nil
+	}
+	data := &bsky.GraphFollow{}
+	if err := json.Unmarshal(record, data); err != nil {
+		return false, err
+	}
+	// TODO: Make this not hard coded
+	// https://bsky.app/profile/furryli.st
+	return data.Subject == "did:plc:jdkvwye2lf4mingzk7qdebzc", nil
+}
+
+// actsOnUntrackedRecord reports whether a record created by an actor we don't
+// track is acted upon, either as a follow which adds them as pending, or as a
+// like or repost which is counted. All other records of such actors are
+// ignored.
+func (fi *FirehoseIngester) actsOnUntrackedRecord(collection string, record json.RawMessage) bool {
+	if discovered, _ := isDiscoveryFollow(collection, record); discovered {
+		return true
+	}
+	if fi.networkCounts == nil {
+		return false
+	}
+	_, ok := fi.networkInteraction(collection, record)
+	return ok
+}
+
 func actorDIDAttr(s string) attribute.KeyValue {
 	return attribute.String("actor.did", s)
 }
@@ -391,7 +581,7 @@ func (fi *FirehoseIngester) handleRecordDelete(
 
 	switch parsedUri.Collection {
 	case "app.bsky.actor.profile":
-		err = fi.handleActorProfileDelete(ctx, recordUri)
+		err = fi.handleActorProfileDelete(ctx, repoDID)
 	case "app.bsky.feed.post":
 		err = fi.handleFeedPostDelete(ctx, recordUri)
 	case "app.bsky.feed.like":
@@ -430,6 +620,9 @@ func (fi *FirehoseIngester) handleRecordUpdate(
 	if !(actor.Status == v1.ActorStatus_ACTOR_STATUS_APPROVED) {
 		return nil
 	}
+	if actor.DeletedAt != nil {
+		return nil
+	}
 
 	switch recordCollection {
 	case "app.bsky.actor.profile":
@@ -0,0 +1,377 @@
+package ingester
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	jsclient "github.com/bluesky-social/jetstream/pkg/client"
+	"github.com/bluesky-social/jetstream/pkg/models"
+	"github.com/gorilla/websocket"
+	"github.com/jonboulle/clockwork"
+	"github.com/klauspost/compress/zstd"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/bluesky"
+)
+
+// DefaultJetstreamURLs are the public Jetstream instances operated by Bluesky,
+// in order of preference.
+var DefaultJetstreamURLs = []string{
+	DefaultJetstreamURL,
+	"wss://jetstream2.us-east.bsky.network/subscribe",
+	"wss://jetstream1.us-west.bsky.network/subscribe",
+	"wss://jetstream2.us-west.bsky.network/subscribe",
+}
+
+const (
+	// jetstreamFailoverRewind is how far the cursor is rewound when switching
+	// endpoint. time_us is assigned by each Jetstream instance when it
+	// receives an event, so the same event has a slightly different cursor on
+	// each instance. Replaying events is harmless as the handlers are
+	// idempotent.
+	jetstreamFailoverRewind = 2 * time.Minute
+	// jetstreamErrorHalfLife is how quickly past errors are forgiven when
+	// scoring an endpoint.
+	jetstreamErrorHalfLife = 10 * time.Minute
+)
+
+var jetstreamActiveEndpoint = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bff_ingester_jetstream_active_endpoint",
+	Help: "Set to 1 for the Jetstream endpoint currently being consumed, and 0 for the others.",
+}, []string{"url"})
+
+var jetstreamEndpointLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bff_ingester_jetstream_endpoint_lag_seconds",
+	Help: "The most recently observed lag between an event's time_us and it being read, by endpoint.",
+}, []string{"url"})
+
+var jetstreamEndpointErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bff_ingester_jetstream_endpoint_errors_total",
+	Help: "The total number of connection failures and stalls, by endpoint.",
+}, []string{"url"})
+
+var jetstreamFailovers = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "bff_ingester_jetstream_failovers_total",
+	Help: "The total number of times the ingester has switched Jetstream endpoint.",
+})
+
+type jetstreamEndpoint struct {
+	url string
+
+	// errorScore is a count of errors that halves every
+	// jetstreamErrorHalfLife.
+	errorScore  float64
+	lastErrorAt time.Time
+	lag         time.Duration
+}
+
+// jetstreamPool tracks the health of a set of Jetstream endpoints so that the
+// healthiest can be chosen when (re)connecting. It's safe for concurrent use.
+type jetstreamPool struct {
+	clock clockwork.Clock
+
+	mu        sync.Mutex
+	endpoints []*jetstreamEndpoint
+}
+
+func newJetstreamPool(clock clockwork.Clock, urls []string) *jetstreamPool {
+	p := &jetstreamPool{clock: clock}
+	for _, u := range urls {
+		p.endpoints = append(p.endpoints, &jetstreamEndpoint{url: u})
+		jetstreamActiveEndpoint.WithLabelValues(u).Set(0)
+	}
+	return p
+}
+
+func (p *jetstreamPool) get(u string) *jetstreamEndpoint {
+	for _, e := range p.endpoints {
+		if e.url == u {
+			return e
+		}
+	}
+	return nil
+}
+
+// score returns the health score of an endpoint, where lower is better. Each
+// recent error costs a point, and each minute of lag costs a point.
+func (p *jetstreamPool) score(e *jetstreamEndpoint) float64 {
+	decay := math.Pow(0.5, float64(p.clock.Since(e.lastErrorAt))/float64(jetstreamErrorHalfLife))
+	return e.errorScore*decay + e.lag.Minutes()
+}
+
+// pick returns the endpoint with the best score. Ties are broken by the order
+// the endpoints were configured in.
+func (p *jetstreamPool) pick() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	best := p.endpoints[0]
+	bestScore := p.score(best)
+	for _, e := range p.endpoints[1:] {
+		if s := p.score(e); s < bestScore {
+			best, bestScore = e, s
+		}
+	}
+	return best.url
+}
+
+func (p *jetstreamPool) recordError(u string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e := p.get(u)
+	if e == nil {
+		return
+	}
+	// Fold the decay of previous errors into the score before adding this
+	// one.
+	e.errorScore = e.errorScore*math.Pow(0.5, float64(p.clock.Since(e.lastErrorAt))/float64(jetstreamErrorHalfLife)) + 1
+	e.lastErrorAt = p.clock.Now()
+	jetstreamEndpointErrors.WithLabelValues(u).Inc()
+}
+
+func (p *jetstreamPool) recordLag(u string, lag time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e := p.get(u)
+	if e == nil {
+		return
+	}
+	e.lag = lag
+	jetstreamEndpointLag.WithLabelValues(u).Set(lag.Seconds())
+}
+
+func (p *jetstreamPool) setActive(u string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, e := range p.endpoints {
+		active := 0.0
+		if e.url == u {
+			active = 1
+		}
+		jetstreamActiveEndpoint.WithLabelValues(e.url).Set(active)
+	}
+}
+
+// jetstreamProgress tracks how quickly event time advances on a connection,
+// so that the watchdog can tell an endpoint that is catching up on a backlog
+// from one that is falling behind.
+type jetstreamProgress struct {
+	mu              sync.Mutex
+	lastReadAt      time.Time
+	latestEventTime time.Time
+}
+
+func (jp *jetstreamProgress) observe(readAt, eventTime time.Time) {
+	jp.mu.Lock()
+	defer jp.mu.Unlock()
+	jp.lastReadAt = readAt
+	if eventTime.After(jp.latestEventTime) {
+		jp.latestEventTime = eventTime
+	}
+}
+
+func (jp *jetstreamProgress) snapshot() (lastReadAt, latestEventTime time.Time) {
+	jp.mu.Lock()
+	defer jp.mu.Unlock()
+	return jp.lastReadAt, jp.latestEventTime
+}
+
+// readJetstream reads events from the healthiest Jetstream endpoint into sched
+// until ctx is cancelled. When a connection fails, stalls, or falls behind, the
+// endpoint is penalised and the healthiest endpoint is reconnected to.
+// cursor returns the time_us to resume from.
+func (fi *FirehoseIngester) readJetstream(
+	ctx context.Context, sched jsclient.Scheduler, cursor func() int64,
+) error {
+	activeURL := ""
+	var rewindTo int64
+	for {
+		u := fi.jetstreamPool.pick()
+		c := cursor()
+		if activeURL != "" && u != activeURL {
+			jetstreamFailovers.Inc()
+			rewindTo = c - jetstreamFailoverRewind.Microseconds()
+			fi.log.Warn(
+				"failing over to another jetstream endpoint",
+				slog.String("from", activeURL),
+				slog.String("to", u),
+			)
+		}
+		if rewindTo != 0 {
+			// Hold the rewound cursor until the new endpoint has delivered
+			// events past it, to avoid reconnecting to the same endpoint
+			// from a cursor it assigned.
+			if c > rewindTo+jetstreamFailoverRewind.Microseconds() {
+				rewindTo = 0
+			} else {
+				c = rewindTo
+			}
+		}
+		activeURL = u
+		fi.jetstreamPool.setActive(u)
+
+		fi.log.Info(
+			"starting ingestion",
+			slog.String("url", u),
+			slog.Int64("cursor", c),
+			slog.String("cursor_time", time.UnixMicro(c).String()),
+		)
+		err := fi.readJetstreamEndpoint(ctx, u, c, sched)
+		if ctx.Err() != nil {
+			return nil
+		}
+		fi.jetstreamPool.recordError(u)
+		fi.log.Error(
+			"jetstream connection failed, reconnecting",
+			slog.String("url", u),
+			bfflog.Err(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-fi.clock.After(fi.reconnectDelay):
+		}
+	}
+}
+
+func (fi *FirehoseIngester) jetstreamSubscribeURL(base string, cursor int64) string {
+	params := url.Values{}
+	params.Set("cursor", strconv.FormatInt(cursor, 10))
+	for _, c := range jetstreamCollections {
+		params.Add("wantedCollections", c)
+	}
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	return base + sep + params.Encode()
+}
+
+// readJetstreamEndpoint consumes a single connection to a Jetstream endpoint.
+// It always returns an error explaining why the connection ended.
+func (fi *FirehoseIngester) readJetstreamEndpoint(
+	ctx context.Context, u string, cursor int64, sched jsclient.Scheduler,
+) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	header := http.Header{}
+	header.Set("User-Agent", bluesky.UserAgent)
+	var decoder *zstd.Decoder
+	if fi.jetstreamCompress {
+		header.Set("Socket-Encoding", "zstd")
+		dec, err := zstd.NewReader(nil, zstd.WithDecoderDicts(models.ZSTDDictionary))
+		if err != nil {
+			return fmt.Errorf("creating zstd decoder: %w", err)
+		}
+		defer dec.Close()
+		decoder = dec
+	}
+
+	conn, _, err := websocket.DefaultDialer.DialContext(
+		ctx, fi.jetstreamSubscribeURL(u, cursor), header,
+	)
+	if err != nil {
+		return fmt.Errorf("dialing: %w", err)
+	}
+	defer conn.Close()
+	// ReadMessage does not observe the context, so we close the connection to
+	// unblock it when the connection is abandoned.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	progress := &jetstreamProgress{}
+	progress.observe(fi.clock.Now(), time.UnixMicro(cursor))
+	go fi.watchJetstream(ctx, cancel, u, progress)
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			if cause := context.Cause(ctx); cause != nil {
+				return cause
+			}
+			return fmt.Errorf("reading message: %w", err)
+		}
+		if decoder != nil {
+			msg, err = decoder.DecodeAll(msg, nil)
+			if err != nil {
+				return fmt.Errorf("decompressing message: %w", err)
+			}
+		}
+
+		evt := &models.Event{}
+		if err := json.Unmarshal(msg, evt); err != nil {
+			return fmt.Errorf("unmarshalling event: %w", err)
+		}
+		if fi.recorder != nil {
+			fi.recorder.record(evt, msg)
+		}
+		now := fi.clock.Now()
+		eventTime := time.UnixMicro(evt.TimeUS)
+		progress.observe(now, eventTime)
+		fi.jetstreamPool.recordLag(u, now.Sub(eventTime))
+
+		if err := sched.AddWork(ctx, evt.Did, evt); err != nil {
+			if cause := context.Cause(ctx); cause != nil {
+				return cause
+			}
+			return fmt.Errorf("adding work to scheduler: %w", err)
+		}
+	}
+}
+
+// watchJetstream abandons a connection if no events have been read within the
+// stall timeout, or if it's lagging behind and not catching up.
+func (fi *FirehoseIngester) watchJetstream(
+	ctx context.Context,
+	cancel context.CancelCauseFunc,
+	u string,
+	progress *jetstreamProgress,
+) {
+	ticker := fi.clock.NewTicker(fi.jetstreamCheckInterval)
+	defer ticker.Stop()
+
+	_, prevEventTime := progress.snapshot()
+	prevCheck := fi.clock.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+		}
+
+		now := fi.clock.Now()
+		lastReadAt, eventTime := progress.snapshot()
+		if stalled := now.Sub(lastReadAt); stalled > fi.jetstreamStallTimeout {
+			cancel(fmt.Errorf("no events read from %s for %s", u, stalled))
+			return
+		}
+
+		// During catch-up, event time advances faster than wall time, so
+		// only treat lag as unhealthy if we're falling further behind.
+		lag := now.Sub(eventTime)
+		fallingBehind := eventTime.Sub(prevEventTime) < now.Sub(prevCheck)/2
+		if lag > fi.jetstreamMaxLag && fallingBehind {
+			cancel(fmt.Errorf("%s is lagging by %s and falling behind", u, lag))
+			return
+		}
+		prevEventTime, prevCheck = eventTime, now
+	}
+}
@@ -0,0 +1,178 @@
+package ingester
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/jetstream/pkg/models"
+	"github.com/gorilla/websocket"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_jetstreamPool(t *testing.T) {
+	t.Parallel()
+
+	clock := clockwork.NewFakeClock()
+	pool := newJetstreamPool(clock, []string{"a", "b", "c"})
+
+	// With no history, the first endpoint is preferred.
+	assert.Equal(t, "a", pool.pick())
+
+	pool.recordError("a")
+	assert.Equal(t, "b", pool.pick())
+
+	// Lag counts against an endpoint too.
+	pool.recordLag("b", 2*time.Minute)
+	assert.Equal(t, "c", pool.pick())
+
+	// Errors are forgiven over time, but lag is not.
+	clock.Advance(time.Hour)
+	pool.recordError("c")
+	assert.Equal(t, "a", pool.pick())
+
+	// Errors accumulate.
+	pool.recordError("a")
+	pool.recordError("a")
+	assert.Equal(t, "c", pool.pick())
+}
+
+// fakeJetstream serves events over a websocket in the same way as Jetstream.
+type fakeJetstream struct {
+	*httptest.Server
+	// cursors receives the cursor of each connection.
+	cursors chan int64
+}
+
+func newFakeJetstream(t *testing.T, handle func(conn *websocket.Conn)) *fakeJetstream {
+	fj := &fakeJetstream{cursors: make(chan int64, 10)}
+	upgrader := websocket.Upgrader{}
+	fj.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor, _ := strconv.ParseInt(r.URL.Query().Get("cursor"), 10, 64)
+		fj.cursors <- cursor
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handle(conn)
+	}))
+	t.Cleanup(fj.Server.Close)
+	return fj
+}
+
+func (fj *fakeJetstream) url() string {
+	return "ws://" + strings.TrimPrefix(fj.Server.URL, "http://") + "/subscribe"
+}
+
+type recordingScheduler struct {
+	events chan *models.Event
+	cursor atomic.Int64
+}
+
+func (s *recordingScheduler) AddWork(_ context.Context, _ string, evt *models.Event) error {
+	s.cursor.Store(evt.TimeUS)
+	s.events <- evt
+	return nil
+}
+
+func (s *recordingScheduler) Shutdown() {}
+
+func TestFirehoseIngester_readJetstream_failover(t *testing.T) {
+	t.Parallel()
+
+	startCursor := time.Now().Add(-time.Second).UnixMicro()
+	lastEventTime := time.Now().UnixMicro()
+
+	tests := []struct {
+		name    string
+		primary func(conn *websocket.Conn)
+		// wantCursor is the cursor the secondary should be connected to with.
+		wantCursor int64
+	}{
+		{
+			name: "primary disconnects",
+			// Close the connection without sending any events.
+			primary:    func(conn *websocket.Conn) {},
+			wantCursor: startCursor - jetstreamFailoverRewind.Microseconds(),
+		},
+		{
+			name: "primary stalls",
+			primary: func(conn *websocket.Conn) {
+				_ = conn.WriteJSON(&models.Event{Did: "did:plc:primary", TimeUS: lastEventTime})
+				// Keep the connection open until the client gives up.
+				_, _, _ = conn.ReadMessage()
+			},
+			wantCursor: lastEventTime - jetstreamFailoverRewind.Microseconds(),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			primary := newFakeJetstream(t, tt.primary)
+			secondary := newFakeJetstream(t, func(conn *websocket.Conn) {
+				_ = conn.WriteJSON(&models.Event{Did: "did:plc:secondary", TimeUS: time.Now().UnixMicro()})
+				_, _, _ = conn.ReadMessage()
+			})
+
+			clock := clockwork.NewRealClock()
+			fi := &FirehoseIngester{
+				log:                    slog.Default(),
+				clock:                  clock,
+				jetstreamPool:          newJetstreamPool(clock, []string{primary.url(), secondary.url()}),
+				reconnectDelay:         time.Millisecond * 10,
+				jetstreamStallTimeout:  time.Millisecond * 200,
+				jetstreamMaxLag:        time.Hour,
+				jetstreamCheckInterval: time.Millisecond * 20,
+			}
+			sched := &recordingScheduler{events: make(chan *models.Event, 10)}
+			sched.cursor.Store(startCursor)
+
+			done := make(chan error)
+			go func() {
+				done <- fi.readJetstream(ctx, sched, sched.cursor.Load)
+			}()
+
+			assert.Equal(t, startCursor, <-primary.cursors)
+			select {
+			case gotCursor := <-secondary.cursors:
+				assert.Equal(t, tt.wantCursor, gotCursor)
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "ingester did not fail over to secondary")
+			}
+			require.Eventually(t, func() bool {
+				for {
+					select {
+					case evt := <-sched.events:
+						if evt.Did == "did:plc:secondary" {
+							return true
+						}
+					default:
+						return false
+					}
+				}
+			}, 5*time.Second, 10*time.Millisecond)
+			assert.Equal(t, secondary.url(), fi.jetstreamPool.pick())
+
+			cancel()
+			select {
+			case err := <-done:
+				assert.NoError(t, err)
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "readJetstream did not exit after cancellation")
+			}
+		})
+	}
+}
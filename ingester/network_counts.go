@@ -0,0 +1,186 @@
+package ingester
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/indigo/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+var networkInteractionsCounted = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bff_ingester_network_interactions_counted_total",
+	Help: "The total number of likes and reposts of candidate posts counted from anyone on the network, by collection.",
+}, []string{"collection"})
+
+// networkCounter collects the likes and reposts of candidate posts from anyone
+// on the network, and periodically adds them to the counts in the database.
+// Only the counts are kept, so likes and reposts which are deleted can't be
+// subtracted.
+//
+// The same event may be seen more than once, e.g when the cursor is rewound on
+// restart or failover, so the database remembers which likes and reposts have
+// been counted for a day, and skips those it has seen before.
+type networkCounter struct {
+	log      *slog.Logger
+	store    *store.PGXStore
+	interval time.Duration
+	// retention is how long counted likes and reposts are remembered for.
+	retention     time.Duration
+	pruneInterval time.Duration
+	lastPrunedAt  time.Time
+
+	mu sync.Mutex
+	// pending holds the interactions to count, keyed by the URI of the like or
+	// repost.
+	pending map[string]store.PostNetworkInteraction
+}
+
+func newNetworkCounter(log *slog.Logger, pgxStore *store.PGXStore) *networkCounter {
+	return &networkCounter{
+		log:           log,
+		store:         pgxStore,
+		interval:      time.Second * 10,
+		retention:     time.Hour * 24,
+		pruneInterval: time.Hour,
+		pending:       map[string]store.PostNetworkInteraction{},
+	}
+}
+
+func (nc *networkCounter) add(uri string, interaction store.PostNetworkInteraction) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	nc.pending[uri] = interaction
+}
+
+// flush writes the pending interactions to the database. If this fails, they're
+// kept to be written by the next flush.
+func (nc *networkCounter) flush(ctx context.Context) error {
+	nc.mu.Lock()
+	pending := nc.pending
+	nc.pending = map[string]store.PostNetworkInteraction{}
+	nc.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if err := nc.store.CountPostNetworkInteractions(ctx, pending); err != nil {
+		for uri, interaction := range pending {
+			nc.add(uri, interaction)
+		}
+		return fmt.Errorf("counting post network interactions: %w", err)
+	}
+	return nil
+}
+
+// prune forgets the likes and reposts counted longer ago than the retention
+// period, at most once per prune interval.
+func (nc *networkCounter) prune(ctx context.Context) error {
+	if time.Since(nc.lastPrunedAt) < nc.pruneInterval {
+		return nil
+	}
+	n, err := nc.store.DeleteOldPostNetworkInteractions(ctx, time.Now().Add(-nc.retention))
+	if err != nil {
+		return fmt.Errorf("deleting old post network interactions: %w", err)
+	}
+	nc.lastPrunedAt = time.Now()
+	nc.log.Info("pruned counted network interactions", slog.Int64("deleted", n))
+	return nil
+}
+
+// run flushes the tallies every interval until ctx is cancelled, and then
+// once more.
+func (nc *networkCounter) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			exitCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*10)
+			defer cancel()
+			if err := nc.flush(exitCtx); err != nil {
+				nc.log.Warn("failed to flush network counts", bfflog.Err(err))
+			}
+			return
+		case <-time.After(nc.interval):
+		}
+
+		if err := nc.flush(ctx); err != nil {
+			nc.log.Warn("failed to flush network counts", bfflog.Err(err))
+		}
+		if err := nc.prune(ctx); err != nil {
+			nc.log.Warn("failed to prune network interactions", bfflog.Err(err))
+		}
+	}
+}
+
+// countNetworkInteraction counts a newly created like or repost, from any
+// actor, if its subject is a post by an approved actor. Each like or repost is
+// only counted once, however many times its event is handled.
+func (fi *FirehoseIngester) countNetworkInteraction(
+	uri string, collection string, record json.RawMessage,
+) {
+	if fi.networkCounts == nil {
+		return
+	}
+
+	interaction, ok := fi.networkInteraction(collection, record)
+	if !ok {
+		return
+	}
+	fi.networkCounts.add(uri, interaction)
+	networkInteractionsCounted.WithLabelValues(collection).Inc()
+}
+
+// networkInteraction decodes a like or repost record, returning false if it
+// isn't one or its subject isn't a post by an approved actor.
+func (fi *FirehoseIngester) networkInteraction(
+	collection string, record json.RawMessage,
+) (store.PostNetworkInteraction, bool) {
+	var subject *atproto.RepoStrongRef
+	repost := false
+	// Records from anyone on the network are decoded here, so malformed ones
+	// are ignored rather than treated as a failure.
+	switch collection {
+	case "app.bsky.feed.like":
+		data := &bsky.FeedLike{}
+		if err := json.Unmarshal(record, data); err != nil {
+			return store.PostNetworkInteraction{}, false
+		}
+		subject = data.Subject
+	case "app.bsky.feed.repost":
+		data := &bsky.FeedRepost{}
+		if err := json.Unmarshal(record, data); err != nil {
+			return store.PostNetworkInteraction{}, false
+		}
+		subject, repost = data.Subject, true
+	default:
+		return store.PostNetworkInteraction{}, false
+	}
+	if subject == nil {
+		return store.PostNetworkInteraction{}, false
+	}
+
+	parsed, err := util.ParseAtUri(subject.Uri)
+	if err != nil || parsed.Collection != "app.bsky.feed.post" {
+		return store.PostNetworkInteraction{}, false
+	}
+	author := fi.actorCache.GetByDID(parsed.Did)
+	if author == nil || author.Status != v1.ActorStatus_ACTOR_STATUS_APPROVED {
+		return store.PostNetworkInteraction{}, false
+	}
+
+	return store.PostNetworkInteraction{
+		SubjectURI: subject.Uri,
+		Repost:     repost,
+	}, true
+}
@@ -0,0 +1,147 @@
+package ingester
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bffv1pb "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+func Test_countNetworkInteraction(t *testing.T) {
+	t.Parallel()
+
+	const authorDID = "did:plc:furry"
+	postURI := "at://" + authorDID + "/app.bsky.feed.post/paws"
+	otherURI := "at://did:plc:other/app.bsky.feed.post/paws"
+	fi := &FirehoseIngester{
+		actorCache: staticActorCache{actor: &bffv1pb.Actor{
+			Did:    authorDID,
+			Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+		}},
+		networkCounts: newNetworkCounter(slog.Default(), nil),
+	}
+
+	like := func(uri string) json.RawMessage {
+		raw, err := json.Marshal(&bsky.FeedLike{
+			LexiconTypeID: "app.bsky.feed.like",
+			Subject:       &atproto.RepoStrongRef{Uri: uri},
+		})
+		require.NoError(t, err)
+		return raw
+	}
+	repost, err := json.Marshal(&bsky.FeedRepost{
+		LexiconTypeID: "app.bsky.feed.repost",
+		Subject:       &atproto.RepoStrongRef{Uri: postURI},
+	})
+	require.NoError(t, err)
+
+	likeURI := func(rkey string) string {
+		return "at://did:plc:fan/app.bsky.feed.like/" + rkey
+	}
+	repostURI := "at://did:plc:fan/app.bsky.feed.repost/1"
+
+	fi.countNetworkInteraction(likeURI("1"), "app.bsky.feed.like", like(postURI))
+	fi.countNetworkInteraction(likeURI("2"), "app.bsky.feed.like", like(postURI))
+	// The same like seen twice is only counted once.
+	fi.countNetworkInteraction(likeURI("2"), "app.bsky.feed.like", like(postURI))
+	fi.countNetworkInteraction(repostURI, "app.bsky.feed.repost", repost)
+	// Interactions with posts by actors we don't know are ignored, as are
+	// malformed records and other collections.
+	fi.countNetworkInteraction(likeURI("3"), "app.bsky.feed.like", like(otherURI))
+	fi.countNetworkInteraction(likeURI("4"), "app.bsky.feed.like", json.RawMessage(`{"subject":1}`))
+	fi.countNetworkInteraction(likeURI("5"), "app.bsky.graph.follow", like(postURI))
+
+	assert.Equal(t, map[string]store.PostNetworkInteraction{
+		likeURI("1"): {SubjectURI: postURI},
+		likeURI("2"): {SubjectURI: postURI},
+		repostURI:    {SubjectURI: postURI, Repost: true},
+	}, fi.networkCounts.pending)
+}
+
+func TestNetworkCounter_flush(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	const authorDID = "did:plc:furry"
+	_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+		Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+		DID:    authorDID,
+	})
+	require.NoError(t, err)
+	postURI := "at://" + authorDID + "/app.bsky.feed.post/paws"
+	now := time.Now()
+	require.NoError(t, harness.Store.CreatePost(ctx, store.CreatePostOpts{
+		URI:       postURI,
+		ActorDID:  authorDID,
+		CreatedAt: now,
+		IndexedAt: now,
+	}))
+
+	like := func(rkey string) string {
+		return "at://did:plc:fan/app.bsky.feed.like/" + rkey
+	}
+	nc := newNetworkCounter(slog.Default(), harness.Store)
+	nc.add(like("1"), store.PostNetworkInteraction{SubjectURI: postURI})
+	nc.add(like("2"), store.PostNetworkInteraction{SubjectURI: postURI})
+	nc.add("at://did:plc:fan/app.bsky.feed.repost/1", store.PostNetworkInteraction{
+		SubjectURI: postURI,
+		Repost:     true,
+	})
+	// Posts we haven't ingested are discarded.
+	nc.add(like("3"), store.PostNetworkInteraction{
+		SubjectURI: "at://" + authorDID + "/app.bsky.feed.post/missing",
+	})
+	require.NoError(t, nc.flush(ctx))
+	nc.add(like("4"), store.PostNetworkInteraction{SubjectURI: postURI})
+	require.NoError(t, nc.flush(ctx))
+	assert.Empty(t, nc.pending)
+
+	counts, err := harness.Store.GetPostNetworkCounts(ctx, postURI)
+	require.NoError(t, err)
+	assert.Equal(t, store.PostNetworkCounts{Likes: 3, Reposts: 1}, counts)
+
+	// After a restart, the ingester rewinds its cursor, and sees events it
+	// has already counted again. Only the new like is counted.
+	restarted := newNetworkCounter(slog.Default(), harness.Store)
+	restarted.add(like("2"), store.PostNetworkInteraction{SubjectURI: postURI})
+	restarted.add(like("4"), store.PostNetworkInteraction{SubjectURI: postURI})
+	restarted.add(like("5"), store.PostNetworkInteraction{SubjectURI: postURI})
+	require.NoError(t, restarted.flush(ctx))
+
+	counts, err = harness.Store.GetPostNetworkCounts(ctx, postURI)
+	require.NoError(t, err)
+	assert.Equal(t, store.PostNetworkCounts{Likes: 4, Reposts: 1}, counts)
+
+	// They're only forgotten once they're older than the retention period.
+	require.NoError(t, restarted.prune(ctx))
+	n, err := harness.Store.DeleteOldPostNetworkInteractions(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), n)
+
+	// The post is only rescored whilst its counts change.
+	res, err := harness.Store.RescoreNetworkPostScores(ctx, now.Add(-time.Hour), 0.05)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), res.Rescored)
+	score, err := harness.Store.GetPostScore(ctx, postURI, "network", res.GenerationSeq)
+	require.NoError(t, err)
+	assert.InDelta(t, 5/math.Pow(2, 1.85), score.Score, 0.01)
+	res, err = harness.Store.RescoreNetworkPostScores(ctx, now.Add(-time.Hour), 0.05)
+	require.NoError(t, err)
+	assert.Zero(t, res.Rescored)
+}
@@ -0,0 +1,231 @@
+package ingester
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bluesky-social/jetstream/pkg/models"
+	"github.com/klauspost/compress/zstd"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+)
+
+const (
+	recordingPrefix = "jetstream-"
+	recordingSuffix = ".ndjson.zst"
+)
+
+var recorderErrors = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "bff_ingester_recorder_errors_total",
+	Help: "The total number of events which could not be written to a recording.",
+})
+
+// EventRecorder writes the Jetstream events received by the ingester to zstd
+// compressed NDJSON files in a directory, starting a new file periodically.
+// Each file is named after the time of the first event it contains, so that a
+// time range can be selected with RecordingFiles and replayed with
+// FirehoseIngester.Replay.
+type EventRecorder struct {
+	log *slog.Logger
+	dir string
+	// rotateInterval is how much event time a file covers before a new one
+	// is started.
+	rotateInterval time.Duration
+	// flushInterval is how often buffered events are flushed to disk, so
+	// that the current file can be read whilst it is being written.
+	flushInterval time.Duration
+
+	mu        sync.Mutex
+	f         *os.File
+	enc       *zstd.Encoder
+	fileStart time.Time
+	lastFlush time.Time
+}
+
+func NewEventRecorder(log *slog.Logger, dir string) (*EventRecorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating recording directory: %w", err)
+	}
+	return &EventRecorder{
+		log:            log,
+		dir:            dir,
+		rotateInterval: time.Hour,
+		flushInterval:  time.Second * 10,
+	}, nil
+}
+
+// record appends raw, the JSON encoding of evt as received, to the current
+// file. Failures are logged rather than returned, as recording should never
+// interrupt ingestion.
+func (r *EventRecorder) record(evt *models.Event, raw []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.recordLocked(evt, raw); err != nil {
+		recorderErrors.Inc()
+		r.log.Error("failed to record event", bfflog.Err(err))
+	}
+}
+
+func (r *EventRecorder) recordLocked(evt *models.Event, raw []byte) error {
+	evtTime := time.UnixMicro(evt.TimeUS)
+	if r.f == nil || evtTime.Sub(r.fileStart) >= r.rotateInterval {
+		if err := r.rotateLocked(evtTime); err != nil {
+			return fmt.Errorf("rotating file: %w", err)
+		}
+	}
+
+	if _, err := r.enc.Write(raw); err != nil {
+		return fmt.Errorf("writing event: %w", err)
+	}
+	if _, err := r.enc.Write([]byte("\n")); err != nil {
+		return fmt.Errorf("writing newline: %w", err)
+	}
+
+	if now := time.Now(); now.Sub(r.lastFlush) >= r.flushInterval {
+		r.lastFlush = now
+		if err := r.enc.Flush(); err != nil {
+			return fmt.Errorf("flushing: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *EventRecorder) rotateLocked(start time.Time) error {
+	if err := r.closeLocked(); err != nil {
+		return fmt.Errorf("closing previous file: %w", err)
+	}
+
+	// Appending is safe as concatenated zstd frames are read back as one
+	// stream. This can happen if the ingester restarts and rewinds.
+	path := filepath.Join(r.dir, recordingName(start))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening file: %w", err)
+	}
+	enc, err := zstd.NewWriter(f)
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("creating encoder: %w", err)
+	}
+
+	r.log.Info("started new recording file", slog.String("path", path))
+	r.f = f
+	r.enc = enc
+	r.fileStart = start
+	r.lastFlush = time.Now()
+	return nil
+}
+
+func (r *EventRecorder) closeLocked() error {
+	if r.f == nil {
+		return nil
+	}
+	encErr := r.enc.Close()
+	fErr := r.f.Close()
+	r.f = nil
+	r.enc = nil
+	return errors.Join(encErr, fErr)
+}
+
+// Close flushes and closes the current file.
+func (r *EventRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.closeLocked()
+}
+
+func recordingName(start time.Time) string {
+	return recordingPrefix + strconv.FormatInt(start.UnixMicro(), 10) + recordingSuffix
+}
+
+// RecordingFiles lists the recording files in dir which may contain events
+// between since and until, in the order they were recorded. A zero since or
+// until leaves that end of the range open.
+func RecordingFiles(dir string, since, until time.Time) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading directory: %w", err)
+	}
+
+	type recording struct {
+		path  string
+		start time.Time
+	}
+	var recordings []recording
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, recordingPrefix) || !strings.HasSuffix(name, recordingSuffix) {
+			continue
+		}
+		us, err := strconv.ParseInt(
+			strings.TrimSuffix(strings.TrimPrefix(name, recordingPrefix), recordingSuffix), 10, 64,
+		)
+		if err != nil {
+			continue
+		}
+		recordings = append(recordings, recording{
+			path:  filepath.Join(dir, name),
+			start: time.UnixMicro(us),
+		})
+	}
+	sort.Slice(recordings, func(i, j int) bool {
+		return recordings[i].start.Before(recordings[j].start)
+	})
+
+	var out []string
+	for i, rec := range recordings {
+		if !until.IsZero() && rec.start.After(until) {
+			break
+		}
+		// A file ends where the next one starts.
+		if !since.IsZero() && i+1 < len(recordings) && !recordings[i+1].start.After(since) {
+			continue
+		}
+		out = append(out, rec.path)
+	}
+	return out, nil
+}
+
+// ReadRecording calls fn with each event in a recording file, in the order
+// they were received. A file which ends part way through, because it is still
+// being written or the recorder did not close it, is read up to that point.
+func ReadRecording(path string, fn func(evt *models.Event) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening file: %w", err)
+	}
+	defer f.Close()
+
+	zr, err := zstd.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("creating decoder: %w", err)
+	}
+	defer zr.Close()
+
+	dec := json.NewDecoder(zr)
+	for {
+		evt := &models.Event{}
+		if err := dec.Decode(evt); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return fmt.Errorf("decoding event: %w", err)
+		}
+		if err := fn(evt); err != nil {
+			return err
+		}
+	}
+}
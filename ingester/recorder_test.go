@@ -0,0 +1,228 @@
+package ingester
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/jetstream/pkg/models"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+func recordEvents(t *testing.T, rec *EventRecorder, events []*models.Event) {
+	t.Helper()
+	for _, evt := range events {
+		raw, err := json.Marshal(evt)
+		require.NoError(t, err)
+		rec.record(evt, raw)
+	}
+}
+
+func readRecordings(t *testing.T, paths []string) []int64 {
+	t.Helper()
+	got := []int64{}
+	for _, path := range paths {
+		require.NoError(t, ReadRecording(path, func(evt *models.Event) error {
+			got = append(got, evt.TimeUS)
+			return nil
+		}))
+	}
+	return got
+}
+
+func TestEventRecorder(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	rec, err := NewEventRecorder(slog.Default(), dir)
+	require.NoError(t, err)
+
+	start := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	var events []*models.Event
+	var want []int64
+	// Three hours of events, one every ten minutes.
+	for i := 0; i < 18; i++ {
+		us := start.Add(time.Duration(i) * 10 * time.Minute).UnixMicro()
+		events = append(events, &models.Event{Did: "did:plc:a", TimeUS: us, Kind: models.EventKindCommit})
+		want = append(want, us)
+	}
+	recordEvents(t, rec, events)
+	require.NoError(t, rec.Close())
+
+	all, err := RecordingFiles(dir, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+	assert.Equal(t, want, readRecordings(t, all))
+
+	// Only files which may contain events in the range are returned.
+	ranged, err := RecordingFiles(dir, start.Add(90*time.Minute), start.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, all[1:], ranged)
+
+	t.Run("unclosed file", func(t *testing.T) {
+		dir := t.TempDir()
+		rec, err := NewEventRecorder(slog.Default(), dir)
+		require.NoError(t, err)
+		rec.flushInterval = 0
+		recordEvents(t, rec, events[:2])
+		t.Cleanup(func() { _ = rec.Close() })
+
+		files, err := RecordingFiles(dir, time.Time{}, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, want[:2], readRecordings(t, files))
+	})
+
+	t.Run("truncated file", func(t *testing.T) {
+		b, err := os.ReadFile(all[0])
+		require.NoError(t, err)
+		truncated := filepath.Join(t.TempDir(), recordingName(start))
+		require.NoError(t, os.WriteFile(truncated, b[:len(b)-5], 0o644))
+
+		got := readRecordings(t, []string{truncated})
+		assert.Less(t, len(got), 6)
+	})
+}
+
+func TestFirehoseIngester_Replay_realTime(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	rec, err := NewEventRecorder(slog.Default(), dir)
+	require.NoError(t, err)
+	start := time.Now()
+	event := func(offset time.Duration) *models.Event {
+		// Posts by an untracked actor are ignored without touching the
+		// store.
+		return &models.Event{
+			Did:    "did:plc:untracked",
+			TimeUS: start.Add(offset).UnixMicro(),
+			Kind:   models.EventKindCommit,
+			Commit: &models.Commit{
+				Operation:  models.CommitOperationCreate,
+				Collection: "app.bsky.feed.post",
+				RKey:       "post",
+				Record:     json.RawMessage(`{}`),
+			},
+		}
+	}
+	recordEvents(t, rec, []*models.Event{event(0), event(time.Minute)})
+	require.NoError(t, rec.Close())
+	files, err := RecordingFiles(dir, time.Time{}, time.Time{})
+	require.NoError(t, err)
+
+	clock := clockwork.NewFakeClock()
+	fi := &FirehoseIngester{
+		log:             slog.Default(),
+		clock:           clock,
+		actorCache:      staticActorCache{actor: &v1.Actor{}},
+		workItemTimeout: time.Second,
+	}
+	done := make(chan ReplayStats)
+	go func() {
+		stats, err := fi.Replay(ctx, files, ReplayOpts{RealTime: true})
+		assert.NoError(t, err)
+		done <- stats
+	}()
+
+	// The second event waits for a minute to pass.
+	clock.BlockUntil(1)
+	select {
+	case <-done:
+		require.FailNow(t, "replay did not wait for the second event")
+	default:
+	}
+	clock.Advance(time.Minute)
+	select {
+	case stats := <-done:
+		assert.Equal(t, ReplayStats{Handled: 2}, stats)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "replay did not finish")
+	}
+}
+
+// TestFirehoseIngester_Replay records interleaved creates and deletes, and
+// checks that replaying them into a fresh database, twice, leaves the same
+// state.
+func TestFirehoseIngester_Replay(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	repos := []string{"did:plc:one", "did:plc:two"}
+	for _, repo := range repos {
+		_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+			Status: v1.ActorStatus_ACTOR_STATUS_APPROVED,
+			DID:    repo,
+		})
+		require.NoError(t, err)
+	}
+	cac := NewActorCache(slog.Default(), harness.Store)
+	require.NoError(t, cac.Sync(ctx))
+	fi := NewFirehoseIngester(slog.Default(), harness.Store, cac)
+
+	record, err := json.Marshal(&bsky.FeedPost{
+		LexiconTypeID: "app.bsky.feed.post",
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339Nano),
+		Text:          "paws",
+	})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	rec, err := NewEventRecorder(slog.Default(), dir)
+	require.NoError(t, err)
+	ops := interleavedRecordOps(repos, 6)
+	want := map[string]bool{}
+	var events []*models.Event
+	for i, op := range ops {
+		want[op.uri] = !op.delete
+		evt := &models.Event{
+			Did:    op.repo,
+			TimeUS: time.Now().Add(time.Duration(i) * time.Second).UnixMicro(),
+			Kind:   models.EventKindCommit,
+			Commit: &models.Commit{
+				Operation:  models.CommitOperationCreate,
+				Collection: "app.bsky.feed.post",
+				RKey:       op.rkey,
+				Record:     record,
+			},
+		}
+		if op.delete {
+			evt.Commit.Operation = models.CommitOperationDelete
+			evt.Commit.Record = nil
+		}
+		events = append(events, evt)
+	}
+	recordEvents(t, rec, events)
+	require.NoError(t, rec.Close())
+	files, err := RecordingFiles(dir, time.Time{}, time.Time{})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		stats, err := fi.Replay(ctx, files, ReplayOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, ReplayStats{Handled: len(ops)}, stats)
+
+		for uri, live := range want {
+			post, err := harness.Store.GetPostByURI(ctx, uri)
+			require.NoError(t, err)
+			assert.Equal(t, !live, post.DeletedAt.Valid, uri)
+		}
+	}
+}
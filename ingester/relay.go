@@ -0,0 +1,579 @@
+package ingester
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/events"
+	lexutil "github.com/bluesky-social/indigo/lex/util"
+	"github.com/bluesky-social/indigo/repo"
+	"github.com/bluesky-social/jetstream/pkg/models"
+	"github.com/gorilla/websocket"
+	"github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	did "github.com/whyrusleeping/go-did"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+const DefaultRelayURL = "wss://bsky.network"
+
+var flushedRelayCursor = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "bff_ingester_flushed_relay_cursor",
+	Help: "The current relay sequence number flushed to persistent storage.",
+})
+
+var relayCommitsRejected = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "bff_ingester_relay_commits_rejected_total",
+	Help: "The total number of relay commits dropped as their signature could not be verified.",
+})
+
+// ingestedCollections are the collections the ingesters act upon. Ops in other
+// collections are skipped before any records are decoded.
+var ingestedCollections = map[string]bool{
+	"app.bsky.actor.profile": true,
+	"app.bsky.feed.like":     true,
+	"app.bsky.feed.post":     true,
+	"app.bsky.graph.follow":  true,
+}
+
+// DIDResolver fetches the DID document for a DID, e.g from plc.directory.
+type DIDResolver interface {
+	GetDocument(ctx context.Context, did string) (*did.Document, error)
+}
+
+// RelayIngester is an alternative to the Jetstream backed FirehoseIngester
+// which consumes com.atproto.sync.subscribeRepos from a relay directly. Unlike
+// Jetstream, the relay provides signed commits, so we can verify that the
+// records we ingest were created by the actor.
+//
+// Records are handled by the same handlers as the FirehoseIngester.
+type RelayIngester struct {
+	// dependencies
+	log         *slog.Logger
+	actorCache  actorCacher
+	store       *store.PGXStore
+	handler     *FirehoseIngester
+	didResolver DIDResolver
+
+	// configuration
+	relayURL            string
+	workerCount         int
+	cursorFlushInterval time.Duration
+	reconnectDelay      time.Duration
+}
+
+func NewRelayIngester(
+	log *slog.Logger,
+	store *store.PGXStore,
+	crc *ActorCache,
+	relayURL string,
+	didResolver DIDResolver,
+) *RelayIngester {
+	if relayURL == "" {
+		relayURL = DefaultRelayURL
+	}
+
+	return &RelayIngester{
+		log:         log,
+		actorCache:  crc,
+		store:       store,
+		didResolver: didResolver,
+		handler: &FirehoseIngester{
+			log:           log,
+			clock:         clockwork.NewRealClock(),
+			actorCache:    crc,
+			store:         store,
+			networkCounts: newNetworkCounter(bfflog.ChildLogger(log, "network_counter"), store),
+
+			workItemTimeout:         time.Second * 30,
+			deadLetterRetryInterval: time.Second * 30,
+		},
+
+		relayURL:            relayURL,
+		workerCount:         20,
+		cursorFlushInterval: time.Second * 10,
+		reconnectDelay:      time.Second * 5,
+	}
+}
+
+func (ri *RelayIngester) subscribeURL(cursor int64) string {
+	u := strings.TrimSuffix(ri.relayURL, "/") + "/xrpc/com.atproto.sync.subscribeRepos"
+	if cursor > 0 {
+		u += "?" + url.Values{"cursor": {strconv.FormatInt(cursor, 10)}}.Encode()
+	}
+	return u
+}
+
+// relayStartRewind is how many events to step back from the persisted cursor
+// when starting, to allow recovery.
+const relayStartRewind = 1000
+
+func (ri *RelayIngester) Start(ctx context.Context) (err error) {
+	eg, ctx := errgroup.WithContext(ctx)
+
+	seqs := newRelaySeqTracker()
+	deadLettered := newDeadLetteredRepos()
+	sched := newRepoScheduler(
+		ctx,
+		bfflog.ChildLogger(ri.log, "relay_scheduler"),
+		"relay",
+		ri.workerCount,
+		100,
+		func(ctx context.Context, item relayWorkItem) error {
+			if item.retryRepo != "" {
+				if err := ri.handler.retryRepoDeadLetters(ctx, deadLettered, item.retryRepo); err != nil {
+					return fmt.Errorf("retrying dead letter events: %w", err)
+				}
+				return nil
+			}
+
+			xev := item.xev
+			start := time.Now()
+			if err := ri.handleEvent(ctx, deadLettered, xev); err != nil {
+				return err
+			}
+			if seq, ok := relayEventSeq(xev); ok {
+				seqs.finish(seq)
+			}
+			if xev.RepoCommit != nil {
+				workItemsProcessed.
+					WithLabelValues("relay_commit").
+					Observe(time.Since(start).Seconds())
+			}
+			return nil
+		},
+	)
+	defer sched.Shutdown()
+
+	initCursor, err := ri.store.GetRelayCursor(ctx)
+	if err != nil {
+		return fmt.Errorf("get relay cursor: %w", err)
+	}
+	if initCursor > relayStartRewind {
+		initCursor -= relayStartRewind
+	}
+
+	eg.Go(func() error {
+		ri.handler.networkCounts.run(ctx)
+		return nil
+	})
+
+	eg.Go(func() error {
+		return ri.handler.retryDeadLetters(
+			ctx,
+			deadLettered,
+			func(string) bool { return true },
+			func(ctx context.Context, repo string) error {
+				return sched.AddWork(ctx, repo, relayWorkItem{retryRepo: repo})
+			},
+		)
+	})
+
+	eg.Go(func() error {
+		for {
+			cursor := seqs.cursor()
+			if cursor == 0 {
+				cursor = initCursor
+			}
+			// Events still being handled from the last connection will be
+			// read again.
+			seqs.reset()
+
+			ri.log.Info(
+				"starting ingestion",
+				slog.Int64("cursor", cursor),
+				slog.String("relay_url", ri.relayURL),
+			)
+			if err := ri.connectAndRead(ctx, cursor, &relayScheduler{next: sched, seqs: seqs}); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				ri.log.Error("relay stream encountered an error, restarting", bfflog.Err(err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(ri.reconnectDelay):
+			}
+		}
+	})
+
+	flushCursor := func(ctx context.Context) {
+		cursor := seqs.cursor()
+		if cursor == 0 {
+			ri.log.Warn("no cursor value to persist")
+			return
+		}
+		if cursor <= initCursor {
+			// attempt to avoid a scenario where a crash loop sends the
+			// cursor further and further into the past.
+			ri.log.Warn("not setting cursor to avoid regression")
+			return
+		}
+		if err := ri.store.SetRelayCursor(ctx, cursor); err != nil {
+			ri.log.Warn("failed to flush cursor", bfflog.Err(err))
+			return
+		}
+
+		ri.log.Info(
+			"successfully flushed cursor",
+			slog.Int64("cursor", cursor),
+		)
+		flushedRelayCursor.Set(float64(cursor))
+	}
+
+	eg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				ri.log.Warn("cursor flushing worker exiting")
+				return nil
+			case <-time.After(ri.cursorFlushInterval):
+			}
+
+			flushCursor(ctx)
+		}
+	})
+
+	err = eg.Wait()
+
+	// Perform a final cursor flush.
+	ri.log.Info("performing final flush of cursor")
+	exitCtx, cancelExitCtx := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancelExitCtx()
+	flushCursor(exitCtx)
+
+	return err
+}
+
+// relayWorkItem is handled by a repo's worker. It's either an event from the
+// relay, or a request to retry the repo's dead lettered events.
+type relayWorkItem struct {
+	xev *events.XRPCStreamEvent
+	// retryRepo is set to the repo whose dead lettered events are due a retry.
+	retryRepo string
+}
+
+// relayScheduler queues the events read from a relay connection, and tracks
+// their sequence numbers.
+type relayScheduler struct {
+	next *repoScheduler[relayWorkItem]
+	seqs *relaySeqTracker
+}
+
+func (rs *relayScheduler) AddWork(ctx context.Context, repo string, xev *events.XRPCStreamEvent) error {
+	if seq, ok := relayEventSeq(xev); ok {
+		rs.seqs.read(seq)
+	}
+	return rs.next.AddWork(ctx, repo, relayWorkItem{xev: xev})
+}
+
+// Shutdown does nothing. HandleRepoStream calls it when the connection ends,
+// but the scheduler outlives connections, and is shut down by Start.
+func (rs *relayScheduler) Shutdown() {}
+
+// relayEventSeq returns the sequence number of a relay event, if it has one.
+func relayEventSeq(xev *events.XRPCStreamEvent) (int64, bool) {
+	switch {
+	case xev.RepoCommit != nil:
+		return xev.RepoCommit.Seq, true
+	case xev.RepoAccount != nil:
+		return xev.RepoAccount.Seq, true
+	case xev.RepoIdentity != nil:
+		return xev.RepoIdentity.Seq, true
+	case xev.RepoHandle != nil:
+		return xev.RepoHandle.Seq, true
+	case xev.RepoMigrate != nil:
+		return xev.RepoMigrate.Seq, true
+	case xev.RepoTombstone != nil:
+		return xev.RepoTombstone.Seq, true
+	default:
+		return 0, false
+	}
+}
+
+// relaySeqTracker tracks which relay events have been handled, so the cursor is
+// only advanced past an event once it and every event before it has been
+// handled. Events for different repos are handled concurrently, so may finish
+// out of order.
+type relaySeqTracker struct {
+	mu sync.Mutex
+	// queued holds the sequence numbers of the events read but not yet
+	// handled, in the order they were read, and finished whether each has
+	// since been handled.
+	queued   []int64
+	finished map[int64]bool
+	handled  int64
+}
+
+func newRelaySeqTracker() *relaySeqTracker {
+	return &relaySeqTracker{finished: map[int64]bool{}}
+}
+
+func (t *relaySeqTracker) read(seq int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.queued = append(t.queued, seq)
+	t.finished[seq] = false
+}
+
+func (t *relaySeqTracker) finish(seq int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.finished[seq]; !ok {
+		// The event was read by a previous connection.
+		return
+	}
+	t.finished[seq] = true
+	for len(t.queued) > 0 && t.finished[t.queued[0]] {
+		t.handled = t.queued[0]
+		delete(t.finished, t.queued[0])
+		t.queued = t.queued[1:]
+	}
+}
+
+// reset forgets the events which haven't been handled yet, as they will be
+// read again by the next connection.
+func (t *relaySeqTracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.queued = nil
+	t.finished = map[int64]bool{}
+}
+
+// cursor returns the sequence number of the last event which has been handled
+// along with every event before it, or zero if there isn't one.
+func (t *relaySeqTracker) cursor() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.handled
+}
+
+// handleEvent handles an event from the relay. Each of its records is handled
+// like a Jetstream event, so it's dead lettered if handling fails. An error is
+// only returned if dead lettering fails too, in which case the event isn't
+// marked as handled and the cursor is held back so it's read again.
+func (ri *RelayIngester) handleEvent(
+	ctx context.Context, deadLettered *deadLetteredRepos, xev *events.XRPCStreamEvent,
+) error {
+	var evts []*models.Event
+	switch {
+	case xev.RepoAccount != nil:
+		evts = []*models.Event{{
+			Did:     xev.RepoAccount.Did,
+			TimeUS:  relayEventTime(xev.RepoAccount.Time).UnixMicro(),
+			Kind:    models.EventKindAccount,
+			Account: xev.RepoAccount,
+		}}
+	case xev.RepoIdentity != nil:
+		evts = []*models.Event{{
+			Did:      xev.RepoIdentity.Did,
+			TimeUS:   relayEventTime(xev.RepoIdentity.Time).UnixMicro(),
+			Kind:     models.EventKindIdentity,
+			Identity: xev.RepoIdentity,
+		}}
+	case xev.RepoCommit != nil:
+		var err error
+		evts, err = ri.commitEvents(ctx, xev.RepoCommit)
+		if err != nil {
+			// Retrying won't help a commit we can't decode or verify, so it's
+			// dropped.
+			ri.log.Warn(
+				"dropping relay commit",
+				bfflog.Err(err),
+				bfflog.ActorDID(xev.RepoCommit.Repo),
+				slog.Int64("seq", xev.RepoCommit.Seq),
+			)
+			return nil
+		}
+	}
+
+	for _, evt := range evts {
+		if err := ri.handler.handleLiveEvent(ctx, deadLettered, evt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func relayEventTime(t string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, t)
+	if err != nil {
+		return time.Now()
+	}
+	return parsed
+}
+
+func (ri *RelayIngester) connectAndRead(
+	ctx context.Context, cursor int64, sched events.Scheduler,
+) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, ri.subscribeURL(cursor), nil)
+	if err != nil {
+		return fmt.Errorf("dialing relay: %w", err)
+	}
+	defer conn.Close()
+
+	// HandleRepoStream only returns when the connection fails, so we close
+	// the connection when the context is cancelled to unblock it.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	return events.HandleRepoStream(ctx, conn, sched, ri.log)
+}
+
+// commitEvents verifies a commit, and decodes its ops in the collections we
+// ingest into the equivalent Jetstream events.
+func (ri *RelayIngester) commitEvents(
+	ctx context.Context, evt *atproto.SyncSubscribeRepos_Commit,
+) (out []*models.Event, err error) {
+	ctx, span := tracer.Start(ctx, "relay_ingester.decode_commit")
+	defer func() {
+		endSpan(span, err)
+	}()
+	span.SetAttributes(actorDIDAttr(evt.Repo))
+
+	if evt.TooBig {
+		// Too big commits do not include their blocks. We'd need to fetch the
+		// repo to process them, and these are rare enough to skip.
+		ri.log.Warn("skipping commit that is too big", bfflog.ActorDID(evt.Repo))
+		return nil, nil
+	}
+
+	ops := relevantOps(evt.Ops)
+	if len(ops) == 0 {
+		return nil, nil
+	}
+
+	rr, err := repo.ReadRepoFromCar(ctx, bytes.NewReader(evt.Blocks))
+	if err != nil {
+		return nil, fmt.Errorf("reading repo from car: %w", err)
+	}
+
+	timeUS := relayEventTime(evt.Time).UnixMicro()
+	for _, op := range ops {
+		collection, rkey, _ := strings.Cut(op.Path, "/")
+		commit := &models.Commit{
+			Rev:        evt.Rev,
+			Operation:  op.Action,
+			Collection: collection,
+			RKey:       rkey,
+		}
+		if op.Cid != nil {
+			commit.CID = op.Cid.String()
+		}
+
+		switch op.Action {
+		case "create", "update":
+			record, err := recordJSON(ctx, rr, op.Path)
+			if err != nil {
+				return nil, fmt.Errorf("%s (%s): decoding record: %w", op.Action, op.Path, err)
+			}
+			commit.Record = record
+		case "delete":
+		default:
+			ri.log.Warn("unknown commit operation", slog.String("action", op.Action))
+			continue
+		}
+		out = append(out, &models.Event{
+			Did:    evt.Repo,
+			TimeUS: timeUS,
+			Kind:   models.EventKindCommit,
+			Commit: commit,
+		})
+	}
+
+	// Every commit we act upon is verified. To avoid resolving the DID of
+	// every actor on the network, ops of actors we don't track are dropped
+	// unless they would be acted upon, and their commit is only verified if
+	// any remain.
+	if ri.actorCache.GetByDID(evt.Repo) == nil {
+		out = slices.DeleteFunc(out, func(e *models.Event) bool {
+			return e.Commit.Operation != models.CommitOperationCreate ||
+				!ri.handler.actsOnUntrackedRecord(e.Commit.Collection, e.Commit.Record)
+		})
+		if len(out) == 0 {
+			return nil, nil
+		}
+	}
+	if err := verifyCommit(ctx, ri.didResolver, evt.Repo, rr); err != nil {
+		relayCommitsRejected.Inc()
+		return nil, fmt.Errorf("verifying commit: %w", err)
+	}
+
+	return out, nil
+}
+
+// relevantOps filters a commit's ops to those in collections we ingest.
+func relevantOps(ops []*atproto.SyncSubscribeRepos_RepoOp) []*atproto.SyncSubscribeRepos_RepoOp {
+	var out []*atproto.SyncSubscribeRepos_RepoOp
+	for _, op := range ops {
+		collection, _, _ := strings.Cut(op.Path, "/")
+		if ingestedCollections[collection] {
+			out = append(out, op)
+		}
+	}
+	return out
+}
+
+// recordJSON decodes the CBOR record at path into the JSON the handlers
+// expect.
+func recordJSON(ctx context.Context, rr *repo.Repo, path string) (json.RawMessage, error) {
+	_, recB, err := rr.GetRecordBytes(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("getting record bytes: %w", err)
+	}
+	if recB == nil {
+		return nil, fmt.Errorf("record not found in commit blocks")
+	}
+	rec, err := lexutil.CborDecodeValue(*recB)
+	if err != nil {
+		return nil, fmt.Errorf("decoding cbor: %w", err)
+	}
+	return json.Marshal(rec)
+}
+
+// verifyCommit checks that the commit in rr was signed by the atproto signing
+// key in the DID document of repoDID.
+func verifyCommit(
+	ctx context.Context, resolver DIDResolver, repoDID string, rr *repo.Repo,
+) error {
+	sc := rr.SignedCommit()
+	if sc.Did != repoDID {
+		return fmt.Errorf("commit is for %q not %q", sc.Did, repoDID)
+	}
+
+	doc, err := resolver.GetDocument(ctx, repoDID)
+	if err != nil {
+		return fmt.Errorf("resolving did: %w", err)
+	}
+	pubKey, err := doc.GetPublicKey("#atproto")
+	if err != nil {
+		return fmt.Errorf("getting signing key: %w", err)
+	}
+
+	msg, err := sc.Unsigned().BytesForSigning()
+	if err != nil {
+		return fmt.Errorf("serializing commit: %w", err)
+	}
+	if err := pubKey.Verify(msg, sc.Sig); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	return nil
+}
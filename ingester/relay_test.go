@@ -0,0 +1,309 @@
+package ingester
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/api/bsky"
+	lexutil "github.com/bluesky-social/indigo/lex/util"
+	"github.com/bluesky-social/indigo/repo"
+	indigoTest "github.com/bluesky-social/indigo/testing"
+	"github.com/ipfs/go-datastore"
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	did "github.com/whyrusleeping/go-did"
+
+	bffv1pb "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+type staticDIDResolver map[string]*did.Document
+
+func (r staticDIDResolver) GetDocument(_ context.Context, d string) (*did.Document, error) {
+	doc, ok := r[d]
+	if !ok {
+		return nil, fmt.Errorf("unknown did %q", d)
+	}
+	return doc, nil
+}
+
+func newSigningKeyDocument(t *testing.T, d string, key *did.PrivKey) *did.Document {
+	t.Helper()
+	parsed, err := did.ParseDID(d)
+	require.NoError(t, err)
+	vm, err := did.VerificationMethodFromKey(key.Public())
+	require.NoError(t, err)
+	return &did.Document{
+		ID: parsed,
+		VerificationMethod: []did.VerificationMethod{{
+			ID:                 "#atproto",
+			Type:               vm.Type,
+			Controller:         d,
+			PublicKeyMultibase: vm.PublicKeyMultibase,
+		}},
+	}
+}
+
+func newSignedRepo(t *testing.T, d string, key *did.PrivKey) *repo.Repo {
+	t.Helper()
+	ctx := context.Background()
+	rr := repo.NewRepo(ctx, d, blockstore.NewBlockstore(datastore.NewMapDatastore()))
+	_, _, err := rr.CreateRecord(ctx, "app.bsky.feed.post", &bsky.FeedPost{Text: "paws"})
+	require.NoError(t, err)
+	_, _, err = rr.Commit(ctx, func(_ context.Context, _ string, b []byte) ([]byte, error) {
+		return key.Sign(b)
+	})
+	require.NoError(t, err)
+	return rr
+}
+
+func Test_verifyCommit(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const actorDID = "did:plc:signer"
+	key, err := did.GeneratePrivKey(rand.Reader, did.KeyTypeSecp256k1)
+	require.NoError(t, err)
+	otherKey, err := did.GeneratePrivKey(rand.Reader, did.KeyTypeSecp256k1)
+	require.NoError(t, err)
+
+	rr := newSignedRepo(t, actorDID, key)
+
+	t.Run("valid", func(t *testing.T) {
+		resolver := staticDIDResolver{actorDID: newSigningKeyDocument(t, actorDID, key)}
+		assert.NoError(t, verifyCommit(ctx, resolver, actorDID, rr))
+	})
+	t.Run("wrong key", func(t *testing.T) {
+		resolver := staticDIDResolver{actorDID: newSigningKeyDocument(t, actorDID, otherKey)}
+		assert.ErrorContains(t, verifyCommit(ctx, resolver, actorDID, rr), "invalid signature")
+	})
+	t.Run("commit for another repo", func(t *testing.T) {
+		resolver := staticDIDResolver{"did:plc:other": newSigningKeyDocument(t, "did:plc:other", key)}
+		assert.ErrorContains(t, verifyCommit(ctx, resolver, "did:plc:other", rr), "commit is for")
+	})
+	t.Run("unresolvable", func(t *testing.T) {
+		assert.ErrorContains(t, verifyCommit(ctx, staticDIDResolver{}, actorDID, rr), "resolving did")
+	})
+}
+
+func Test_relevantOps(t *testing.T) {
+	t.Parallel()
+
+	ops := []*atproto.SyncSubscribeRepos_RepoOp{
+		{Action: "create", Path: "app.bsky.feed.post/1"},
+		{Action: "create", Path: "app.bsky.feed.repost/2"},
+		{Action: "delete", Path: "app.bsky.graph.follow/3"},
+		{Action: "update", Path: "app.bsky.actor.profile/self"},
+		{Action: "create", Path: "app.bsky.graph.block/4"},
+	}
+	got := relevantOps(ops)
+	paths := []string{}
+	for _, op := range got {
+		paths = append(paths, op.Path)
+	}
+	assert.Equal(t, []string{
+		"app.bsky.feed.post/1",
+		"app.bsky.graph.follow/3",
+		"app.bsky.actor.profile/self",
+	}, paths)
+}
+
+func Test_actsOnUntrackedRecord(t *testing.T) {
+	t.Parallel()
+
+	const authorDID = "did:plc:furry"
+	fi := &FirehoseIngester{
+		actorCache: staticActorCache{actor: &bffv1pb.Actor{
+			Did:    authorDID,
+			Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+		}},
+		networkCounts: newNetworkCounter(slog.Default(), nil),
+	}
+	marshal := func(v any) json.RawMessage {
+		raw, err := json.Marshal(v)
+		require.NoError(t, err)
+		return raw
+	}
+	like := func(uri string) json.RawMessage {
+		return marshal(&bsky.FeedLike{Subject: &atproto.RepoStrongRef{Uri: uri}})
+	}
+
+	tests := []struct {
+		name       string
+		collection string
+		record     json.RawMessage
+		want       bool
+	}{
+		{
+			name:       "follow of furryli.st",
+			collection: "app.bsky.graph.follow",
+			record:     marshal(&bsky.GraphFollow{Subject: "did:plc:jdkvwye2lf4mingzk7qdebzc"}),
+			want:       true,
+		},
+		{
+			name:       "other follow",
+			collection: "app.bsky.graph.follow",
+			record:     marshal(&bsky.GraphFollow{Subject: authorDID}),
+		},
+		{
+			name:       "like of approved actor's post",
+			collection: "app.bsky.feed.like",
+			record:     like("at://" + authorDID + "/app.bsky.feed.post/paws"),
+			want:       true,
+		},
+		{
+			name:       "like of other post",
+			collection: "app.bsky.feed.like",
+			record:     like("at://did:plc:other/app.bsky.feed.post/paws"),
+		},
+		{
+			name:       "post",
+			collection: "app.bsky.feed.post",
+			record:     marshal(&bsky.FeedPost{Text: "paws"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fi.actsOnUntrackedRecord(tt.collection, tt.record))
+		})
+	}
+}
+
+func Test_relaySeqTracker(t *testing.T) {
+	t.Parallel()
+
+	seqs := newRelaySeqTracker()
+	assert.Equal(t, int64(0), seqs.cursor())
+
+	for _, seq := range []int64{10, 11, 12, 13} {
+		seqs.read(seq)
+	}
+	// 12 and 13 finishing first must not advance the cursor past 10 and 11,
+	// which are still being handled.
+	seqs.finish(12)
+	seqs.finish(13)
+	assert.Equal(t, int64(0), seqs.cursor())
+	seqs.finish(10)
+	assert.Equal(t, int64(10), seqs.cursor())
+	seqs.finish(11)
+	assert.Equal(t, int64(13), seqs.cursor())
+
+	// Events read by a previous connection are forgotten on reconnect, and
+	// will be read again.
+	seqs.read(14)
+	seqs.read(15)
+	seqs.reset()
+	seqs.finish(15)
+	assert.Equal(t, int64(13), seqs.cursor())
+	seqs.read(14)
+	seqs.read(15)
+	seqs.finish(14)
+	assert.Equal(t, int64(14), seqs.cursor())
+}
+
+// fakePLCResolver adapts the test PLC, which names the signing key
+// "#signingKey" rather than "#atproto" as the real PLC does.
+type fakePLCResolver struct {
+	DIDResolver
+}
+
+func (r fakePLCResolver) GetDocument(ctx context.Context, d string) (*did.Document, error) {
+	doc, err := r.DIDResolver.GetDocument(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	for i := range doc.VerificationMethod {
+		if doc.VerificationMethod[i].ID == "#signingKey" {
+			doc.VerificationMethod[i].ID = "#atproto"
+		}
+	}
+	return doc, nil
+}
+
+func TestRelayIngester(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+	harness.PDS.RequestScraping(t, harness.Relay)
+
+	nonFurry := harness.PDS.MustNewUser(t, "non-furry.tpds")
+	approvedFurry := harness.PDS.MustNewUser(t, "approvedFurry.tpds")
+	_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+		Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+		DID:    approvedFurry.DID(),
+	})
+	require.NoError(t, err)
+
+	cac := NewActorCache(slog.Default(), harness.Store)
+	require.NoError(t, cac.Sync(ctx))
+
+	ri := NewRelayIngester(
+		slog.Default(),
+		harness.Store,
+		cac,
+		"ws://"+harness.Relay.Host(),
+		fakePLCResolver{harness.PLC},
+	)
+	riContext, riCancel := context.WithCancel(ctx)
+	riWait := make(chan struct{})
+	go func() {
+		if err := ri.Start(riContext); err != nil {
+			if riContext.Err() == nil {
+				require.NoError(t, err)
+			}
+		}
+		close(riWait)
+	}()
+
+	createPost := func(user *indigoTest.TestUser, text string) string {
+		resp, err := atproto.RepoCreateRecord(ctx, testenv.ExtractClientFromTestUser(user), &atproto.RepoCreateRecord_Input{
+			Collection: "app.bsky.feed.post",
+			Repo:       user.DID(),
+			Record: &lexutil.LexiconTypeDecoder{
+				Val: &bsky.FeedPost{
+					LexiconTypeID: "app.bsky.feed.post",
+					CreatedAt:     time.Now().UTC().Format(time.RFC3339Nano),
+					Text:          text,
+				},
+			},
+		})
+		require.NoError(t, err)
+		return resp.Uri
+	}
+	furryPostURI := createPost(approvedFurry, "paws paws paws")
+	nonFurryPostURI := createPost(nonFurry, "lorem ipsum dolor sit amet")
+
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		post, err := harness.Store.GetPostByURI(ctx, furryPostURI)
+		if assert.NoError(t, err) {
+			assert.Equal(t, approvedFurry.DID(), post.ActorDID)
+		}
+	}, time.Second*10, time.Millisecond*100)
+
+	_, err = harness.Store.GetPostByURI(ctx, nonFurryPostURI)
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	// Ensure ingester closes properly and persists its cursor.
+	riCancel()
+	select {
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "relay ingester did not finish within deadline")
+	case <-riWait:
+	}
+	cursor, err := harness.Store.GetRelayCursor(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, cursor, int64(0))
+}
@@ -0,0 +1,94 @@
+package ingester
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bluesky-social/jetstream/pkg/models"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+)
+
+type ReplayOpts struct {
+	// Since and Until bound the time of the events which are replayed. A zero
+	// value leaves that end of the range open.
+	Since time.Time
+	Until time.Time
+	// RealTime paces events with the gaps between them when they were
+	// recorded, rather than handling them as fast as possible.
+	RealTime bool
+}
+
+type ReplayStats struct {
+	Handled int
+	// Failed events are written to the dead letter table.
+	Failed int
+}
+
+// Replay handles the events in recording files written by an EventRecorder.
+// Unlike Start, events are handled one at a time in the order they were
+// recorded, so a replay always has the same result.
+func (fi *FirehoseIngester) Replay(
+	ctx context.Context, paths []string, opts ReplayOpts,
+) (stats ReplayStats, err error) {
+	var firstEvent time.Time
+	var replayStart time.Time
+
+	for _, path := range paths {
+		fi.log.Info("replaying recording", slog.String("path", path))
+		err := ReadRecording(path, func(evt *models.Event) error {
+			evtTime := time.UnixMicro(evt.TimeUS)
+			if !opts.Since.IsZero() && evtTime.Before(opts.Since) {
+				return nil
+			}
+			if !opts.Until.IsZero() && evtTime.After(opts.Until) {
+				return nil
+			}
+
+			if opts.RealTime {
+				if firstEvent.IsZero() {
+					firstEvent = evtTime
+					replayStart = fi.clock.Now()
+				}
+				wait := evtTime.Sub(firstEvent) - fi.clock.Since(replayStart)
+				if wait > 0 {
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case <-fi.clock.After(wait):
+					}
+				}
+			}
+
+			if err := fi.replayEvent(ctx, evt); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				fi.log.Error("failed to replay event", bfflog.Err(err), slog.Any("evt", evt))
+				stats.Failed++
+				return nil
+			}
+			stats.Handled++
+			return nil
+		})
+		if err != nil {
+			return stats, fmt.Errorf("replaying %s: %w", path, err)
+		}
+	}
+	return stats, nil
+}
+
+func (fi *FirehoseIngester) replayEvent(ctx context.Context, evt *models.Event) error {
+	handleCtx, cancel := context.WithTimeout(ctx, fi.workItemTimeout)
+	defer cancel()
+	handleErr := fi.handleEvent(handleCtx, evt)
+	if handleErr == nil {
+		return nil
+	}
+	if err := fi.deadLetter(ctx, evt, handleErr); err != nil {
+		return fmt.Errorf("%w (dead lettering: %w)", handleErr, err)
+	}
+	return handleErr
+}
@@ -0,0 +1,113 @@
+package ingester
+
+import (
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+)
+
+var schedulerQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bff_ingester_scheduler_queued_events",
+	Help: "The number of events waiting to be handled, by scheduler.",
+}, []string{"scheduler"})
+
+// repoScheduler handles events on a fixed number of workers. Events for the
+// same repo are always handled by the same worker, one at a time, in the order
+// they were added. Events for different repos are handled concurrently.
+//
+// This replaces the parallel schedulers from jetstream and indigo, which we
+// found had two problems. Queued events were handled with the context of the
+// connection that added them, so were dropped when that connection was
+// abandoned. And if AddWork was cancelled whilst waiting for a worker, the repo
+// was never released, so later events for it were queued forever.
+type repoScheduler[T any] struct {
+	log    *slog.Logger
+	handle func(ctx context.Context, evt T) error
+	name   string
+
+	// ctx is passed to handle, and outlives the contexts passed to AddWork.
+	ctx    context.Context
+	cancel context.CancelFunc
+	queues []chan T
+	wg     sync.WaitGroup
+}
+
+// newRepoScheduler starts workerCount workers, each with a queue of queueSize
+// events. The workers run until Shutdown is called or ctx is cancelled.
+func newRepoScheduler[T any](
+	ctx context.Context,
+	log *slog.Logger,
+	name string,
+	workerCount int,
+	queueSize int,
+	handle func(ctx context.Context, evt T) error,
+) *repoScheduler[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &repoScheduler[T]{
+		log:    log,
+		handle: handle,
+		name:   name,
+		ctx:    ctx,
+		cancel: cancel,
+		queues: make([]chan T, workerCount),
+	}
+	for i := range s.queues {
+		s.queues[i] = make(chan T, queueSize)
+		s.wg.Add(1)
+		go s.work(s.queues[i])
+	}
+	return s
+}
+
+func (s *repoScheduler[T]) work(queue chan T) {
+	defer s.wg.Done()
+	queued := schedulerQueueDepth.WithLabelValues(s.name)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case evt := <-queue:
+			queued.Dec()
+			if err := s.handle(s.ctx, evt); err != nil {
+				s.log.Error("event handler failed", bfflog.Err(err))
+			}
+		}
+	}
+}
+
+func (s *repoScheduler[T]) queueFor(repo string) chan T {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(repo))
+	return s.queues[h.Sum32()%uint32(len(s.queues))]
+}
+
+// AddWork queues an event to be handled after any already queued for the same
+// repo. It blocks whilst the repo's worker has a full queue. If ctx is
+// cancelled before the event is queued, it is not handled.
+func (s *repoScheduler[T]) AddWork(ctx context.Context, repo string, evt T) error {
+	queued := schedulerQueueDepth.WithLabelValues(s.name)
+	queued.Inc()
+	select {
+	case s.queueFor(repo) <- evt:
+		return nil
+	case <-ctx.Done():
+		queued.Dec()
+		return ctx.Err()
+	case <-s.ctx.Done():
+		queued.Dec()
+		return s.ctx.Err()
+	}
+}
+
+// Shutdown cancels the events being handled and stops the workers. Queued
+// events are discarded.
+func (s *repoScheduler[T]) Shutdown() {
+	s.cancel()
+	s.wg.Wait()
+}
@@ -0,0 +1,226 @@
+package ingester
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/jetstream/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bffv1pb "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+type testRecordOp struct {
+	repo   string
+	rkey   string
+	uri    string
+	delete bool
+}
+
+// interleavedRecordOps returns, for each repo, a create and delete of several
+// records. Ops for different repos are interleaved, but the ops for each repo
+// stay in order.
+func interleavedRecordOps(repos []string, recordsPerRepo int) []testRecordOp {
+	perRepo := map[string][]testRecordOp{}
+	for _, repo := range repos {
+		for i := 0; i < recordsPerRepo; i++ {
+			rkey := fmt.Sprintf("post%d", i)
+			uri := fmt.Sprintf("at://%s/app.bsky.feed.post/%s", repo, rkey)
+			perRepo[repo] = append(perRepo[repo],
+				testRecordOp{repo: repo, rkey: rkey, uri: uri},
+				testRecordOp{repo: repo, rkey: rkey, uri: uri, delete: true},
+			)
+			// Leave every third record behind so we can tell that creates
+			// are applied at all.
+			if i%3 == 0 {
+				perRepo[repo] = perRepo[repo][:len(perRepo[repo])-1]
+			}
+		}
+	}
+
+	var out []testRecordOp
+	for len(perRepo) > 0 {
+		for _, repo := range repos {
+			ops := perRepo[repo]
+			if len(ops) == 0 {
+				delete(perRepo, repo)
+				continue
+			}
+			out = append(out, ops[0])
+			perRepo[repo] = ops[1:]
+		}
+	}
+	return out
+}
+
+func Test_repoScheduler_ordering(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repos := []string{"did:plc:a", "did:plc:b", "did:plc:c", "did:plc:d", "did:plc:e"}
+	ops := interleavedRecordOps(repos, 30)
+
+	// want is the state after applying each op in turn.
+	want := map[string]bool{}
+	for _, op := range ops {
+		want[op.uri] = !op.delete
+	}
+
+	var mu sync.Mutex
+	got := map[string]bool{}
+	sched := newRepoScheduler(
+		ctx, slog.Default(), "test", 4, 10,
+		func(_ context.Context, op testRecordOp) error {
+			// Creates are slower than deletes, as they are in the ingester,
+			// so any reordering would let a delete overtake its create.
+			if !op.delete {
+				time.Sleep(time.Duration(rand.Intn(500)) * time.Microsecond)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if op.delete {
+				// Mirrors the soft delete, so a late create is ignored.
+				got[op.uri] = false
+			} else if _, ok := got[op.uri]; !ok {
+				got[op.uri] = true
+			}
+			return nil
+		},
+	)
+	t.Cleanup(sched.Shutdown)
+
+	for _, op := range ops {
+		require.NoError(t, sched.AddWork(ctx, op.repo, op))
+	}
+
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, want, got)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func Test_repoScheduler_cancelledAddWork(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	unblock := make(chan struct{})
+	handled := make(chan string, 10)
+	sched := newRepoScheduler(
+		ctx, slog.Default(), "test", 1, 0,
+		func(_ context.Context, evt string) error {
+			if evt == "blocking" {
+				<-unblock
+			}
+			handled <- evt
+			return nil
+		},
+	)
+	t.Cleanup(sched.Shutdown)
+
+	require.NoError(t, sched.AddWork(ctx, "did:plc:a", "blocking"))
+
+	// The worker is busy, so this cannot be queued before it is cancelled.
+	cancelledCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err := sched.AddWork(cancelledCtx, "did:plc:a", "cancelled")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(unblock)
+	// Later events for the repo must still be handled.
+	require.NoError(t, sched.AddWork(ctx, "did:plc:a", "later"))
+
+	var got []string
+	for len(got) < 2 {
+		select {
+		case evt := <-handled:
+			got = append(got, evt)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "events not handled", "got %v", got)
+		}
+	}
+	assert.Equal(t, []string{"blocking", "later"}, got)
+}
+
+// TestFirehoseIngester_scheduledDeletes replays interleaved creates and
+// deletes of posts through the scheduler used by the ingester, and checks
+// that no deleted posts are left behind in the store.
+func TestFirehoseIngester_scheduledDeletes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	repos := []string{"did:plc:one", "did:plc:two", "did:plc:three"}
+	for _, repo := range repos {
+		_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+			Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+			DID:    repo,
+		})
+		require.NoError(t, err)
+	}
+	cac := NewActorCache(slog.Default(), harness.Store)
+	require.NoError(t, cac.Sync(ctx))
+	fi := NewFirehoseIngester(slog.Default(), harness.Store, cac)
+
+	record, err := json.Marshal(&bsky.FeedPost{
+		LexiconTypeID: "app.bsky.feed.post",
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339Nano),
+		Text:          "paws",
+	})
+	require.NoError(t, err)
+
+	ops := interleavedRecordOps(repos, 10)
+	var wg sync.WaitGroup
+	wg.Add(len(ops))
+	sched := newRepoScheduler(
+		ctx, slog.Default(), "test", fi.workerCount, 100,
+		func(ctx context.Context, evt *models.Event) error {
+			defer wg.Done()
+			return fi.handleCommit(ctx, evt)
+		},
+	)
+	t.Cleanup(sched.Shutdown)
+
+	want := map[string]bool{}
+	for _, op := range ops {
+		want[op.uri] = !op.delete
+		evt := &models.Event{
+			Did:    op.repo,
+			TimeUS: time.Now().UnixMicro(),
+			Kind:   models.EventKindCommit,
+			Commit: &models.Commit{
+				Operation:  models.CommitOperationCreate,
+				Collection: "app.bsky.feed.post",
+				RKey:       op.rkey,
+				Record:     record,
+			},
+		}
+		if op.delete {
+			evt.Commit.Operation = models.CommitOperationDelete
+			evt.Commit.Record = nil
+		}
+		require.NoError(t, sched.AddWork(ctx, op.repo, evt))
+	}
+	wg.Wait()
+
+	for uri, live := range want {
+		post, err := harness.Store.GetPostByURI(ctx, uri)
+		require.NoError(t, err)
+		assert.Equal(t, !live, post.DeletedAt.Valid, uri)
+	}
+}
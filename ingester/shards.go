@@ -0,0 +1,263 @@
+package ingester
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/bluesky-social/jetstream/pkg/models"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+var ownedShards = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "bff_ingester_owned_shards",
+	Help: "The number of shards of repo DIDs this replica is ingesting.",
+})
+
+var shardRebalances = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "bff_ingester_shard_rebalances_total",
+	Help: "The total number of times the shards ingested by this replica have changed.",
+})
+
+// ShardOpts splits ingestion across several replicas. Each replica still
+// reads the whole of Jetstream, but only handles events from the repo DIDs in
+// the shards it holds a lease on.
+type ShardOpts struct {
+	// Count is the number of shards repo DIDs are split into. It must be the
+	// same for every replica.
+	Count int
+	// ReplicaID uniquely identifies this replica whilst it's running.
+	ReplicaID string
+}
+
+// Shard makes the ingester only handle the events of its share of the shards,
+// coordinating with the other replicas through leases in the database.
+func (fi *FirehoseIngester) Shard(opts ShardOpts) {
+	fi.shardCount = opts.Count
+	fi.replicaID = opts.ReplicaID
+}
+
+// shardForDID returns the shard a repo belongs to. This deliberately uses a
+// different hash to repoScheduler, so that a shard's repos are still spread
+// over all of the workers.
+func shardForDID(did string, count int) int {
+	if count <= 1 {
+		return 0
+	}
+	return int(crc32.ChecksumIEEE([]byte(did)) % uint32(count))
+}
+
+// fairShare is the most shards a replica should hold, so that they're spread
+// evenly between the replicas running.
+func fairShare(count int, replicas int64) int {
+	if replicas < 1 {
+		replicas = 1
+	}
+	return int((int64(count) + replicas - 1) / replicas)
+}
+
+// shardSet is the shards an ingestion session handles, and how to persist
+// their cursors. When ingestion isn't sharded, there's a single shard.
+type shardSet struct {
+	count int
+	// cursors holds the persisted cursor of each shard, or -1 if there isn't
+	// one.
+	cursors map[int]int64
+	save    func(ctx context.Context, shard int, cursor int64) error
+}
+
+// shardFilter passes on only the events from repos in the shards being
+// ingested.
+type shardFilter struct {
+	next   *repoScheduler[workItem]
+	count  int
+	shards map[int]bool
+}
+
+// owns returns whether a repo is in the shards being ingested.
+func (sf *shardFilter) owns(repo string) bool {
+	return sf.shards[shardForDID(repo, sf.count)]
+}
+
+func (sf *shardFilter) AddWork(ctx context.Context, repo string, evt *models.Event) error {
+	if !sf.owns(repo) {
+		return nil
+	}
+	return sf.next.AddWork(ctx, repo, workItem{evt: evt})
+}
+
+func (sf *shardFilter) Shutdown() {
+	sf.next.Shutdown()
+}
+
+// ingestShards ingests this replica's share of the shards until ctx is
+// cancelled. Every renewal interval, it renews its leases, takes any shards
+// nobody holds, and gives up any more than its share, e.g. because another
+// replica has started. When the shards it holds change, ingestion restarts
+// from the oldest of their cursors.
+func (fi *FirehoseIngester) ingestShards(ctx context.Context) error {
+	count := int32(fi.shardCount)
+	log := fi.log.With(slog.String("replica_id", fi.replicaID))
+	if err := fi.store.CreateIngesterShards(ctx, count); err != nil {
+		return fmt.Errorf("creating shards: %w", err)
+	}
+
+	var (
+		owned         []int32
+		lastRenewedAt time.Time
+		stopSession   = func() {}
+		sessionDone   <-chan error
+	)
+	restart := func(next []int32) {
+		stopSession()
+		stopSession, sessionDone = func() {}, nil
+		for _, shard := range owned {
+			if slices.Contains(next, shard) {
+				continue
+			}
+			if err := fi.store.ReleaseIngesterShard(ctx, count, shard, fi.replicaID); err != nil {
+				log.Warn("failed to release shard", slog.Int("shard", int(shard)), bfflog.Err(err))
+			}
+		}
+		owned = next
+		ownedShards.Set(float64(len(owned)))
+		shardRebalances.Inc()
+		log.Info("ingesting shards", slog.Any("shards", owned))
+		if len(owned) == 0 {
+			return
+		}
+
+		sessionCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error, 1)
+		go func() {
+			done <- fi.ingestOwnedShards(sessionCtx, owned)
+		}()
+		stopSession = func() {
+			cancel()
+			<-done
+		}
+		sessionDone = done
+	}
+	defer func() {
+		// Give up our shards so other replicas can take over without
+		// waiting for the leases to expire.
+		stopSession()
+		exitCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*10)
+		defer cancel()
+		for _, shard := range owned {
+			if err := fi.store.ReleaseIngesterShard(exitCtx, count, shard, fi.replicaID); err != nil {
+				log.Warn("failed to release shard", slog.Int("shard", int(shard)), bfflog.Err(err))
+			}
+		}
+		if err := fi.store.DeleteIngesterReplica(exitCtx, fi.replicaID); err != nil {
+			log.Warn("failed to delete replica", bfflog.Err(err))
+		}
+		ownedShards.Set(0)
+	}()
+
+	ticker := fi.clock.NewTicker(fi.shardRenewInterval)
+	defer ticker.Stop()
+	for {
+		next, err := fi.balanceShards(ctx, count)
+		switch {
+		case err == nil:
+			lastRenewedAt = fi.clock.Now()
+		case ctx.Err() != nil:
+			return nil
+		default:
+			log.Error("failed to renew shard leases", bfflog.Err(err))
+			next = owned
+			// Stop before our leases expire, as another replica may then
+			// take over the shards.
+			if fi.clock.Since(lastRenewedAt) >= fi.shardLeaseTTL-fi.shardRenewInterval {
+				next = nil
+			}
+		}
+		if !slices.Equal(next, owned) {
+			restart(next)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sessionDone:
+			return fmt.Errorf("ingesting shards: %w", err)
+		case <-ticker.Chan():
+		}
+	}
+}
+
+// balanceShards renews the leases held by this replica, and acquires or
+// gives up shards so that it holds its share. It returns the shards it should
+// ingest, in order.
+func (fi *FirehoseIngester) balanceShards(ctx context.Context, count int32) ([]int32, error) {
+	replicas, err := fi.store.HeartbeatIngesterReplica(ctx, fi.replicaID, fi.shardLeaseTTL)
+	if err != nil {
+		return nil, fmt.Errorf("heartbeating: %w", err)
+	}
+	held, err := fi.store.RenewIngesterShardLeases(ctx, count, fi.replicaID, fi.shardLeaseTTL)
+	if err != nil {
+		return nil, fmt.Errorf("renewing leases: %w", err)
+	}
+	share := fairShare(int(count), replicas)
+	slices.Sort(held)
+	if len(held) > share {
+		// The extra shards are released once the session ingesting them
+		// has flushed their cursors.
+		return held[:share], nil
+	}
+	if len(held) < share {
+		acquired, err := fi.store.AcquireIngesterShards(
+			ctx, count, fi.replicaID, int32(share-len(held)), fi.shardLeaseTTL,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("acquiring shards: %w", err)
+		}
+		for _, s := range acquired {
+			held = append(held, s.Shard)
+		}
+		slices.Sort(held)
+	}
+	return held, nil
+}
+
+// ingestOwnedShards ingests the given shards, resuming from their persisted
+// cursors, until ctx is cancelled.
+func (fi *FirehoseIngester) ingestOwnedShards(ctx context.Context, owned []int32) error {
+	count := int32(fi.shardCount)
+	shards, err := fi.store.ListIngesterShards(ctx, count)
+	if err != nil {
+		return fmt.Errorf("listing shards: %w", err)
+	}
+	cursors := map[int]int64{}
+	for _, s := range shards {
+		if !slices.Contains(owned, s.Shard) {
+			continue
+		}
+		cursors[int(s.Shard)] = -1
+		if s.Cursor.Valid {
+			cursors[int(s.Shard)] = s.Cursor.Int64
+		}
+	}
+
+	return fi.ingest(ctx, shardSet{
+		count:   fi.shardCount,
+		cursors: cursors,
+		save: func(ctx context.Context, shard int, cursor int64) error {
+			err := fi.store.SetIngesterShardCursor(ctx, count, int32(shard), fi.replicaID, cursor)
+			if errors.Is(err, store.ErrNotFound) {
+				return fmt.Errorf("lease on shard %d lost", shard)
+			}
+			return err
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package ingester
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+func Test_shardForDID(t *testing.T) {
+	t.Parallel()
+
+	const count = 4
+	perShard := map[int]int{}
+	workers := map[uint32]bool{}
+	for i := range 1000 {
+		did := fmt.Sprintf("did:plc:%d", i)
+		shard := shardForDID(did, count)
+		require.Equal(t, shard, shardForDID(did, count))
+		require.Equal(t, 0, shardForDID(did, 1))
+		perShard[shard]++
+
+		if shard == 0 {
+			h := fnv.New32a()
+			_, _ = h.Write([]byte(did))
+			workers[h.Sum32()%20] = true
+		}
+	}
+
+	for shard := range count {
+		assert.Greater(t, perShard[shard], 150, "shard %d", shard)
+	}
+	// The repos in a shard should still be spread over every scheduler
+	// worker.
+	assert.Len(t, workers, 20)
+}
+
+func Test_fairShare(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 8, fairShare(8, 0))
+	assert.Equal(t, 8, fairShare(8, 1))
+	assert.Equal(t, 4, fairShare(8, 2))
+	assert.Equal(t, 3, fairShare(8, 3))
+	assert.Equal(t, 1, fairShare(8, 10))
+}
+
+func TestFirehoseIngester_balanceShards(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	const count = 4
+	newReplica := func(id string) *FirehoseIngester {
+		fi := NewFirehoseIngester(slog.Default(), harness.Store, nil)
+		fi.Shard(ShardOpts{Count: count, ReplicaID: id})
+		fi.shardLeaseTTL = 2 * time.Second
+		return fi
+	}
+	a, b := newReplica("a"), newReplica("b")
+	require.NoError(t, harness.Store.SetJetstreamCursor(ctx, 1234))
+	require.NoError(t, harness.Store.CreateIngesterShards(ctx, count))
+
+	// Alone, a replica takes every shard, which resume from the unsharded
+	// cursor.
+	shards, err := a.balanceShards(ctx, count)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{0, 1, 2, 3}, shards)
+	listed, err := harness.Store.ListIngesterShards(ctx, count)
+	require.NoError(t, err)
+	require.Len(t, listed, count)
+	assert.Equal(t, int64(1234), listed[0].Cursor.Int64)
+
+	// When another starts, it has to wait for the first to give up half.
+	shards, err = b.balanceShards(ctx, count)
+	require.NoError(t, err)
+	assert.Empty(t, shards)
+	shards, err = a.balanceShards(ctx, count)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{0, 1}, shards)
+	for _, shard := range []int32{2, 3} {
+		require.NoError(t, harness.Store.ReleaseIngesterShard(ctx, count, shard, "a"))
+	}
+	shards, err = b.balanceShards(ctx, count)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{2, 3}, shards)
+	require.NoError(t, harness.Store.SetIngesterShardCursor(ctx, count, 2, "b", 5678))
+
+	// When a replica dies, the other takes over once its leases expire.
+	time.Sleep(3 * time.Second)
+	shards, err = b.balanceShards(ctx, count)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{0, 1, 2, 3}, shards)
+	err = harness.Store.SetIngesterShardCursor(ctx, count, 0, "a", 9999)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	listed, err = harness.Store.ListIngesterShards(ctx, count)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234), listed[0].Cursor.Int64)
+	assert.Equal(t, int64(5678), listed[2].Cursor.Int64)
+}
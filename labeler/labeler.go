@@ -0,0 +1,403 @@
+package labeler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/atproto/crypto"
+	"github.com/bluesky-social/indigo/util/labels"
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/bluesky"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+)
+
+const (
+	// ApprovedLabel is applied to the accounts of approved actors.
+	ApprovedLabel = "furryli-st-approved"
+	// BannedLabel is applied to the accounts of banned actors.
+	BannedLabel = "furryli-st-banned"
+	// HiddenLabel is applied to posts hidden by our moderators.
+	HiddenLabel = "furryli-st-hidden"
+)
+
+// labelVersion is the version of the atproto label object we emit.
+const labelVersion int64 = 1
+
+// auditEventLookback is how far behind the last processed audit event we
+// start reading from. Audit events are timestamped before the transaction
+// that creates them commits, so they can appear slightly out of order.
+const auditEventLookback = 1 * time.Minute
+
+const auditEventBatchSize = 100
+
+// actorStatusLabels maps the actor statuses we publish to their label.
+var actorStatusLabels = map[v1.ActorStatus]string{
+	v1.ActorStatus_ACTOR_STATUS_APPROVED: ApprovedLabel,
+	v1.ActorStatus_ACTOR_STATUS_BANNED:   BannedLabel,
+}
+
+// Labeler derives labels from the moderation state held in the store and
+// persists signed labels (and negations of labels that no longer apply) so
+// that they can be served via com.atproto.label.subscribeLabels and
+// com.atproto.label.queryLabels.
+//
+// Only a single Labeler should be running at any one time, otherwise labels
+// may be emitted twice. Setting Opts.ReplicaID ensures this with a leader
+// lease.
+type Labeler struct {
+	log   *slog.Logger
+	store *store.PGXStore
+	did   string
+	key   crypto.PrivateKey
+	opts  Opts
+}
+
+type Opts struct {
+	// SyncInterval is how often the audit log is checked for moderation
+	// decisions, which are then reflected in the labels of their subjects.
+	SyncInterval time.Duration
+	// ReconcileInterval is how often every label is compared against the
+	// moderation state. This catches changes which aren't audited, such as
+	// hidden posts. Defaults to an hour.
+	ReconcileInterval time.Duration
+	// ReplicaID identifies this replica in leader election. If set, labels
+	// are only synced whilst this replica is the leader, so the labeler can
+	// be enabled on several replicas.
+	ReplicaID string
+}
+
+func New(
+	log *slog.Logger,
+	store *store.PGXStore,
+	did string,
+	key crypto.PrivateKey,
+	opts Opts,
+) *Labeler {
+	return &Labeler{
+		log:   log,
+		store: store,
+		did:   did,
+		key:   key,
+		opts:  opts,
+	}
+}
+
+// ConfigFromEnv loads the labeler DID and signing key from the environment.
+// The signing key should be a multibase encoded K-256 private key, whose
+// public key is published as the `#atproto_label` verification method in
+// the labeler's DID document.
+func ConfigFromEnv() (string, crypto.PrivateKey, error) {
+	did := os.Getenv("BFF_LABELER_DID")
+	if did == "" {
+		return "", nil, fmt.Errorf("BFF_LABELER_DID not set")
+	}
+	encodedKey := os.Getenv("BFF_LABELER_SIGNING_KEY")
+	if encodedKey == "" {
+		return "", nil, fmt.Errorf("BFF_LABELER_SIGNING_KEY not set")
+	}
+	key, err := crypto.ParsePrivateMultibase(encodedKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("parsing signing key: %w", err)
+	}
+	return did, key, nil
+}
+
+// ToLexicon converts a stored label to its lexicon representation. The
+// fields must be derived identically when signing and when serving, or the
+// signature will not verify.
+func ToLexicon(l gen.Label) *atproto.LabelDefs_Label {
+	unsigned := unsignedLabel(l.Src, l.URI, l.Val, l.Neg, l.Cts.Time)
+	return &atproto.LabelDefs_Label{
+		Cts: unsigned.Cts,
+		Neg: unsigned.Neg,
+		Sig: l.Sig,
+		Src: unsigned.Src,
+		Uri: unsigned.Uri,
+		Val: unsigned.Val,
+		Ver: unsigned.Ver,
+	}
+}
+
+func unsignedLabel(src, uri, val string, neg bool, cts time.Time) labels.UnsignedLabel {
+	ver := labelVersion
+	ul := labels.UnsignedLabel{
+		Cts: bluesky.FormatTime(cts),
+		Src: src,
+		Uri: uri,
+		Val: val,
+		Ver: &ver,
+	}
+	if neg {
+		ul.Neg = &neg
+	}
+	return ul
+}
+
+type labelKey struct {
+	uri string
+	val string
+}
+
+// diffLabels returns the labels which need to be emitted, and the labels
+// which need to be negated, to move from the active set of labels to the
+// desired set.
+func diffLabels(desired, active map[labelKey]struct{}) (toEmit, toNegate []labelKey) {
+	for k := range desired {
+		if _, ok := active[k]; !ok {
+			toEmit = append(toEmit, k)
+		}
+	}
+	for k := range active {
+		if _, ok := desired[k]; !ok {
+			toNegate = append(toNegate, k)
+		}
+	}
+	return toEmit, toNegate
+}
+
+func (l *Labeler) desiredLabels(ctx context.Context) (map[labelKey]struct{}, error) {
+	desired := map[labelKey]struct{}{}
+
+	for status, val := range actorStatusLabels {
+		actors, err := l.store.ListActors(ctx, store.ListActorsOpts{
+			FilterStatus: status,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing actors with status %s: %w", status, err)
+		}
+		for _, a := range actors {
+			desired[labelKey{uri: a.Did, val: val}] = struct{}{}
+		}
+	}
+
+	hiddenPosts, err := l.store.ListHiddenPostURIs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing hidden posts: %w", err)
+	}
+	for _, uri := range hiddenPosts {
+		desired[labelKey{uri: uri, val: HiddenLabel}] = struct{}{}
+	}
+
+	return desired, nil
+}
+
+func (l *Labeler) emit(ctx context.Context, k labelKey, neg bool) error {
+	// Postgres stores timestamps with microsecond precision, so we truncate
+	// here to ensure that the signed timestamp survives the round-trip.
+	cts := time.Now().UTC().Truncate(time.Millisecond)
+	ul := unsignedLabel(l.did, k.uri, k.val, neg, cts)
+	sig, err := sign(l.key, ul)
+	if err != nil {
+		return fmt.Errorf("signing label: %w", err)
+	}
+
+	_, err = l.store.CreateLabel(ctx, store.CreateLabelOpts{
+		Src: l.did,
+		URI: k.uri,
+		Val: k.val,
+		Neg: neg,
+		Cts: cts,
+		Sig: sig,
+	})
+	if err != nil {
+		return fmt.Errorf("persisting label: %w", err)
+	}
+	return nil
+}
+
+func sign(key crypto.PrivateKey, ul labels.UnsignedLabel) ([]byte, error) {
+	b, err := ul.BytesForSigning()
+	if err != nil {
+		return nil, fmt.Errorf("encoding label: %w", err)
+	}
+	return key.HashAndSign(b)
+}
+
+// reconcile compares every label we have emitted against the moderation
+// state, and emits or negates labels to bring them in line.
+func (l *Labeler) reconcile(ctx context.Context) error {
+	desired, err := l.desiredLabels(ctx)
+	if err != nil {
+		return fmt.Errorf("determining desired labels: %w", err)
+	}
+
+	activeRows, err := l.store.ListActiveLabels(ctx, store.ListActiveLabelsOpts{
+		Src: l.did,
+	})
+	if err != nil {
+		return fmt.Errorf("listing active labels: %w", err)
+	}
+
+	emitted, negated, err := l.apply(ctx, desired, activeRows)
+	if err != nil {
+		return err
+	}
+	l.log.Info(
+		"reconciled labels",
+		slog.Int("emitted", emitted),
+		slog.Int("negated", negated),
+	)
+	return nil
+}
+
+type auditPosition struct {
+	createdAt time.Time
+	id        string
+}
+
+// syncAuditEvents relabels the subjects of audit events created after pos,
+// and returns the position of the last audit event processed.
+func (l *Labeler) syncAuditEvents(ctx context.Context, pos auditPosition) (auditPosition, error) {
+	// Re-read recent events in case any committed after we last read.
+	after := auditPosition{createdAt: pos.createdAt.Add(-auditEventLookback)}
+	subjects := map[string]struct{}{}
+	for {
+		events, err := l.store.ListAuditEventsCreatedAfter(ctx, store.ListAuditEventsCreatedAfterOpts{
+			AfterCreatedAt: after.createdAt,
+			AfterID:        after.id,
+			Limit:          auditEventBatchSize,
+		})
+		if err != nil {
+			return pos, fmt.Errorf("listing audit events: %w", err)
+		}
+		for _, ae := range events {
+			if ae.SubjectDid != "" {
+				subjects[ae.SubjectDid] = struct{}{}
+			}
+			after = auditPosition{createdAt: ae.CreatedAt.AsTime(), id: ae.Id}
+		}
+		if len(events) < auditEventBatchSize {
+			break
+		}
+	}
+	if after.createdAt.After(pos.createdAt) {
+		pos = after
+	}
+	if len(subjects) == 0 {
+		return pos, nil
+	}
+
+	emitted, negated, err := l.relabelActors(ctx, slices.Collect(maps.Keys(subjects)))
+	if err != nil {
+		return pos, err
+	}
+	if emitted > 0 || negated > 0 {
+		l.log.Info(
+			"synced labels from audit events",
+			slog.Int("subjects", len(subjects)),
+			slog.Int("emitted", emitted),
+			slog.Int("negated", negated),
+		)
+	}
+	return pos, nil
+}
+
+// relabelActors brings the account labels of the given actors in line with
+// their current status.
+func (l *Labeler) relabelActors(ctx context.Context, dids []string) (emitted, negated int, err error) {
+	desired := map[labelKey]struct{}{}
+	for _, did := range dids {
+		actor, err := l.store.GetActorByDID(ctx, did)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				continue
+			}
+			return 0, 0, fmt.Errorf("getting actor %q: %w", did, err)
+		}
+		if val, ok := actorStatusLabels[actor.Status]; ok {
+			desired[labelKey{uri: did, val: val}] = struct{}{}
+		}
+	}
+
+	activeRows, err := l.store.ListActiveLabels(ctx, store.ListActiveLabelsOpts{
+		Src:        l.did,
+		FilterURIs: dids,
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("listing active labels: %w", err)
+	}
+
+	return l.apply(ctx, desired, activeRows)
+}
+
+// apply emits and negates labels to move from the active labels to the
+// desired labels.
+func (l *Labeler) apply(
+	ctx context.Context, desired map[labelKey]struct{}, activeRows []gen.ListActiveLabelsRow,
+) (emitted, negated int, err error) {
+	active := map[labelKey]struct{}{}
+	for _, row := range activeRows {
+		active[labelKey{uri: row.URI, val: row.Val}] = struct{}{}
+	}
+
+	toEmit, toNegate := diffLabels(desired, active)
+	for _, k := range toEmit {
+		if err := l.emit(ctx, k, false); err != nil {
+			return emitted, negated, fmt.Errorf("emitting %q label for %q: %w", k.val, k.uri, err)
+		}
+		emitted++
+	}
+	for _, k := range toNegate {
+		if err := l.emit(ctx, k, true); err != nil {
+			return emitted, negated, fmt.Errorf("negating %q label for %q: %w", k.val, k.uri, err)
+		}
+		negated++
+	}
+	return emitted, negated, nil
+}
+
+func (l *Labeler) Run(ctx context.Context) error {
+	if l.opts.ReplicaID == "" {
+		return l.run(ctx)
+	}
+	elector := store.NewLeaderElector(
+		l.log, l.store, "labeler", store.LeaderElectorOpts{Holder: l.opts.ReplicaID},
+	)
+	return elector.Run(ctx, l.run)
+}
+
+func (l *Labeler) run(ctx context.Context) error {
+	reconcileInterval := l.opts.ReconcileInterval
+	if reconcileInterval == 0 {
+		reconcileInterval = 1 * time.Hour
+	}
+
+	// We may have missed audit events whilst not running, so we reconcile
+	// first and then follow the audit log from this point.
+	pos := auditPosition{createdAt: time.Now()}
+	var lastReconciled time.Time
+
+	t := time.NewTicker(l.opts.SyncInterval)
+	defer t.Stop()
+	for {
+		if time.Since(lastReconciled) >= reconcileInterval {
+			start := time.Now()
+			if err := l.reconcile(ctx); err != nil {
+				l.log.Error("failed to reconcile labels", bfflog.Err(err))
+			} else {
+				lastReconciled = start
+			}
+		}
+
+		var err error
+		pos, err = l.syncAuditEvents(ctx, pos)
+		if err != nil {
+			l.log.Error("failed to sync labels from audit events", bfflog.Err(err))
+		}
+
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
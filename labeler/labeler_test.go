@@ -0,0 +1,70 @@
+package labeler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bluesky-social/indigo/atproto/crypto"
+	"github.com/bluesky-social/indigo/util/labels"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+)
+
+func TestDiffLabels(t *testing.T) {
+	approved := labelKey{uri: "did:plc:approved", val: ApprovedLabel}
+	banned := labelKey{uri: "did:plc:banned", val: BannedLabel}
+	unbanned := labelKey{uri: "did:plc:unbanned", val: BannedLabel}
+	hidden := labelKey{uri: "at://did:plc:approved/app.bsky.feed.post/123", val: HiddenLabel}
+
+	desired := map[labelKey]struct{}{
+		approved: {},
+		banned:   {},
+		hidden:   {},
+	}
+	active := map[labelKey]struct{}{
+		approved: {},
+		unbanned: {},
+	}
+
+	toEmit, toNegate := diffLabels(desired, active)
+	assert.ElementsMatch(t, []labelKey{banned, hidden}, toEmit)
+	assert.ElementsMatch(t, []labelKey{unbanned}, toNegate)
+}
+
+func TestSignedLabelVerifies(t *testing.T) {
+	key, err := crypto.GeneratePrivateKeyK256()
+	require.NoError(t, err)
+	pub, err := key.PublicKey()
+	require.NoError(t, err)
+
+	for _, neg := range []bool{false, true} {
+		cts := time.Now().UTC().Truncate(time.Millisecond)
+		sig, err := sign(key, unsignedLabel("did:plc:labeler", "did:plc:subject", BannedLabel, neg, cts))
+		require.NoError(t, err)
+
+		// Simulate the round-trip through the store.
+		lex := ToLexicon(gen.Label{
+			Src: "did:plc:labeler",
+			URI: "did:plc:subject",
+			Val: BannedLabel,
+			Neg: neg,
+			Cts: pgtype.Timestamptz{Time: cts.Local(), Valid: true},
+			Sig: sig,
+		})
+		assert.Equal(t, neg, lex.Neg != nil && *lex.Neg)
+
+		ul := labels.UnsignedLabel{
+			Cts: lex.Cts,
+			Neg: lex.Neg,
+			Src: lex.Src,
+			Uri: lex.Uri,
+			Val: lex.Val,
+			Ver: lex.Ver,
+		}
+		b, err := ul.BytesForSigning()
+		require.NoError(t, err)
+		require.NoError(t, pub.HashAndVerify(b, lex.Sig))
+	}
+}
@@ -59,6 +59,12 @@ const (
 	// ModerationServiceCreateActorProcedure is the fully-qualified name of the ModerationService's
 	// CreateActor RPC.
 	ModerationServiceCreateActorProcedure = "/bff.v1.ModerationService/CreateActor"
+	// ModerationServicePurgeActorProcedure is the fully-qualified name of the ModerationService's
+	// PurgeActor RPC.
+	ModerationServicePurgeActorProcedure = "/bff.v1.ModerationService/PurgeActor"
+	// ModerationServiceRestoreActorProcedure is the fully-qualified name of the ModerationService's
+	// RestoreActor RPC.
+	ModerationServiceRestoreActorProcedure = "/bff.v1.ModerationService/RestoreActor"
 	// ModerationServiceListAuditEventsProcedure is the fully-qualified name of the ModerationService's
 	// ListAuditEvents RPC.
 	ModerationServiceListAuditEventsProcedure = "/bff.v1.ModerationService/ListAuditEvents"
@@ -71,6 +77,45 @@ const (
 	// ModerationServiceAssignRolesProcedure is the fully-qualified name of the ModerationService's
 	// AssignRoles RPC.
 	ModerationServiceAssignRolesProcedure = "/bff.v1.ModerationService/AssignRoles"
+	// ModerationServiceSetActorNSFWOverrideProcedure is the fully-qualified name of the
+	// ModerationService's SetActorNSFWOverride RPC.
+	ModerationServiceSetActorNSFWOverrideProcedure = "/bff.v1.ModerationService/SetActorNSFWOverride"
+	// ModerationServiceGetFollowReconciliationReportProcedure is the fully-qualified name of the
+	// ModerationService's GetFollowReconciliationReport RPC.
+	ModerationServiceGetFollowReconciliationReportProcedure = "/bff.v1.ModerationService/GetFollowReconciliationReport"
+	// ModerationServiceListTasksProcedure is the fully-qualified name of the ModerationService's
+	// ListTasks RPC.
+	ModerationServiceListTasksProcedure = "/bff.v1.ModerationService/ListTasks"
+	// ModerationServiceGetTaskProcedure is the fully-qualified name of the ModerationService's GetTask
+	// RPC.
+	ModerationServiceGetTaskProcedure = "/bff.v1.ModerationService/GetTask"
+	// ModerationServiceRetryTaskProcedure is the fully-qualified name of the ModerationService's
+	// RetryTask RPC.
+	ModerationServiceRetryTaskProcedure = "/bff.v1.ModerationService/RetryTask"
+	// ModerationServiceCancelTaskProcedure is the fully-qualified name of the ModerationService's
+	// CancelTask RPC.
+	ModerationServiceCancelTaskProcedure = "/bff.v1.ModerationService/CancelTask"
+	// ModerationServiceRefreshActorProfileProcedure is the fully-qualified name of the
+	// ModerationService's RefreshActorProfile RPC.
+	ModerationServiceRefreshActorProfileProcedure = "/bff.v1.ModerationService/RefreshActorProfile"
+	// ModerationServiceListDeadLetterEventsProcedure is the fully-qualified name of the
+	// ModerationService's ListDeadLetterEvents RPC.
+	ModerationServiceListDeadLetterEventsProcedure = "/bff.v1.ModerationService/ListDeadLetterEvents"
+	// ModerationServiceGetDeadLetterEventProcedure is the fully-qualified name of the
+	// ModerationService's GetDeadLetterEvent RPC.
+	ModerationServiceGetDeadLetterEventProcedure = "/bff.v1.ModerationService/GetDeadLetterEvent"
+	// ModerationServiceReplayDeadLetterEventProcedure is the fully-qualified name of the
+	// ModerationService's ReplayDeadLetterEvent RPC.
+	ModerationServiceReplayDeadLetterEventProcedure = "/bff.v1.ModerationService/ReplayDeadLetterEvent"
+	// ModerationServiceDiscardDeadLetterEventProcedure is the fully-qualified name of the
+	// ModerationService's DiscardDeadLetterEvent RPC.
+	ModerationServiceDiscardDeadLetterEventProcedure = "/bff.v1.ModerationService/DiscardDeadLetterEvent"
+	// ModerationServiceListScoringFlagsProcedure is the fully-qualified name of the ModerationService's
+	// ListScoringFlags RPC.
+	ModerationServiceListScoringFlagsProcedure = "/bff.v1.ModerationService/ListScoringFlags"
+	// ModerationServiceExplainPostProcedure is the fully-qualified name of the ModerationService's
+	// ExplainPost RPC.
+	ModerationServiceExplainPostProcedure = "/bff.v1.ModerationService/ExplainPost"
 )
 
 // ModerationServiceClient is a client for the bff.v1.ModerationService service.
@@ -98,10 +143,53 @@ type ModerationServiceClient interface {
 	// CreateActor creates a database entry for an actor who does not currently exist.
 	// By default, their status will be set to none.
 	CreateActor(context.Context, *connect.Request[v1.CreateActorRequest]) (*connect.Response[v1.CreateActorResponse], error)
+	// PurgeActor deletes an actor's posts, likes, follows, profiles and handle
+	// history. Their data is hidden immediately, and permanently deleted after a
+	// grace period. Until then, it can be restored with RestoreActor.
+	PurgeActor(context.Context, *connect.Request[v1.PurgeActorRequest]) (*connect.Response[v1.PurgeActorResponse], error)
+	// RestoreActor restores the data of an actor who is pending a purge.
+	RestoreActor(context.Context, *connect.Request[v1.RestoreActorRequest]) (*connect.Response[v1.RestoreActorResponse], error)
 	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
 	CreateCommentAuditEvent(context.Context, *connect.Request[v1.CreateCommentAuditEventRequest]) (*connect.Response[v1.CreateCommentAuditEventResponse], error)
 	ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error)
 	AssignRoles(context.Context, *connect.Request[v1.AssignRolesRequest]) (*connect.Response[v1.AssignRolesResponse], error)
+	// SetActorNSFWOverride overrides whether an actor's posts are NSFW,
+	// regardless of the NSFW rules. Their existing posts are reclassified.
+	SetActorNSFWOverride(context.Context, *connect.Request[v1.SetActorNSFWOverrideRequest]) (*connect.Response[v1.SetActorNSFWOverrideResponse], error)
+	// GetFollowReconciliationReport compares the accounts followed by the feed
+	// account against the approved actors, and reports the corrections that
+	// the background worker would make. No changes are made.
+	GetFollowReconciliationReport(context.Context, *connect.Request[v1.GetFollowReconciliationReportRequest]) (*connect.Response[v1.GetFollowReconciliationReportResponse], error)
+	// ListTasks lists background worker tasks, most recently created first. It
+	// can be filtered by state, type and the actor the task acts upon.
+	ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error)
+	// GetTask fetches a single background worker task.
+	GetTask(context.Context, *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error)
+	// RetryTask resets a failed, cancelled or pending task so that it runs again
+	// immediately with a fresh set of tries. Running and done tasks cannot be
+	// retried.
+	RetryTask(context.Context, *connect.Request[v1.RetryTaskRequest]) (*connect.Response[v1.RetryTaskResponse], error)
+	// CancelTask cancels a pending task. Running tasks cannot be cancelled.
+	CancelTask(context.Context, *connect.Request[v1.CancelTaskRequest]) (*connect.Response[v1.CancelTaskResponse], error)
+	// RefreshActorProfile enqueues a task to refresh the stored profile of an
+	// actor from the network.
+	RefreshActorProfile(context.Context, *connect.Request[v1.RefreshActorProfileRequest]) (*connect.Response[v1.RefreshActorProfileResponse], error)
+	// ListDeadLetterEvents lists Jetstream events that the ingester failed to
+	// handle, most recently failed first.
+	ListDeadLetterEvents(context.Context, *connect.Request[v1.ListDeadLetterEventsRequest]) (*connect.Response[v1.ListDeadLetterEventsResponse], error)
+	// GetDeadLetterEvent fetches a single dead-lettered event.
+	GetDeadLetterEvent(context.Context, *connect.Request[v1.GetDeadLetterEventRequest]) (*connect.Response[v1.GetDeadLetterEventResponse], error)
+	// ReplayDeadLetterEvent makes the ingester retry an event immediately,
+	// including one which has exhausted its attempts.
+	ReplayDeadLetterEvent(context.Context, *connect.Request[v1.ReplayDeadLetterEventRequest]) (*connect.Response[v1.ReplayDeadLetterEventResponse], error)
+	// DiscardDeadLetterEvent deletes an event without handling it.
+	DiscardDeadLetterEvent(context.Context, *connect.Request[v1.DiscardDeadLetterEventRequest]) (*connect.Response[v1.DiscardDeadLetterEventResponse], error)
+	// ListScoringFlags lists the actors and posts whose likes are being
+	// dampened by the anti-gaming safeguards in post scoring.
+	ListScoringFlags(context.Context, *connect.Request[v1.ListScoringFlagsRequest]) (*connect.Response[v1.ListScoringFlagsResponse], error)
+	// ExplainPost reports whether a post passes each of a feed's filters, and
+	// for scored feeds, how it was scored and where it ranks.
+	ExplainPost(context.Context, *connect.Request[v1.ExplainPostRequest]) (*connect.Response[v1.ExplainPostResponse], error)
 }
 
 // NewModerationServiceClient constructs a client for the bff.v1.ModerationService service. By
@@ -159,6 +247,16 @@ func NewModerationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			baseURL+ModerationServiceCreateActorProcedure,
 			opts...,
 		),
+		purgeActor: connect.NewClient[v1.PurgeActorRequest, v1.PurgeActorResponse](
+			httpClient,
+			baseURL+ModerationServicePurgeActorProcedure,
+			opts...,
+		),
+		restoreActor: connect.NewClient[v1.RestoreActorRequest, v1.RestoreActorResponse](
+			httpClient,
+			baseURL+ModerationServiceRestoreActorProcedure,
+			opts...,
+		),
 		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
 			httpClient,
 			baseURL+ModerationServiceListAuditEventsProcedure,
@@ -179,24 +277,104 @@ func NewModerationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			baseURL+ModerationServiceAssignRolesProcedure,
 			opts...,
 		),
+		setActorNSFWOverride: connect.NewClient[v1.SetActorNSFWOverrideRequest, v1.SetActorNSFWOverrideResponse](
+			httpClient,
+			baseURL+ModerationServiceSetActorNSFWOverrideProcedure,
+			opts...,
+		),
+		getFollowReconciliationReport: connect.NewClient[v1.GetFollowReconciliationReportRequest, v1.GetFollowReconciliationReportResponse](
+			httpClient,
+			baseURL+ModerationServiceGetFollowReconciliationReportProcedure,
+			opts...,
+		),
+		listTasks: connect.NewClient[v1.ListTasksRequest, v1.ListTasksResponse](
+			httpClient,
+			baseURL+ModerationServiceListTasksProcedure,
+			opts...,
+		),
+		getTask: connect.NewClient[v1.GetTaskRequest, v1.GetTaskResponse](
+			httpClient,
+			baseURL+ModerationServiceGetTaskProcedure,
+			opts...,
+		),
+		retryTask: connect.NewClient[v1.RetryTaskRequest, v1.RetryTaskResponse](
+			httpClient,
+			baseURL+ModerationServiceRetryTaskProcedure,
+			opts...,
+		),
+		cancelTask: connect.NewClient[v1.CancelTaskRequest, v1.CancelTaskResponse](
+			httpClient,
+			baseURL+ModerationServiceCancelTaskProcedure,
+			opts...,
+		),
+		refreshActorProfile: connect.NewClient[v1.RefreshActorProfileRequest, v1.RefreshActorProfileResponse](
+			httpClient,
+			baseURL+ModerationServiceRefreshActorProfileProcedure,
+			opts...,
+		),
+		listDeadLetterEvents: connect.NewClient[v1.ListDeadLetterEventsRequest, v1.ListDeadLetterEventsResponse](
+			httpClient,
+			baseURL+ModerationServiceListDeadLetterEventsProcedure,
+			opts...,
+		),
+		getDeadLetterEvent: connect.NewClient[v1.GetDeadLetterEventRequest, v1.GetDeadLetterEventResponse](
+			httpClient,
+			baseURL+ModerationServiceGetDeadLetterEventProcedure,
+			opts...,
+		),
+		replayDeadLetterEvent: connect.NewClient[v1.ReplayDeadLetterEventRequest, v1.ReplayDeadLetterEventResponse](
+			httpClient,
+			baseURL+ModerationServiceReplayDeadLetterEventProcedure,
+			opts...,
+		),
+		discardDeadLetterEvent: connect.NewClient[v1.DiscardDeadLetterEventRequest, v1.DiscardDeadLetterEventResponse](
+			httpClient,
+			baseURL+ModerationServiceDiscardDeadLetterEventProcedure,
+			opts...,
+		),
+		listScoringFlags: connect.NewClient[v1.ListScoringFlagsRequest, v1.ListScoringFlagsResponse](
+			httpClient,
+			baseURL+ModerationServiceListScoringFlagsProcedure,
+			opts...,
+		),
+		explainPost: connect.NewClient[v1.ExplainPostRequest, v1.ExplainPostResponse](
+			httpClient,
+			baseURL+ModerationServiceExplainPostProcedure,
+			opts...,
+		),
 	}
 }
 
 // moderationServiceClient implements ModerationServiceClient.
 type moderationServiceClient struct {
-	ping                    *connect.Client[v1.PingRequest, v1.PingResponse]
-	processApprovalQueue    *connect.Client[v1.ProcessApprovalQueueRequest, v1.ProcessApprovalQueueResponse]
-	holdBackPendingActor    *connect.Client[v1.HoldBackPendingActorRequest, v1.HoldBackPendingActorResponse]
-	listActors              *connect.Client[v1.ListActorsRequest, v1.ListActorsResponse]
-	getActor                *connect.Client[v1.GetActorRequest, v1.GetActorResponse]
-	banActor                *connect.Client[v1.BanActorRequest, v1.BanActorResponse]
-	unapproveActor          *connect.Client[v1.UnapproveActorRequest, v1.UnapproveActorResponse]
-	forceApproveActor       *connect.Client[v1.ForceApproveActorRequest, v1.ForceApproveActorResponse]
-	createActor             *connect.Client[v1.CreateActorRequest, v1.CreateActorResponse]
-	listAuditEvents         *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
-	createCommentAuditEvent *connect.Client[v1.CreateCommentAuditEventRequest, v1.CreateCommentAuditEventResponse]
-	listRoles               *connect.Client[v1.ListRolesRequest, v1.ListRolesResponse]
-	assignRoles             *connect.Client[v1.AssignRolesRequest, v1.AssignRolesResponse]
+	ping                          *connect.Client[v1.PingRequest, v1.PingResponse]
+	processApprovalQueue          *connect.Client[v1.ProcessApprovalQueueRequest, v1.ProcessApprovalQueueResponse]
+	holdBackPendingActor          *connect.Client[v1.HoldBackPendingActorRequest, v1.HoldBackPendingActorResponse]
+	listActors                    *connect.Client[v1.ListActorsRequest, v1.ListActorsResponse]
+	getActor                      *connect.Client[v1.GetActorRequest, v1.GetActorResponse]
+	banActor                      *connect.Client[v1.BanActorRequest, v1.BanActorResponse]
+	unapproveActor                *connect.Client[v1.UnapproveActorRequest, v1.UnapproveActorResponse]
+	forceApproveActor             *connect.Client[v1.ForceApproveActorRequest, v1.ForceApproveActorResponse]
+	createActor                   *connect.Client[v1.CreateActorRequest, v1.CreateActorResponse]
+	purgeActor                    *connect.Client[v1.PurgeActorRequest, v1.PurgeActorResponse]
+	restoreActor                  *connect.Client[v1.RestoreActorRequest, v1.RestoreActorResponse]
+	listAuditEvents               *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
+	createCommentAuditEvent       *connect.Client[v1.CreateCommentAuditEventRequest, v1.CreateCommentAuditEventResponse]
+	listRoles                     *connect.Client[v1.ListRolesRequest, v1.ListRolesResponse]
+	assignRoles                   *connect.Client[v1.AssignRolesRequest, v1.AssignRolesResponse]
+	setActorNSFWOverride          *connect.Client[v1.SetActorNSFWOverrideRequest, v1.SetActorNSFWOverrideResponse]
+	getFollowReconciliationReport *connect.Client[v1.GetFollowReconciliationReportRequest, v1.GetFollowReconciliationReportResponse]
+	listTasks                     *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	getTask                       *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	retryTask                     *connect.Client[v1.RetryTaskRequest, v1.RetryTaskResponse]
+	cancelTask                    *connect.Client[v1.CancelTaskRequest, v1.CancelTaskResponse]
+	refreshActorProfile           *connect.Client[v1.RefreshActorProfileRequest, v1.RefreshActorProfileResponse]
+	listDeadLetterEvents          *connect.Client[v1.ListDeadLetterEventsRequest, v1.ListDeadLetterEventsResponse]
+	getDeadLetterEvent            *connect.Client[v1.GetDeadLetterEventRequest, v1.GetDeadLetterEventResponse]
+	replayDeadLetterEvent         *connect.Client[v1.ReplayDeadLetterEventRequest, v1.ReplayDeadLetterEventResponse]
+	discardDeadLetterEvent        *connect.Client[v1.DiscardDeadLetterEventRequest, v1.DiscardDeadLetterEventResponse]
+	listScoringFlags              *connect.Client[v1.ListScoringFlagsRequest, v1.ListScoringFlagsResponse]
+	explainPost                   *connect.Client[v1.ExplainPostRequest, v1.ExplainPostResponse]
 }
 
 // Ping calls bff.v1.ModerationService.Ping.
@@ -244,6 +422,16 @@ func (c *moderationServiceClient) CreateActor(ctx context.Context, req *connect.
 	return c.createActor.CallUnary(ctx, req)
 }
 
+// PurgeActor calls bff.v1.ModerationService.PurgeActor.
+func (c *moderationServiceClient) PurgeActor(ctx context.Context, req *connect.Request[v1.PurgeActorRequest]) (*connect.Response[v1.PurgeActorResponse], error) {
+	return c.purgeActor.CallUnary(ctx, req)
+}
+
+// RestoreActor calls bff.v1.ModerationService.RestoreActor.
+func (c *moderationServiceClient) RestoreActor(ctx context.Context, req *connect.Request[v1.RestoreActorRequest]) (*connect.Response[v1.RestoreActorResponse], error) {
+	return c.restoreActor.CallUnary(ctx, req)
+}
+
 // ListAuditEvents calls bff.v1.ModerationService.ListAuditEvents.
 func (c *moderationServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
 	return c.listAuditEvents.CallUnary(ctx, req)
@@ -264,6 +452,71 @@ func (c *moderationServiceClient) AssignRoles(ctx context.Context, req *connect.
 	return c.assignRoles.CallUnary(ctx, req)
 }
 
+// SetActorNSFWOverride calls bff.v1.ModerationService.SetActorNSFWOverride.
+func (c *moderationServiceClient) SetActorNSFWOverride(ctx context.Context, req *connect.Request[v1.SetActorNSFWOverrideRequest]) (*connect.Response[v1.SetActorNSFWOverrideResponse], error) {
+	return c.setActorNSFWOverride.CallUnary(ctx, req)
+}
+
+// GetFollowReconciliationReport calls bff.v1.ModerationService.GetFollowReconciliationReport.
+func (c *moderationServiceClient) GetFollowReconciliationReport(ctx context.Context, req *connect.Request[v1.GetFollowReconciliationReportRequest]) (*connect.Response[v1.GetFollowReconciliationReportResponse], error) {
+	return c.getFollowReconciliationReport.CallUnary(ctx, req)
+}
+
+// ListTasks calls bff.v1.ModerationService.ListTasks.
+func (c *moderationServiceClient) ListTasks(ctx context.Context, req *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
+	return c.listTasks.CallUnary(ctx, req)
+}
+
+// GetTask calls bff.v1.ModerationService.GetTask.
+func (c *moderationServiceClient) GetTask(ctx context.Context, req *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
+	return c.getTask.CallUnary(ctx, req)
+}
+
+// RetryTask calls bff.v1.ModerationService.RetryTask.
+func (c *moderationServiceClient) RetryTask(ctx context.Context, req *connect.Request[v1.RetryTaskRequest]) (*connect.Response[v1.RetryTaskResponse], error) {
+	return c.retryTask.CallUnary(ctx, req)
+}
+
+// CancelTask calls bff.v1.ModerationService.CancelTask.
+func (c *moderationServiceClient) CancelTask(ctx context.Context, req *connect.Request[v1.CancelTaskRequest]) (*connect.Response[v1.CancelTaskResponse], error) {
+	return c.cancelTask.CallUnary(ctx, req)
+}
+
+// RefreshActorProfile calls bff.v1.ModerationService.RefreshActorProfile.
+func (c *moderationServiceClient) RefreshActorProfile(ctx context.Context, req *connect.Request[v1.RefreshActorProfileRequest]) (*connect.Response[v1.RefreshActorProfileResponse], error) {
+	return c.refreshActorProfile.CallUnary(ctx, req)
+}
+
+// ListDeadLetterEvents calls bff.v1.ModerationService.ListDeadLetterEvents.
+func (c *moderationServiceClient) ListDeadLetterEvents(ctx context.Context, req *connect.Request[v1.ListDeadLetterEventsRequest]) (*connect.Response[v1.ListDeadLetterEventsResponse], error) {
+	return c.listDeadLetterEvents.CallUnary(ctx, req)
+}
+
+// GetDeadLetterEvent calls bff.v1.ModerationService.GetDeadLetterEvent.
+func (c *moderationServiceClient) GetDeadLetterEvent(ctx context.Context, req *connect.Request[v1.GetDeadLetterEventRequest]) (*connect.Response[v1.GetDeadLetterEventResponse], error) {
+	return c.getDeadLetterEvent.CallUnary(ctx, req)
+}
+
+// ReplayDeadLetterEvent calls bff.v1.ModerationService.ReplayDeadLetterEvent.
+func (c *moderationServiceClient) ReplayDeadLetterEvent(ctx context.Context, req *connect.Request[v1.ReplayDeadLetterEventRequest]) (*connect.Response[v1.ReplayDeadLetterEventResponse], error) {
+	return c.replayDeadLetterEvent.CallUnary(ctx, req)
+}
+
+// DiscardDeadLetterEvent calls bff.v1.ModerationService.DiscardDeadLetterEvent.
+func (c *moderationServiceClient) DiscardDeadLetterEvent(ctx context.Context, req *connect.Request[v1.DiscardDeadLetterEventRequest]) (*connect.Response[v1.DiscardDeadLetterEventResponse], error) {
+	return c.discardDeadLetterEvent.CallUnary(ctx, req)
+}
+
+// ListScoringFlags calls bff.v1.ModerationService.ListScoringFlags.
+func (c *moderationServiceClient) ListScoringFlags(ctx context.Context, req *connect.Request[v1.ListScoringFlagsRequest]) (*connect.Response[v1.ListScoringFlagsResponse], error) {
+	return c.listScoringFlags.CallUnary(ctx, req)
+}
+
+// ExplainPost calls bff.v1.ModerationService.ExplainPost.
+func (c *moderationServiceClient) ExplainPost(ctx context.Context, req *connect.Request[v1.ExplainPostRequest]) (*connect.Response[v1.ExplainPostResponse], error) {
+	return c.explainPost.CallUnary(ctx, req)
+}
+
 // ModerationServiceHandler is an implementation of the bff.v1.ModerationService service.
 type ModerationServiceHandler interface {
 	// Ping is a test RPC that checks that the user is authenticated and then
@@ -289,10 +542,53 @@ type ModerationServiceHandler interface {
 	// CreateActor creates a database entry for an actor who does not currently exist.
 	// By default, their status will be set to none.
 	CreateActor(context.Context, *connect.Request[v1.CreateActorRequest]) (*connect.Response[v1.CreateActorResponse], error)
+	// PurgeActor deletes an actor's posts, likes, follows, profiles and handle
+	// history. Their data is hidden immediately, and permanently deleted after a
+	// grace period. Until then, it can be restored with RestoreActor.
+	PurgeActor(context.Context, *connect.Request[v1.PurgeActorRequest]) (*connect.Response[v1.PurgeActorResponse], error)
+	// RestoreActor restores the data of an actor who is pending a purge.
+	RestoreActor(context.Context, *connect.Request[v1.RestoreActorRequest]) (*connect.Response[v1.RestoreActorResponse], error)
 	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
 	CreateCommentAuditEvent(context.Context, *connect.Request[v1.CreateCommentAuditEventRequest]) (*connect.Response[v1.CreateCommentAuditEventResponse], error)
 	ListRoles(context.Context, *connect.Request[v1.ListRolesRequest]) (*connect.Response[v1.ListRolesResponse], error)
 	AssignRoles(context.Context, *connect.Request[v1.AssignRolesRequest]) (*connect.Response[v1.AssignRolesResponse], error)
+	// SetActorNSFWOverride overrides whether an actor's posts are NSFW,
+	// regardless of the NSFW rules. Their existing posts are reclassified.
+	SetActorNSFWOverride(context.Context, *connect.Request[v1.SetActorNSFWOverrideRequest]) (*connect.Response[v1.SetActorNSFWOverrideResponse], error)
+	// GetFollowReconciliationReport compares the accounts followed by the feed
+	// account against the approved actors, and reports the corrections that
+	// the background worker would make. No changes are made.
+	GetFollowReconciliationReport(context.Context, *connect.Request[v1.GetFollowReconciliationReportRequest]) (*connect.Response[v1.GetFollowReconciliationReportResponse], error)
+	// ListTasks lists background worker tasks, most recently created first. It
+	// can be filtered by state, type and the actor the task acts upon.
+	ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error)
+	// GetTask fetches a single background worker task.
+	GetTask(context.Context, *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error)
+	// RetryTask resets a failed, cancelled or pending task so that it runs again
+	// immediately with a fresh set of tries. Running and done tasks cannot be
+	// retried.
+	RetryTask(context.Context, *connect.Request[v1.RetryTaskRequest]) (*connect.Response[v1.RetryTaskResponse], error)
+	// CancelTask cancels a pending task. Running tasks cannot be cancelled.
+	CancelTask(context.Context, *connect.Request[v1.CancelTaskRequest]) (*connect.Response[v1.CancelTaskResponse], error)
+	// RefreshActorProfile enqueues a task to refresh the stored profile of an
+	// actor from the network.
+	RefreshActorProfile(context.Context, *connect.Request[v1.RefreshActorProfileRequest]) (*connect.Response[v1.RefreshActorProfileResponse], error)
+	// ListDeadLetterEvents lists Jetstream events that the ingester failed to
+	// handle, most recently failed first.
+	ListDeadLetterEvents(context.Context, *connect.Request[v1.ListDeadLetterEventsRequest]) (*connect.Response[v1.ListDeadLetterEventsResponse], error)
+	// GetDeadLetterEvent fetches a single dead-lettered event.
+	GetDeadLetterEvent(context.Context, *connect.Request[v1.GetDeadLetterEventRequest]) (*connect.Response[v1.GetDeadLetterEventResponse], error)
+	// ReplayDeadLetterEvent makes the ingester retry an event immediately,
+	// including one which has exhausted its attempts.
+	ReplayDeadLetterEvent(context.Context, *connect.Request[v1.ReplayDeadLetterEventRequest]) (*connect.Response[v1.ReplayDeadLetterEventResponse], error)
+	// DiscardDeadLetterEvent deletes an event without handling it.
+	DiscardDeadLetterEvent(context.Context, *connect.Request[v1.DiscardDeadLetterEventRequest]) (*connect.Response[v1.DiscardDeadLetterEventResponse], error)
+	// ListScoringFlags lists the actors and posts whose likes are being
+	// dampened by the anti-gaming safeguards in post scoring.
+	ListScoringFlags(context.Context, *connect.Request[v1.ListScoringFlagsRequest]) (*connect.Response[v1.ListScoringFlagsResponse], error)
+	// ExplainPost reports whether a post passes each of a feed's filters, and
+	// for scored feeds, how it was scored and where it ranks.
+	ExplainPost(context.Context, *connect.Request[v1.ExplainPostRequest]) (*connect.Response[v1.ExplainPostResponse], error)
 }
 
 // NewModerationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -346,6 +642,16 @@ func NewModerationServiceHandler(svc ModerationServiceHandler, opts ...connect.H
 		svc.CreateActor,
 		opts...,
 	)
+	moderationServicePurgeActorHandler := connect.NewUnaryHandler(
+		ModerationServicePurgeActorProcedure,
+		svc.PurgeActor,
+		opts...,
+	)
+	moderationServiceRestoreActorHandler := connect.NewUnaryHandler(
+		ModerationServiceRestoreActorProcedure,
+		svc.RestoreActor,
+		opts...,
+	)
 	moderationServiceListAuditEventsHandler := connect.NewUnaryHandler(
 		ModerationServiceListAuditEventsProcedure,
 		svc.ListAuditEvents,
@@ -366,6 +672,71 @@ func NewModerationServiceHandler(svc ModerationServiceHandler, opts ...connect.H
 		svc.AssignRoles,
 		opts...,
 	)
+	moderationServiceSetActorNSFWOverrideHandler := connect.NewUnaryHandler(
+		ModerationServiceSetActorNSFWOverrideProcedure,
+		svc.SetActorNSFWOverride,
+		opts...,
+	)
+	moderationServiceGetFollowReconciliationReportHandler := connect.NewUnaryHandler(
+		ModerationServiceGetFollowReconciliationReportProcedure,
+		svc.GetFollowReconciliationReport,
+		opts...,
+	)
+	moderationServiceListTasksHandler := connect.NewUnaryHandler(
+		ModerationServiceListTasksProcedure,
+		svc.ListTasks,
+		opts...,
+	)
+	moderationServiceGetTaskHandler := connect.NewUnaryHandler(
+		ModerationServiceGetTaskProcedure,
+		svc.GetTask,
+		opts...,
+	)
+	moderationServiceRetryTaskHandler := connect.NewUnaryHandler(
+		ModerationServiceRetryTaskProcedure,
+		svc.RetryTask,
+		opts...,
+	)
+	moderationServiceCancelTaskHandler := connect.NewUnaryHandler(
+		ModerationServiceCancelTaskProcedure,
+		svc.CancelTask,
+		opts...,
+	)
+	moderationServiceRefreshActorProfileHandler := connect.NewUnaryHandler(
+		ModerationServiceRefreshActorProfileProcedure,
+		svc.RefreshActorProfile,
+		opts...,
+	)
+	moderationServiceListDeadLetterEventsHandler := connect.NewUnaryHandler(
+		ModerationServiceListDeadLetterEventsProcedure,
+		svc.ListDeadLetterEvents,
+		opts...,
+	)
+	moderationServiceGetDeadLetterEventHandler := connect.NewUnaryHandler(
+		ModerationServiceGetDeadLetterEventProcedure,
+		svc.GetDeadLetterEvent,
+		opts...,
+	)
+	moderationServiceReplayDeadLetterEventHandler := connect.NewUnaryHandler(
+		ModerationServiceReplayDeadLetterEventProcedure,
+		svc.ReplayDeadLetterEvent,
+		opts...,
+	)
+	moderationServiceDiscardDeadLetterEventHandler := connect.NewUnaryHandler(
+		ModerationServiceDiscardDeadLetterEventProcedure,
+		svc.DiscardDeadLetterEvent,
+		opts...,
+	)
+	moderationServiceListScoringFlagsHandler := connect.NewUnaryHandler(
+		ModerationServiceListScoringFlagsProcedure,
+		svc.ListScoringFlags,
+		opts...,
+	)
+	moderationServiceExplainPostHandler := connect.NewUnaryHandler(
+		ModerationServiceExplainPostProcedure,
+		svc.ExplainPost,
+		opts...,
+	)
 	return "/bff.v1.ModerationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ModerationServicePingProcedure:
@@ -386,6 +757,10 @@ func NewModerationServiceHandler(svc ModerationServiceHandler, opts ...connect.H
 			moderationServiceForceApproveActorHandler.ServeHTTP(w, r)
 		case ModerationServiceCreateActorProcedure:
 			moderationServiceCreateActorHandler.ServeHTTP(w, r)
+		case ModerationServicePurgeActorProcedure:
+			moderationServicePurgeActorHandler.ServeHTTP(w, r)
+		case ModerationServiceRestoreActorProcedure:
+			moderationServiceRestoreActorHandler.ServeHTTP(w, r)
 		case ModerationServiceListAuditEventsProcedure:
 			moderationServiceListAuditEventsHandler.ServeHTTP(w, r)
 		case ModerationServiceCreateCommentAuditEventProcedure:
@@ -394,6 +769,32 @@ func NewModerationServiceHandler(svc ModerationServiceHandler, opts ...connect.H
 			moderationServiceListRolesHandler.ServeHTTP(w, r)
 		case ModerationServiceAssignRolesProcedure:
 			moderationServiceAssignRolesHandler.ServeHTTP(w, r)
+		case ModerationServiceSetActorNSFWOverrideProcedure:
+			moderationServiceSetActorNSFWOverrideHandler.ServeHTTP(w, r)
+		case ModerationServiceGetFollowReconciliationReportProcedure:
+			moderationServiceGetFollowReconciliationReportHandler.ServeHTTP(w, r)
+		case ModerationServiceListTasksProcedure:
+			moderationServiceListTasksHandler.ServeHTTP(w, r)
+		case ModerationServiceGetTaskProcedure:
+			moderationServiceGetTaskHandler.ServeHTTP(w, r)
+		case ModerationServiceRetryTaskProcedure:
+			moderationServiceRetryTaskHandler.ServeHTTP(w, r)
+		case ModerationServiceCancelTaskProcedure:
+			moderationServiceCancelTaskHandler.ServeHTTP(w, r)
+		case ModerationServiceRefreshActorProfileProcedure:
+			moderationServiceRefreshActorProfileHandler.ServeHTTP(w, r)
+		case ModerationServiceListDeadLetterEventsProcedure:
+			moderationServiceListDeadLetterEventsHandler.ServeHTTP(w, r)
+		case ModerationServiceGetDeadLetterEventProcedure:
+			moderationServiceGetDeadLetterEventHandler.ServeHTTP(w, r)
+		case ModerationServiceReplayDeadLetterEventProcedure:
+			moderationServiceReplayDeadLetterEventHandler.ServeHTTP(w, r)
+		case ModerationServiceDiscardDeadLetterEventProcedure:
+			moderationServiceDiscardDeadLetterEventHandler.ServeHTTP(w, r)
+		case ModerationServiceListScoringFlagsProcedure:
+			moderationServiceListScoringFlagsHandler.ServeHTTP(w, r)
+		case ModerationServiceExplainPostProcedure:
+			moderationServiceExplainPostHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -439,6 +840,14 @@ func (UnimplementedModerationServiceHandler) CreateActor(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.CreateActor is not implemented"))
 }
 
+func (UnimplementedModerationServiceHandler) PurgeActor(context.Context, *connect.Request[v1.PurgeActorRequest]) (*connect.Response[v1.PurgeActorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.PurgeActor is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) RestoreActor(context.Context, *connect.Request[v1.RestoreActorRequest]) (*connect.Response[v1.RestoreActorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.RestoreActor is not implemented"))
+}
+
 func (UnimplementedModerationServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.ListAuditEvents is not implemented"))
 }
@@ -454,3 +863,55 @@ func (UnimplementedModerationServiceHandler) ListRoles(context.Context, *connect
 func (UnimplementedModerationServiceHandler) AssignRoles(context.Context, *connect.Request[v1.AssignRolesRequest]) (*connect.Response[v1.AssignRolesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.AssignRoles is not implemented"))
 }
+
+func (UnimplementedModerationServiceHandler) SetActorNSFWOverride(context.Context, *connect.Request[v1.SetActorNSFWOverrideRequest]) (*connect.Response[v1.SetActorNSFWOverrideResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.SetActorNSFWOverride is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) GetFollowReconciliationReport(context.Context, *connect.Request[v1.GetFollowReconciliationReportRequest]) (*connect.Response[v1.GetFollowReconciliationReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.GetFollowReconciliationReport is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.ListTasks is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) GetTask(context.Context, *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.GetTask is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) RetryTask(context.Context, *connect.Request[v1.RetryTaskRequest]) (*connect.Response[v1.RetryTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.RetryTask is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) CancelTask(context.Context, *connect.Request[v1.CancelTaskRequest]) (*connect.Response[v1.CancelTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.CancelTask is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) RefreshActorProfile(context.Context, *connect.Request[v1.RefreshActorProfileRequest]) (*connect.Response[v1.RefreshActorProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.RefreshActorProfile is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ListDeadLetterEvents(context.Context, *connect.Request[v1.ListDeadLetterEventsRequest]) (*connect.Response[v1.ListDeadLetterEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.ListDeadLetterEvents is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) GetDeadLetterEvent(context.Context, *connect.Request[v1.GetDeadLetterEventRequest]) (*connect.Response[v1.GetDeadLetterEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.GetDeadLetterEvent is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ReplayDeadLetterEvent(context.Context, *connect.Request[v1.ReplayDeadLetterEventRequest]) (*connect.Response[v1.ReplayDeadLetterEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.ReplayDeadLetterEvent is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) DiscardDeadLetterEvent(context.Context, *connect.Request[v1.DiscardDeadLetterEventRequest]) (*connect.Response[v1.DiscardDeadLetterEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.DiscardDeadLetterEvent is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ListScoringFlags(context.Context, *connect.Request[v1.ListScoringFlagsRequest]) (*connect.Response[v1.ListScoringFlagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.ListScoringFlags is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ExplainPost(context.Context, *connect.Request[v1.ExplainPostRequest]) (*connect.Response[v1.ExplainPostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bff.v1.ModerationService.ExplainPost is not implemented"))
+}
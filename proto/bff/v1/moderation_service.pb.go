@@ -75,40 +75,55 @@ func (ApprovalQueueAction) EnumDescriptor() ([]byte, []int) {
 type AuditEventType int32
 
 const (
-	AuditEventType_COMMENT        AuditEventType = 0
-	AuditEventType_APPROVED       AuditEventType = 1
-	AuditEventType_REJECTED       AuditEventType = 2
-	AuditEventType_HELD_BACK      AuditEventType = 3
-	AuditEventType_FORCE_APPROVED AuditEventType = 4
-	AuditEventType_UNAPPROVED     AuditEventType = 5
-	AuditEventType_TRACKED        AuditEventType = 6
-	AuditEventType_BANNED         AuditEventType = 7
-	AuditEventType_ASSIGNED_ROLES AuditEventType = 8
+	AuditEventType_COMMENT                AuditEventType = 0
+	AuditEventType_APPROVED               AuditEventType = 1
+	AuditEventType_REJECTED               AuditEventType = 2
+	AuditEventType_HELD_BACK              AuditEventType = 3
+	AuditEventType_FORCE_APPROVED         AuditEventType = 4
+	AuditEventType_UNAPPROVED             AuditEventType = 5
+	AuditEventType_TRACKED                AuditEventType = 6
+	AuditEventType_BANNED                 AuditEventType = 7
+	AuditEventType_ASSIGNED_ROLES         AuditEventType = 8
+	AuditEventType_ACCOUNT_STATUS_CHANGED AuditEventType = 9
+	AuditEventType_HANDLE_CHANGED         AuditEventType = 10
+	AuditEventType_ACTOR_DATA_DELETED     AuditEventType = 11
+	AuditEventType_ACTOR_DATA_RESTORED    AuditEventType = 12
+	AuditEventType_ACTOR_DATA_PURGED      AuditEventType = 13
 )
 
 // Enum value maps for AuditEventType.
 var (
 	AuditEventType_name = map[int32]string{
-		0: "COMMENT",
-		1: "APPROVED",
-		2: "REJECTED",
-		3: "HELD_BACK",
-		4: "FORCE_APPROVED",
-		5: "UNAPPROVED",
-		6: "TRACKED",
-		7: "BANNED",
-		8: "ASSIGNED_ROLES",
+		0:  "COMMENT",
+		1:  "APPROVED",
+		2:  "REJECTED",
+		3:  "HELD_BACK",
+		4:  "FORCE_APPROVED",
+		5:  "UNAPPROVED",
+		6:  "TRACKED",
+		7:  "BANNED",
+		8:  "ASSIGNED_ROLES",
+		9:  "ACCOUNT_STATUS_CHANGED",
+		10: "HANDLE_CHANGED",
+		11: "ACTOR_DATA_DELETED",
+		12: "ACTOR_DATA_RESTORED",
+		13: "ACTOR_DATA_PURGED",
 	}
 	AuditEventType_value = map[string]int32{
-		"COMMENT":        0,
-		"APPROVED":       1,
-		"REJECTED":       2,
-		"HELD_BACK":      3,
-		"FORCE_APPROVED": 4,
-		"UNAPPROVED":     5,
-		"TRACKED":        6,
-		"BANNED":         7,
-		"ASSIGNED_ROLES": 8,
+		"COMMENT":                0,
+		"APPROVED":               1,
+		"REJECTED":               2,
+		"HELD_BACK":              3,
+		"FORCE_APPROVED":         4,
+		"UNAPPROVED":             5,
+		"TRACKED":                6,
+		"BANNED":                 7,
+		"ASSIGNED_ROLES":         8,
+		"ACCOUNT_STATUS_CHANGED": 9,
+		"HANDLE_CHANGED":         10,
+		"ACTOR_DATA_DELETED":     11,
+		"ACTOR_DATA_RESTORED":    12,
+		"ACTOR_DATA_PURGED":      13,
 	}
 )
 
@@ -139,6 +154,68 @@ func (AuditEventType) EnumDescriptor() ([]byte, []int) {
 	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{1}
 }
 
+type TaskState int32
+
+const (
+	TaskState_TASK_STATE_UNSPECIFIED TaskState = 0
+	// TASK_STATE_PENDING tasks are waiting to run, or to be retried.
+	TaskState_TASK_STATE_PENDING TaskState = 1
+	// TASK_STATE_RUNNING tasks have been claimed by a worker.
+	TaskState_TASK_STATE_RUNNING TaskState = 2
+	// TASK_STATE_FAILED tasks have exhausted their tries and will not be
+	// retried unless done so manually.
+	TaskState_TASK_STATE_FAILED    TaskState = 3
+	TaskState_TASK_STATE_DONE      TaskState = 4
+	TaskState_TASK_STATE_CANCELLED TaskState = 5
+)
+
+// Enum value maps for TaskState.
+var (
+	TaskState_name = map[int32]string{
+		0: "TASK_STATE_UNSPECIFIED",
+		1: "TASK_STATE_PENDING",
+		2: "TASK_STATE_RUNNING",
+		3: "TASK_STATE_FAILED",
+		4: "TASK_STATE_DONE",
+		5: "TASK_STATE_CANCELLED",
+	}
+	TaskState_value = map[string]int32{
+		"TASK_STATE_UNSPECIFIED": 0,
+		"TASK_STATE_PENDING":     1,
+		"TASK_STATE_RUNNING":     2,
+		"TASK_STATE_FAILED":      3,
+		"TASK_STATE_DONE":        4,
+		"TASK_STATE_CANCELLED":   5,
+	}
+)
+
+func (x TaskState) Enum() *TaskState {
+	p := new(TaskState)
+	*p = x
+	return p
+}
+
+func (x TaskState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskState) Descriptor() protoreflect.EnumDescriptor {
+	return file_bff_v1_moderation_service_proto_enumTypes[2].Descriptor()
+}
+
+func (TaskState) Type() protoreflect.EnumType {
+	return &file_bff_v1_moderation_service_proto_enumTypes[2]
+}
+
+func (x TaskState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskState.Descriptor instead.
+func (TaskState) EnumDescriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{2}
+}
+
 type Post struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -255,6 +332,8 @@ type GetActorResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Actor *Actor `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	// handle_history lists the handles the actor has used, most recent first.
+	HandleHistory []*ActorHandle `protobuf:"bytes,2,rep,name=handle_history,json=handleHistory,proto3" json:"handle_history,omitempty"`
 }
 
 func (x *GetActorResponse) Reset() {
@@ -296,6 +375,69 @@ func (x *GetActorResponse) GetActor() *Actor {
 	return nil
 }
 
+func (x *GetActorResponse) GetHandleHistory() []*ActorHandle {
+	if x != nil {
+		return x.HandleHistory
+	}
+	return nil
+}
+
+type ActorHandle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Handle string `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	// seen_at is when the actor changed to this handle.
+	SeenAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=seen_at,json=seenAt,proto3" json:"seen_at,omitempty"`
+}
+
+func (x *ActorHandle) Reset() {
+	*x = ActorHandle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActorHandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActorHandle) ProtoMessage() {}
+
+func (x *ActorHandle) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActorHandle.ProtoReflect.Descriptor instead.
+func (*ActorHandle) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActorHandle) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *ActorHandle) GetSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SeenAt
+	}
+	return nil
+}
+
 type ListActorsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -309,7 +451,7 @@ type ListActorsRequest struct {
 func (x *ListActorsRequest) Reset() {
 	*x = ListActorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[3]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -322,7 +464,7 @@ func (x *ListActorsRequest) String() string {
 func (*ListActorsRequest) ProtoMessage() {}
 
 func (x *ListActorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[3]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +477,7 @@ func (x *ListActorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActorsRequest.ProtoReflect.Descriptor instead.
 func (*ListActorsRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{3}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListActorsRequest) GetCursor() string {
@@ -371,7 +513,7 @@ type ListActorsResponse struct {
 func (x *ListActorsResponse) Reset() {
 	*x = ListActorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[4]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -384,7 +526,7 @@ func (x *ListActorsResponse) String() string {
 func (*ListActorsResponse) ProtoMessage() {}
 
 func (x *ListActorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[4]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +539,7 @@ func (x *ListActorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActorsResponse.ProtoReflect.Descriptor instead.
 func (*ListActorsResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{4}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListActorsResponse) GetActors() []*Actor {
@@ -423,7 +565,7 @@ type PingRequest struct {
 func (x *PingRequest) Reset() {
 	*x = PingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[5]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -436,7 +578,7 @@ func (x *PingRequest) String() string {
 func (*PingRequest) ProtoMessage() {}
 
 func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[5]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +591,7 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{5}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{6}
 }
 
 type PingResponse struct {
@@ -461,7 +603,7 @@ type PingResponse struct {
 func (x *PingResponse) Reset() {
 	*x = PingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[6]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -474,7 +616,7 @@ func (x *PingResponse) String() string {
 func (*PingResponse) ProtoMessage() {}
 
 func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[6]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +629,7 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{6}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{7}
 }
 
 type ProcessApprovalQueueRequest struct {
@@ -506,7 +648,7 @@ type ProcessApprovalQueueRequest struct {
 func (x *ProcessApprovalQueueRequest) Reset() {
 	*x = ProcessApprovalQueueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[7]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +661,7 @@ func (x *ProcessApprovalQueueRequest) String() string {
 func (*ProcessApprovalQueueRequest) ProtoMessage() {}
 
 func (x *ProcessApprovalQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[7]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +674,7 @@ func (x *ProcessApprovalQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessApprovalQueueRequest.ProtoReflect.Descriptor instead.
 func (*ProcessApprovalQueueRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{7}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ProcessApprovalQueueRequest) GetDid() string {
@@ -572,7 +714,7 @@ type ProcessApprovalQueueResponse struct {
 func (x *ProcessApprovalQueueResponse) Reset() {
 	*x = ProcessApprovalQueueResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[8]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -585,7 +727,7 @@ func (x *ProcessApprovalQueueResponse) String() string {
 func (*ProcessApprovalQueueResponse) ProtoMessage() {}
 
 func (x *ProcessApprovalQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[8]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,7 +740,7 @@ func (x *ProcessApprovalQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessApprovalQueueResponse.ProtoReflect.Descriptor instead.
 func (*ProcessApprovalQueueResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{8}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{9}
 }
 
 // ProcessApprovalQueueAuditPayload is the payload for the
@@ -615,7 +757,7 @@ type ProcessApprovalQueueAuditPayload struct {
 func (x *ProcessApprovalQueueAuditPayload) Reset() {
 	*x = ProcessApprovalQueueAuditPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[9]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -628,7 +770,7 @@ func (x *ProcessApprovalQueueAuditPayload) String() string {
 func (*ProcessApprovalQueueAuditPayload) ProtoMessage() {}
 
 func (x *ProcessApprovalQueueAuditPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[9]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +783,7 @@ func (x *ProcessApprovalQueueAuditPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessApprovalQueueAuditPayload.ProtoReflect.Descriptor instead.
 func (*ProcessApprovalQueueAuditPayload) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{9}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProcessApprovalQueueAuditPayload) GetAction() ApprovalQueueAction {
@@ -670,7 +812,7 @@ type HoldBackPendingActorRequest struct {
 func (x *HoldBackPendingActorRequest) Reset() {
 	*x = HoldBackPendingActorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[10]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -683,7 +825,7 @@ func (x *HoldBackPendingActorRequest) String() string {
 func (*HoldBackPendingActorRequest) ProtoMessage() {}
 
 func (x *HoldBackPendingActorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[10]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,7 +838,7 @@ func (x *HoldBackPendingActorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HoldBackPendingActorRequest.ProtoReflect.Descriptor instead.
 func (*HoldBackPendingActorRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{10}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *HoldBackPendingActorRequest) GetDid() string {
@@ -722,7 +864,7 @@ type HoldBackPendingActorResponse struct {
 func (x *HoldBackPendingActorResponse) Reset() {
 	*x = HoldBackPendingActorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[11]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -735,7 +877,7 @@ func (x *HoldBackPendingActorResponse) String() string {
 func (*HoldBackPendingActorResponse) ProtoMessage() {}
 
 func (x *HoldBackPendingActorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[11]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +890,7 @@ func (x *HoldBackPendingActorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HoldBackPendingActorResponse.ProtoReflect.Descriptor instead.
 func (*HoldBackPendingActorResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{11}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{12}
 }
 
 type HoldBackPendingActorAuditPayload struct {
@@ -762,7 +904,7 @@ type HoldBackPendingActorAuditPayload struct {
 func (x *HoldBackPendingActorAuditPayload) Reset() {
 	*x = HoldBackPendingActorAuditPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[12]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -775,7 +917,7 @@ func (x *HoldBackPendingActorAuditPayload) String() string {
 func (*HoldBackPendingActorAuditPayload) ProtoMessage() {}
 
 func (x *HoldBackPendingActorAuditPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[12]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +930,7 @@ func (x *HoldBackPendingActorAuditPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HoldBackPendingActorAuditPayload.ProtoReflect.Descriptor instead.
 func (*HoldBackPendingActorAuditPayload) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{12}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *HoldBackPendingActorAuditPayload) GetHeldUntil() *timestamppb.Timestamp {
@@ -816,7 +958,7 @@ type ListAuditEventsRequest struct {
 func (x *ListAuditEventsRequest) Reset() {
 	*x = ListAuditEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[13]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -829,7 +971,7 @@ func (x *ListAuditEventsRequest) String() string {
 func (*ListAuditEventsRequest) ProtoMessage() {}
 
 func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[13]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,7 +984,7 @@ func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{13}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListAuditEventsRequest) GetFilterActorDid() string {
@@ -899,7 +1041,7 @@ type ListAuditEventsResponse struct {
 func (x *ListAuditEventsResponse) Reset() {
 	*x = ListAuditEventsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[14]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -912,7 +1054,7 @@ func (x *ListAuditEventsResponse) String() string {
 func (*ListAuditEventsResponse) ProtoMessage() {}
 
 func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[14]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,7 +1067,7 @@ func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{14}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListAuditEventsResponse) GetAuditEvents() []*AuditEvent {
@@ -955,7 +1097,7 @@ type CreateCommentAuditEventRequest struct {
 func (x *CreateCommentAuditEventRequest) Reset() {
 	*x = CreateCommentAuditEventRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[15]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -968,7 +1110,7 @@ func (x *CreateCommentAuditEventRequest) String() string {
 func (*CreateCommentAuditEventRequest) ProtoMessage() {}
 
 func (x *CreateCommentAuditEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[15]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1123,7 @@ func (x *CreateCommentAuditEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCommentAuditEventRequest.ProtoReflect.Descriptor instead.
 func (*CreateCommentAuditEventRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{15}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateCommentAuditEventRequest) GetSubjectDid() string {
@@ -1016,7 +1158,7 @@ type CreateCommentAuditEventResponse struct {
 func (x *CreateCommentAuditEventResponse) Reset() {
 	*x = CreateCommentAuditEventResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[16]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1029,7 +1171,7 @@ func (x *CreateCommentAuditEventResponse) String() string {
 func (*CreateCommentAuditEventResponse) ProtoMessage() {}
 
 func (x *CreateCommentAuditEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[16]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,7 +1184,7 @@ func (x *CreateCommentAuditEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCommentAuditEventResponse.ProtoReflect.Descriptor instead.
 func (*CreateCommentAuditEventResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{16}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateCommentAuditEventResponse) GetAuditEvent() *AuditEvent {
@@ -1065,7 +1207,7 @@ type CommentAuditPayload struct {
 func (x *CommentAuditPayload) Reset() {
 	*x = CommentAuditPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[17]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1078,7 +1220,7 @@ func (x *CommentAuditPayload) String() string {
 func (*CommentAuditPayload) ProtoMessage() {}
 
 func (x *CommentAuditPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[17]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,7 +1233,7 @@ func (x *CommentAuditPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentAuditPayload.ProtoReflect.Descriptor instead.
 func (*CommentAuditPayload) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{17}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CommentAuditPayload) GetComment() string {
@@ -1113,7 +1255,7 @@ type CreateActorRequest struct {
 func (x *CreateActorRequest) Reset() {
 	*x = CreateActorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[18]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1126,7 +1268,7 @@ func (x *CreateActorRequest) String() string {
 func (*CreateActorRequest) ProtoMessage() {}
 
 func (x *CreateActorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[18]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,7 +1281,7 @@ func (x *CreateActorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActorRequest.ProtoReflect.Descriptor instead.
 func (*CreateActorRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{18}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateActorRequest) GetActorDid() string {
@@ -1167,7 +1309,7 @@ type CreateActorResponse struct {
 func (x *CreateActorResponse) Reset() {
 	*x = CreateActorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[19]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1180,7 +1322,7 @@ func (x *CreateActorResponse) String() string {
 func (*CreateActorResponse) ProtoMessage() {}
 
 func (x *CreateActorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[19]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,7 +1335,7 @@ func (x *CreateActorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActorResponse.ProtoReflect.Descriptor instead.
 func (*CreateActorResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{19}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateActorResponse) GetActor() *Actor {
@@ -1214,7 +1356,7 @@ type CreateActorAuditPayload struct {
 func (x *CreateActorAuditPayload) Reset() {
 	*x = CreateActorAuditPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[20]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1227,7 +1369,7 @@ func (x *CreateActorAuditPayload) String() string {
 func (*CreateActorAuditPayload) ProtoMessage() {}
 
 func (x *CreateActorAuditPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[20]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,7 +1382,7 @@ func (x *CreateActorAuditPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActorAuditPayload.ProtoReflect.Descriptor instead.
 func (*CreateActorAuditPayload) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{20}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CreateActorAuditPayload) GetReason() string {
@@ -1262,7 +1404,7 @@ type UnapproveActorRequest struct {
 func (x *UnapproveActorRequest) Reset() {
 	*x = UnapproveActorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[21]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1275,7 +1417,7 @@ func (x *UnapproveActorRequest) String() string {
 func (*UnapproveActorRequest) ProtoMessage() {}
 
 func (x *UnapproveActorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[21]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,7 +1430,7 @@ func (x *UnapproveActorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnapproveActorRequest.ProtoReflect.Descriptor instead.
 func (*UnapproveActorRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{21}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UnapproveActorRequest) GetActorDid() string {
@@ -1316,7 +1458,7 @@ type UnapproveActorResponse struct {
 func (x *UnapproveActorResponse) Reset() {
 	*x = UnapproveActorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[22]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1329,7 +1471,7 @@ func (x *UnapproveActorResponse) String() string {
 func (*UnapproveActorResponse) ProtoMessage() {}
 
 func (x *UnapproveActorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[22]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,7 +1484,7 @@ func (x *UnapproveActorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnapproveActorResponse.ProtoReflect.Descriptor instead.
 func (*UnapproveActorResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{22}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UnapproveActorResponse) GetActor() *Actor {
@@ -1363,7 +1505,7 @@ type UnapproveActorAuditPayload struct {
 func (x *UnapproveActorAuditPayload) Reset() {
 	*x = UnapproveActorAuditPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[23]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1376,7 +1518,7 @@ func (x *UnapproveActorAuditPayload) String() string {
 func (*UnapproveActorAuditPayload) ProtoMessage() {}
 
 func (x *UnapproveActorAuditPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[23]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,7 +1531,7 @@ func (x *UnapproveActorAuditPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnapproveActorAuditPayload.ProtoReflect.Descriptor instead.
 func (*UnapproveActorAuditPayload) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{23}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UnapproveActorAuditPayload) GetReason() string {
@@ -1411,7 +1553,7 @@ type ForceApproveActorRequest struct {
 func (x *ForceApproveActorRequest) Reset() {
 	*x = ForceApproveActorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[24]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1424,7 +1566,7 @@ func (x *ForceApproveActorRequest) String() string {
 func (*ForceApproveActorRequest) ProtoMessage() {}
 
 func (x *ForceApproveActorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[24]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,7 +1579,7 @@ func (x *ForceApproveActorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForceApproveActorRequest.ProtoReflect.Descriptor instead.
 func (*ForceApproveActorRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{24}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ForceApproveActorRequest) GetActorDid() string {
@@ -1465,7 +1607,7 @@ type ForceApproveActorResponse struct {
 func (x *ForceApproveActorResponse) Reset() {
 	*x = ForceApproveActorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[25]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1478,7 +1620,7 @@ func (x *ForceApproveActorResponse) String() string {
 func (*ForceApproveActorResponse) ProtoMessage() {}
 
 func (x *ForceApproveActorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[25]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +1633,7 @@ func (x *ForceApproveActorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForceApproveActorResponse.ProtoReflect.Descriptor instead.
 func (*ForceApproveActorResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{25}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ForceApproveActorResponse) GetActor() *Actor {
@@ -1512,7 +1654,7 @@ type ForceApproveActorAuditPayload struct {
 func (x *ForceApproveActorAuditPayload) Reset() {
 	*x = ForceApproveActorAuditPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[26]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1525,7 +1667,7 @@ func (x *ForceApproveActorAuditPayload) String() string {
 func (*ForceApproveActorAuditPayload) ProtoMessage() {}
 
 func (x *ForceApproveActorAuditPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[26]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,7 +1680,7 @@ func (x *ForceApproveActorAuditPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForceApproveActorAuditPayload.ProtoReflect.Descriptor instead.
 func (*ForceApproveActorAuditPayload) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{26}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ForceApproveActorAuditPayload) GetReason() string {
@@ -1560,7 +1702,7 @@ type BanActorRequest struct {
 func (x *BanActorRequest) Reset() {
 	*x = BanActorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[27]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1573,7 +1715,7 @@ func (x *BanActorRequest) String() string {
 func (*BanActorRequest) ProtoMessage() {}
 
 func (x *BanActorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[27]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1586,7 +1728,7 @@ func (x *BanActorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BanActorRequest.ProtoReflect.Descriptor instead.
 func (*BanActorRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{27}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *BanActorRequest) GetActorDid() string {
@@ -1614,7 +1756,7 @@ type BanActorResponse struct {
 func (x *BanActorResponse) Reset() {
 	*x = BanActorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[28]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1627,7 +1769,7 @@ func (x *BanActorResponse) String() string {
 func (*BanActorResponse) ProtoMessage() {}
 
 func (x *BanActorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[28]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,7 +1782,7 @@ func (x *BanActorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BanActorResponse.ProtoReflect.Descriptor instead.
 func (*BanActorResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{28}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *BanActorResponse) GetActor() *Actor {
@@ -1661,7 +1803,7 @@ type BanActorAuditPayload struct {
 func (x *BanActorAuditPayload) Reset() {
 	*x = BanActorAuditPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[29]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1674,7 +1816,7 @@ func (x *BanActorAuditPayload) String() string {
 func (*BanActorAuditPayload) ProtoMessage() {}
 
 func (x *BanActorAuditPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[29]
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,7 +1829,7 @@ func (x *BanActorAuditPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BanActorAuditPayload.ProtoReflect.Descriptor instead.
 func (*BanActorAuditPayload) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{29}
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *BanActorAuditPayload) GetReason() string {
@@ -1697,42 +1839,32 @@ func (x *BanActorAuditPayload) GetReason() string {
 	return ""
 }
 
-type AuditEvent struct {
+type PurgeActorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is a unique identifier of this audit event.
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// actor_did is the DID of the actor whose action caused this audit event
-	// to be emitted.
-	ActorDid string `protobuf:"bytes,3,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
-	// subject_did is the DID of the actor who the action was done to.
-	SubjectDid string `protobuf:"bytes,4,opt,name=subject_did,json=subjectDid,proto3" json:"subject_did,omitempty"`
-	// subject_record_uri is the optional AT URI of a specific ATProto record
-	// that the action was taken against.
-	SubjectRecordUri string     `protobuf:"bytes,5,opt,name=subject_record_uri,json=subjectRecordUri,proto3" json:"subject_record_uri,omitempty"`
-	Payload          *anypb.Any `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	ActorDid string `protobuf:"bytes,1,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *AuditEvent) Reset() {
-	*x = AuditEvent{}
+func (x *PurgeActorRequest) Reset() {
+	*x = PurgeActorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[30]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuditEvent) String() string {
+func (x *PurgeActorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditEvent) ProtoMessage() {}
+func (*PurgeActorRequest) ProtoMessage() {}
 
-func (x *AuditEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[30]
+func (x *PurgeActorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,76 +1875,156 @@ func (x *AuditEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
-func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use PurgeActorRequest.ProtoReflect.Descriptor instead.
+func (*PurgeActorRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AuditEvent) GetId() string {
+func (x *PurgeActorRequest) GetActorDid() string {
 	if x != nil {
-		return x.Id
+		return x.ActorDid
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+func (x *PurgeActorRequest) GetReason() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *AuditEvent) GetActorDid() string {
-	if x != nil {
-		return x.ActorDid
+type PurgeActorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actor *Actor `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *PurgeActorResponse) Reset() {
+	*x = PurgeActorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AuditEvent) GetSubjectDid() string {
+func (x *PurgeActorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeActorResponse) ProtoMessage() {}
+
+func (x *PurgeActorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeActorResponse.ProtoReflect.Descriptor instead.
+func (*PurgeActorResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PurgeActorResponse) GetActor() *Actor {
 	if x != nil {
-		return x.SubjectDid
+		return x.Actor
 	}
-	return ""
+	return nil
 }
 
-func (x *AuditEvent) GetSubjectRecordUri() string {
+// ActorDataDeletedAuditPayload is emitted when an actor's data is deleted,
+// either by PurgeActor or by the ingester when their account is deleted.
+type ActorDataDeletedAuditPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// purge_at is when the data will be permanently deleted.
+	PurgeAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+}
+
+func (x *ActorDataDeletedAuditPayload) Reset() {
+	*x = ActorDataDeletedAuditPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActorDataDeletedAuditPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActorDataDeletedAuditPayload) ProtoMessage() {}
+
+func (x *ActorDataDeletedAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActorDataDeletedAuditPayload.ProtoReflect.Descriptor instead.
+func (*ActorDataDeletedAuditPayload) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ActorDataDeletedAuditPayload) GetReason() string {
 	if x != nil {
-		return x.SubjectRecordUri
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetPayload() *anypb.Any {
+func (x *ActorDataDeletedAuditPayload) GetPurgeAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Payload
+		return x.PurgeAt
 	}
 	return nil
 }
 
-type ListRolesRequest struct {
+type RestoreActorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ActorDid string `protobuf:"bytes,1,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ListRolesRequest) Reset() {
-	*x = ListRolesRequest{}
+func (x *RestoreActorRequest) Reset() {
+	*x = RestoreActorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[31]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListRolesRequest) String() string {
+func (x *RestoreActorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesRequest) ProtoMessage() {}
+func (*RestoreActorRequest) ProtoMessage() {}
 
-func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[31]
+func (x *RestoreActorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1823,36 +2035,50 @@ func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
-func (*ListRolesRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use RestoreActorRequest.ProtoReflect.Descriptor instead.
+func (*RestoreActorRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{34}
 }
 
-type ListRolesResponse struct {
+func (x *RestoreActorRequest) GetActorDid() string {
+	if x != nil {
+		return x.ActorDid
+	}
+	return ""
+}
+
+func (x *RestoreActorRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RestoreActorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Roles map[string]*Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Actor *Actor `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
 }
 
-func (x *ListRolesResponse) Reset() {
-	*x = ListRolesResponse{}
+func (x *RestoreActorResponse) Reset() {
+	*x = RestoreActorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[32]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListRolesResponse) String() string {
+func (x *RestoreActorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesResponse) ProtoMessage() {}
+func (*RestoreActorResponse) ProtoMessage() {}
 
-func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[32]
+func (x *RestoreActorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1863,43 +2089,43 @@ func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
-func (*ListRolesResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RestoreActorResponse.ProtoReflect.Descriptor instead.
+func (*RestoreActorResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListRolesResponse) GetRoles() map[string]*Role {
+func (x *RestoreActorResponse) GetActor() *Actor {
 	if x != nil {
-		return x.Roles
+		return x.Actor
 	}
 	return nil
 }
 
-type Role struct {
+type ActorDataRestoredAuditPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Permissions []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *Role) Reset() {
-	*x = Role{}
+func (x *ActorDataRestoredAuditPayload) Reset() {
+	*x = ActorDataRestoredAuditPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[33]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Role) String() string {
+func (x *ActorDataRestoredAuditPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Role) ProtoMessage() {}
+func (*ActorDataRestoredAuditPayload) ProtoMessage() {}
 
-func (x *Role) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[33]
+func (x *ActorDataRestoredAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1910,44 +2136,48 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Role.ProtoReflect.Descriptor instead.
-func (*Role) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ActorDataRestoredAuditPayload.ProtoReflect.Descriptor instead.
+func (*ActorDataRestoredAuditPayload) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Role) GetPermissions() []string {
+func (x *ActorDataRestoredAuditPayload) GetReason() string {
 	if x != nil {
-		return x.Permissions
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type AssignRolesRequest struct {
+// ActorDataPurgedAuditPayload is emitted by the worker when an actor's data
+// is permanently deleted. The actor and subject of the audit event are both
+// the purged actor.
+type ActorDataPurgedAuditPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActorDid string   `protobuf:"bytes,1,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
-	Roles    []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Posts   int64 `protobuf:"varint,1,opt,name=posts,proto3" json:"posts,omitempty"`
+	Likes   int64 `protobuf:"varint,2,opt,name=likes,proto3" json:"likes,omitempty"`
+	Follows int64 `protobuf:"varint,3,opt,name=follows,proto3" json:"follows,omitempty"`
 }
 
-func (x *AssignRolesRequest) Reset() {
-	*x = AssignRolesRequest{}
+func (x *ActorDataPurgedAuditPayload) Reset() {
+	*x = ActorDataPurgedAuditPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[34]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignRolesRequest) String() string {
+func (x *ActorDataPurgedAuditPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignRolesRequest) ProtoMessage() {}
+func (*ActorDataPurgedAuditPayload) ProtoMessage() {}
 
-func (x *AssignRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[34]
+func (x *ActorDataPurgedAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1958,539 +2188,3892 @@ func (x *AssignRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignRolesRequest.ProtoReflect.Descriptor instead.
-func (*AssignRolesRequest) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ActorDataPurgedAuditPayload.ProtoReflect.Descriptor instead.
+func (*ActorDataPurgedAuditPayload) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AssignRolesRequest) GetActorDid() string {
+func (x *ActorDataPurgedAuditPayload) GetPosts() int64 {
 	if x != nil {
-		return x.ActorDid
+		return x.Posts
 	}
-	return ""
+	return 0
 }
 
-func (x *AssignRolesRequest) GetRoles() []string {
+func (x *ActorDataPurgedAuditPayload) GetLikes() int64 {
 	if x != nil {
-		return x.Roles
+		return x.Likes
 	}
-	return nil
+	return 0
 }
 
-type AssignRolesResponse struct {
+func (x *ActorDataPurgedAuditPayload) GetFollows() int64 {
+	if x != nil {
+		return x.Follows
+	}
+	return 0
+}
+
+type AuditEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// id is a unique identifier of this audit event.
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// actor_did is the DID of the actor whose action caused this audit event
+	// to be emitted.
+	ActorDid string `protobuf:"bytes,3,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+	// subject_did is the DID of the actor who the action was done to.
+	SubjectDid string `protobuf:"bytes,4,opt,name=subject_did,json=subjectDid,proto3" json:"subject_did,omitempty"`
+	// subject_record_uri is the optional AT URI of a specific ATProto record
+	// that the action was taken against.
+	SubjectRecordUri string     `protobuf:"bytes,5,opt,name=subject_record_uri,json=subjectRecordUri,proto3" json:"subject_record_uri,omitempty"`
+	Payload          *anypb.Any `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (x *AssignRolesResponse) Reset() {
-	*x = AssignRolesResponse{}
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[35]
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignRolesResponse) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignRolesResponse) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *AssignRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[35]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AssignRolesResponse.ProtoReflect.Descriptor instead.
-func (*AssignRolesResponse) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{35}
-}
-
-type AssignRolesAuditPayload struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RolesBefore []string `protobuf:"bytes,1,rep,name=roles_before,json=rolesBefore,proto3" json:"roles_before,omitempty"`
-	RolesAfter  []string `protobuf:"bytes,2,rep,name=roles_after,json=rolesAfter,proto3" json:"roles_after,omitempty"`
-}
-
-func (x *AssignRolesAuditPayload) Reset() {
-	*x = AssignRolesAuditPayload{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bff_v1_moderation_service_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AssignRolesAuditPayload) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AssignRolesAuditPayload) ProtoMessage() {}
-
-func (x *AssignRolesAuditPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_bff_v1_moderation_service_proto_msgTypes[36]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetActorDid() string {
+	if x != nil {
+		return x.ActorDid
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSubjectDid() string {
+	if x != nil {
+		return x.SubjectDid
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSubjectRecordUri() string {
+	if x != nil {
+		return x.SubjectRecordUri
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPayload() *anypb.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{39}
+}
+
+type ListRolesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles map[string]*Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListRolesResponse) GetRoles() map[string]*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type Role struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Permissions []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type AssignRolesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorDid string   `protobuf:"bytes,1,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+	Roles    []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *AssignRolesRequest) Reset() {
+	*x = AssignRolesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRolesRequest) ProtoMessage() {}
+
+func (x *AssignRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRolesRequest.ProtoReflect.Descriptor instead.
+func (*AssignRolesRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AssignRolesRequest) GetActorDid() string {
+	if x != nil {
+		return x.ActorDid
+	}
+	return ""
+}
+
+func (x *AssignRolesRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type AssignRolesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AssignRolesResponse) Reset() {
+	*x = AssignRolesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRolesResponse) ProtoMessage() {}
+
+func (x *AssignRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRolesResponse.ProtoReflect.Descriptor instead.
+func (*AssignRolesResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{43}
+}
+
+type AssignRolesAuditPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RolesBefore []string `protobuf:"bytes,1,rep,name=roles_before,json=rolesBefore,proto3" json:"roles_before,omitempty"`
+	RolesAfter  []string `protobuf:"bytes,2,rep,name=roles_after,json=rolesAfter,proto3" json:"roles_after,omitempty"`
+}
+
+func (x *AssignRolesAuditPayload) Reset() {
+	*x = AssignRolesAuditPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignRolesAuditPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRolesAuditPayload) ProtoMessage() {}
+
+func (x *AssignRolesAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRolesAuditPayload.ProtoReflect.Descriptor instead.
+func (*AssignRolesAuditPayload) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AssignRolesAuditPayload) GetRolesBefore() []string {
+	if x != nil {
+		return x.RolesBefore
+	}
+	return nil
+}
+
+func (x *AssignRolesAuditPayload) GetRolesAfter() []string {
+	if x != nil {
+		return x.RolesAfter
+	}
+	return nil
+}
+
+type SetActorNSFWOverrideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorDid string `protobuf:"bytes,1,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+	// nsfw_override is the new override. UNSPECIFIED removes any override.
+	NsfwOverride ActorNSFWOverride `protobuf:"varint,2,opt,name=nsfw_override,json=nsfwOverride,proto3,enum=bff.v1.ActorNSFWOverride" json:"nsfw_override,omitempty"`
+}
+
+func (x *SetActorNSFWOverrideRequest) Reset() {
+	*x = SetActorNSFWOverrideRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetActorNSFWOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActorNSFWOverrideRequest) ProtoMessage() {}
+
+func (x *SetActorNSFWOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActorNSFWOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetActorNSFWOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetActorNSFWOverrideRequest) GetActorDid() string {
+	if x != nil {
+		return x.ActorDid
+	}
+	return ""
+}
+
+func (x *SetActorNSFWOverrideRequest) GetNsfwOverride() ActorNSFWOverride {
+	if x != nil {
+		return x.NsfwOverride
+	}
+	return ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_UNSPECIFIED
+}
+
+type SetActorNSFWOverrideResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actor *Actor `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *SetActorNSFWOverrideResponse) Reset() {
+	*x = SetActorNSFWOverrideResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetActorNSFWOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActorNSFWOverrideResponse) ProtoMessage() {}
+
+func (x *SetActorNSFWOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActorNSFWOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetActorNSFWOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetActorNSFWOverrideResponse) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+type SetActorNSFWOverrideAuditPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NsfwOverrideBefore ActorNSFWOverride `protobuf:"varint,1,opt,name=nsfw_override_before,json=nsfwOverrideBefore,proto3,enum=bff.v1.ActorNSFWOverride" json:"nsfw_override_before,omitempty"`
+	NsfwOverrideAfter  ActorNSFWOverride `protobuf:"varint,2,opt,name=nsfw_override_after,json=nsfwOverrideAfter,proto3,enum=bff.v1.ActorNSFWOverride" json:"nsfw_override_after,omitempty"`
+}
+
+func (x *SetActorNSFWOverrideAuditPayload) Reset() {
+	*x = SetActorNSFWOverrideAuditPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetActorNSFWOverrideAuditPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActorNSFWOverrideAuditPayload) ProtoMessage() {}
+
+func (x *SetActorNSFWOverrideAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActorNSFWOverrideAuditPayload.ProtoReflect.Descriptor instead.
+func (*SetActorNSFWOverrideAuditPayload) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetActorNSFWOverrideAuditPayload) GetNsfwOverrideBefore() ActorNSFWOverride {
+	if x != nil {
+		return x.NsfwOverrideBefore
+	}
+	return ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_UNSPECIFIED
+}
+
+func (x *SetActorNSFWOverrideAuditPayload) GetNsfwOverrideAfter() ActorNSFWOverride {
+	if x != nil {
+		return x.NsfwOverrideAfter
+	}
+	return ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_UNSPECIFIED
+}
+
+// AccountStatusChangedAuditPayload is emitted by the ingester when an actor's
+// account is deactivated, taken down or restored on the network. The actor
+// and subject of the audit event are both the affected actor.
+type AccountStatusChangedAuditPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// status is the reason the account is inactive, e.g. "deactivated".
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AccountStatusChangedAuditPayload) Reset() {
+	*x = AccountStatusChangedAuditPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountStatusChangedAuditPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatusChangedAuditPayload) ProtoMessage() {}
+
+func (x *AccountStatusChangedAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatusChangedAuditPayload.ProtoReflect.Descriptor instead.
+func (*AccountStatusChangedAuditPayload) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AccountStatusChangedAuditPayload) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *AccountStatusChangedAuditPayload) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// HandleChangedAuditPayload is emitted by the ingester when an actor changes
+// their handle. The actor and subject of the audit event are both the
+// affected actor.
+type HandleChangedAuditPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HandleBefore string `protobuf:"bytes,1,opt,name=handle_before,json=handleBefore,proto3" json:"handle_before,omitempty"`
+	HandleAfter  string `protobuf:"bytes,2,opt,name=handle_after,json=handleAfter,proto3" json:"handle_after,omitempty"`
+}
+
+func (x *HandleChangedAuditPayload) Reset() {
+	*x = HandleChangedAuditPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandleChangedAuditPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleChangedAuditPayload) ProtoMessage() {}
+
+func (x *HandleChangedAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleChangedAuditPayload.ProtoReflect.Descriptor instead.
+func (*HandleChangedAuditPayload) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *HandleChangedAuditPayload) GetHandleBefore() string {
+	if x != nil {
+		return x.HandleBefore
+	}
+	return ""
+}
+
+func (x *HandleChangedAuditPayload) GetHandleAfter() string {
+	if x != nil {
+		return x.HandleAfter
+	}
+	return ""
+}
+
+type GetFollowReconciliationReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetFollowReconciliationReportRequest) Reset() {
+	*x = GetFollowReconciliationReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowReconciliationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowReconciliationReportRequest) ProtoMessage() {}
+
+func (x *GetFollowReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{50}
+}
+
+type GetFollowReconciliationReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// dids_to_follow are approved actors who are not currently followed.
+	DidsToFollow []string `protobuf:"bytes,1,rep,name=dids_to_follow,json=didsToFollow,proto3" json:"dids_to_follow,omitempty"`
+	// dids_to_unfollow are followed actors who are no longer approved.
+	DidsToUnfollow []string `protobuf:"bytes,2,rep,name=dids_to_unfollow,json=didsToUnfollow,proto3" json:"dids_to_unfollow,omitempty"`
+	// untracked_dids are followed accounts which are not known actors. These
+	// are left alone by reconciliation.
+	UntrackedDids []string `protobuf:"bytes,3,rep,name=untracked_dids,json=untrackedDids,proto3" json:"untracked_dids,omitempty"`
+}
+
+func (x *GetFollowReconciliationReportResponse) Reset() {
+	*x = GetFollowReconciliationReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowReconciliationReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowReconciliationReportResponse) ProtoMessage() {}
+
+func (x *GetFollowReconciliationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowReconciliationReportResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowReconciliationReportResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetFollowReconciliationReportResponse) GetDidsToFollow() []string {
+	if x != nil {
+		return x.DidsToFollow
+	}
+	return nil
+}
+
+func (x *GetFollowReconciliationReportResponse) GetDidsToUnfollow() []string {
+	if x != nil {
+		return x.DidsToUnfollow
+	}
+	return nil
+}
+
+func (x *GetFollowReconciliationReportResponse) GetUntrackedDids() []string {
+	if x != nil {
+		return x.UntrackedDids
+	}
+	return nil
+}
+
+type Task struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type determines which handler processes the task, e.g. "follow".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// payload is the JSON encoded input to the task handler.
+	Payload  string    `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	State    TaskState `protobuf:"varint,4,opt,name=state,proto3,enum=bff.v1.TaskState" json:"state,omitempty"`
+	Tries    int32     `protobuf:"varint,5,opt,name=tries,proto3" json:"tries,omitempty"`
+	MaxTries int32     `protobuf:"varint,6,opt,name=max_tries,json=maxTries,proto3" json:"max_tries,omitempty"`
+	// next_try_at is the earliest time the task will next be run, if pending.
+	NextTryAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_try_at,json=nextTryAt,proto3" json:"next_try_at,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// last_error is the error returned by the most recent failed try.
+	LastError string `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// actor_did is the actor the task acts upon, if any.
+	ActorDid string `protobuf:"bytes,11,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Task) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Task) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Task) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *Task) GetState() TaskState {
+	if x != nil {
+		return x.State
+	}
+	return TaskState_TASK_STATE_UNSPECIFIED
+}
+
+func (x *Task) GetTries() int32 {
+	if x != nil {
+		return x.Tries
+	}
+	return 0
+}
+
+func (x *Task) GetMaxTries() int32 {
+	if x != nil {
+		return x.MaxTries
+	}
+	return 0
+}
+
+func (x *Task) GetNextTryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextTryAt
+	}
+	return nil
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *Task) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Task) GetActorDid() string {
+	if x != nil {
+		return x.ActorDid
+	}
+	return ""
+}
+
+type ListTasksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterState    TaskState `protobuf:"varint,1,opt,name=filter_state,json=filterState,proto3,enum=bff.v1.TaskState" json:"filter_state,omitempty"`
+	FilterActorDid string    `protobuf:"bytes,2,opt,name=filter_actor_did,json=filterActorDid,proto3" json:"filter_actor_did,omitempty"`
+	FilterType     string    `protobuf:"bytes,3,opt,name=filter_type,json=filterType,proto3" json:"filter_type,omitempty"`
+	// limit specifies how many tasks to return. If unspecified, this defaults
+	// to 100.
+	Limit  uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTasksRequest) GetFilterState() TaskState {
+	if x != nil {
+		return x.FilterState
+	}
+	return TaskState_TASK_STATE_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetFilterActorDid() string {
+	if x != nil {
+		return x.FilterActorDid
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetFilterType() string {
+	if x != nil {
+		return x.FilterType
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks  []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Cursor string  `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type RetryTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RetryTaskRequest) Reset() {
+	*x = RetryTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryTaskRequest) ProtoMessage() {}
+
+func (x *RetryTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryTaskRequest.ProtoReflect.Descriptor instead.
+func (*RetryTaskRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RetryTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RetryTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *RetryTaskResponse) Reset() {
+	*x = RetryTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryTaskResponse) ProtoMessage() {}
+
+func (x *RetryTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryTaskResponse.ProtoReflect.Descriptor instead.
+func (*RetryTaskResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RetryTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type CancelTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelTaskRequest) Reset() {
+	*x = CancelTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskRequest) ProtoMessage() {}
+
+func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
+func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CancelTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *CancelTaskResponse) Reset() {
+	*x = CancelTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskResponse) ProtoMessage() {}
+
+func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskResponse.ProtoReflect.Descriptor instead.
+func (*CancelTaskResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CancelTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type RefreshActorProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorDid string `protobuf:"bytes,1,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+}
+
+func (x *RefreshActorProfileRequest) Reset() {
+	*x = RefreshActorProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshActorProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshActorProfileRequest) ProtoMessage() {}
+
+func (x *RefreshActorProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshActorProfileRequest.ProtoReflect.Descriptor instead.
+func (*RefreshActorProfileRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RefreshActorProfileRequest) GetActorDid() string {
+	if x != nil {
+		return x.ActorDid
+	}
+	return ""
+}
+
+type RefreshActorProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *RefreshActorProfileResponse) Reset() {
+	*x = RefreshActorProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshActorProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshActorProfileResponse) ProtoMessage() {}
+
+func (x *RefreshActorProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshActorProfileResponse.ProtoReflect.Descriptor instead.
+func (*RefreshActorProfileResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RefreshActorProfileResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type DeadLetterEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorDid string `protobuf:"bytes,2,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+	// event is the JSON encoded Jetstream event.
+	Event string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// last_error is the error returned by the most recent failed attempt.
+	LastError     string                 `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Attempts      int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastAttemptAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	// next_attempt_at is when the event will next be retried. It is unset once
+	// the event has exhausted its attempts.
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+}
+
+func (x *DeadLetterEvent) Reset() {
+	*x = DeadLetterEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetterEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterEvent) ProtoMessage() {}
+
+func (x *DeadLetterEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterEvent.ProtoReflect.Descriptor instead.
+func (*DeadLetterEvent) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeadLetterEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetterEvent) GetActorDid() string {
+	if x != nil {
+		return x.ActorDid
+	}
+	return ""
+}
+
+func (x *DeadLetterEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *DeadLetterEvent) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DeadLetterEvent) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetterEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeadLetterEvent) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+func (x *DeadLetterEvent) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+type ListDeadLetterEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterActorDid string `protobuf:"bytes,1,opt,name=filter_actor_did,json=filterActorDid,proto3" json:"filter_actor_did,omitempty"`
+	// limit specifies how many events to return. If unspecified, this defaults
+	// to 100.
+	Limit  uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListDeadLetterEventsRequest) Reset() {
+	*x = ListDeadLetterEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLetterEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetterEventsRequest) ProtoMessage() {}
+
+func (x *ListDeadLetterEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetterEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLetterEventsRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListDeadLetterEventsRequest) GetFilterActorDid() string {
+	if x != nil {
+		return x.FilterActorDid
+	}
+	return ""
+}
+
+func (x *ListDeadLetterEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeadLetterEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListDeadLetterEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*DeadLetterEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Cursor string             `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListDeadLetterEventsResponse) Reset() {
+	*x = ListDeadLetterEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLetterEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetterEventsResponse) ProtoMessage() {}
+
+func (x *ListDeadLetterEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetterEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLetterEventsResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListDeadLetterEventsResponse) GetEvents() []*DeadLetterEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListDeadLetterEventsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetDeadLetterEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDeadLetterEventRequest) Reset() {
+	*x = GetDeadLetterEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLetterEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterEventRequest) ProtoMessage() {}
+
+func (x *GetDeadLetterEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterEventRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterEventRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetDeadLetterEventRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetDeadLetterEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *DeadLetterEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *GetDeadLetterEventResponse) Reset() {
+	*x = GetDeadLetterEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLetterEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetterEventResponse) ProtoMessage() {}
+
+func (x *GetDeadLetterEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetterEventResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterEventResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetDeadLetterEventResponse) GetEvent() *DeadLetterEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type ReplayDeadLetterEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReplayDeadLetterEventRequest) Reset() {
+	*x = ReplayDeadLetterEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLetterEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterEventRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLetterEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterEventRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterEventRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReplayDeadLetterEventRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReplayDeadLetterEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *DeadLetterEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *ReplayDeadLetterEventResponse) Reset() {
+	*x = ReplayDeadLetterEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLetterEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterEventResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLetterEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterEventResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterEventResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReplayDeadLetterEventResponse) GetEvent() *DeadLetterEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type DiscardDeadLetterEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DiscardDeadLetterEventRequest) Reset() {
+	*x = DiscardDeadLetterEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardDeadLetterEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDeadLetterEventRequest) ProtoMessage() {}
+
+func (x *DiscardDeadLetterEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDeadLetterEventRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDeadLetterEventRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DiscardDeadLetterEventRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DiscardDeadLetterEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DiscardDeadLetterEventResponse) Reset() {
+	*x = DiscardDeadLetterEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardDeadLetterEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDeadLetterEventResponse) ProtoMessage() {}
+
+func (x *DiscardDeadLetterEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDeadLetterEventResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDeadLetterEventResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{71}
+}
+
+// ReciprocalLikers is a pair of actors who have liked many of each other's
+// posts recently.
+type ReciprocalLikers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorDid   string `protobuf:"bytes,1,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+	PartnerDid string `protobuf:"bytes,2,opt,name=partner_did,json=partnerDid,proto3" json:"partner_did,omitempty"`
+	// likes_given is how many of partner_did's posts actor_did has liked.
+	LikesGiven int64 `protobuf:"varint,3,opt,name=likes_given,json=likesGiven,proto3" json:"likes_given,omitempty"`
+	// likes_received is how many of actor_did's posts partner_did has liked.
+	LikesReceived int64 `protobuf:"varint,4,opt,name=likes_received,json=likesReceived,proto3" json:"likes_received,omitempty"`
+}
+
+func (x *ReciprocalLikers) Reset() {
+	*x = ReciprocalLikers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReciprocalLikers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReciprocalLikers) ProtoMessage() {}
+
+func (x *ReciprocalLikers) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReciprocalLikers.ProtoReflect.Descriptor instead.
+func (*ReciprocalLikers) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReciprocalLikers) GetActorDid() string {
+	if x != nil {
+		return x.ActorDid
+	}
+	return ""
+}
+
+func (x *ReciprocalLikers) GetPartnerDid() string {
+	if x != nil {
+		return x.PartnerDid
+	}
+	return ""
+}
+
+func (x *ReciprocalLikers) GetLikesGiven() int64 {
+	if x != nil {
+		return x.LikesGiven
+	}
+	return 0
+}
+
+func (x *ReciprocalLikers) GetLikesReceived() int64 {
+	if x != nil {
+		return x.LikesReceived
+	}
+	return 0
+}
+
+// DampenedPost is a recent post whose likes count for less in scoring.
+type DampenedPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri      string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	ActorDid string `protobuf:"bytes,2,opt,name=actor_did,json=actorDid,proto3" json:"actor_did,omitempty"`
+	Likes    int64  `protobuf:"varint,3,opt,name=likes,proto3" json:"likes,omitempty"`
+	// weighted_likes is what the likes count for once dampened.
+	WeightedLikes float64 `protobuf:"fixed64,4,opt,name=weighted_likes,json=weightedLikes,proto3" json:"weighted_likes,omitempty"`
+}
+
+func (x *DampenedPost) Reset() {
+	*x = DampenedPost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DampenedPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DampenedPost) ProtoMessage() {}
+
+func (x *DampenedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DampenedPost.ProtoReflect.Descriptor instead.
+func (*DampenedPost) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DampenedPost) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DampenedPost) GetActorDid() string {
+	if x != nil {
+		return x.ActorDid
+	}
+	return ""
+}
+
+func (x *DampenedPost) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *DampenedPost) GetWeightedLikes() float64 {
+	if x != nil {
+		return x.WeightedLikes
+	}
+	return 0
+}
+
+type ListScoringFlagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// limit specifies how many of each kind of flag to return. If unspecified,
+	// this defaults to 100.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListScoringFlagsRequest) Reset() {
+	*x = ListScoringFlagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScoringFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScoringFlagsRequest) ProtoMessage() {}
+
+func (x *ListScoringFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScoringFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ListScoringFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListScoringFlagsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListScoringFlagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReciprocalLikers []*ReciprocalLikers `protobuf:"bytes,1,rep,name=reciprocal_likers,json=reciprocalLikers,proto3" json:"reciprocal_likers,omitempty"`
+	DampenedPosts    []*DampenedPost     `protobuf:"bytes,2,rep,name=dampened_posts,json=dampenedPosts,proto3" json:"dampened_posts,omitempty"`
+}
+
+func (x *ListScoringFlagsResponse) Reset() {
+	*x = ListScoringFlagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScoringFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScoringFlagsResponse) ProtoMessage() {}
+
+func (x *ListScoringFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScoringFlagsResponse.ProtoReflect.Descriptor instead.
+func (*ListScoringFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListScoringFlagsResponse) GetReciprocalLikers() []*ReciprocalLikers {
+	if x != nil {
+		return x.ReciprocalLikers
+	}
+	return nil
+}
+
+func (x *ListScoringFlagsResponse) GetDampenedPosts() []*DampenedPost {
+	if x != nil {
+		return x.DampenedPosts
+	}
+	return nil
+}
+
+type ExplainPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	FeedId string `protobuf:"bytes,2,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+}
+
+func (x *ExplainPostRequest) Reset() {
+	*x = ExplainPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainPostRequest) ProtoMessage() {}
+
+func (x *ExplainPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainPostRequest.ProtoReflect.Descriptor instead.
+func (*ExplainPostRequest) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ExplainPostRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ExplainPostRequest) GetFeedId() string {
+	if x != nil {
+		return x.FeedId
+	}
+	return ""
+}
+
+// PostFilterResult is whether a post passes one of a feed's filters.
+type PostFilterResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name identifies the filter, e.g "hashtags" or "nsfw".
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// detail describes what the filter checked, in a way that can be shown to
+	// moderators.
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *PostFilterResult) Reset() {
+	*x = PostFilterResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostFilterResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostFilterResult) ProtoMessage() {}
+
+func (x *PostFilterResult) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostFilterResult.ProtoReflect.Descriptor instead.
+func (*PostFilterResult) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PostFilterResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostFilterResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *PostFilterResult) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// PostScoreExplanation breaks down a post's score in the latest generation of
+// a scored feed's algorithm.
+type PostScoreExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alg           string                 `protobuf:"bytes,1,opt,name=alg,proto3" json:"alg,omitempty"`
+	GenerationSeq int64                  `protobuf:"varint,2,opt,name=generation_seq,json=generationSeq,proto3" json:"generation_seq,omitempty"`
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	// likes is the like count the score was calculated from. Depending on the
+	// algorithm this may include reposts, or be weighted. likes, age_hours,
+	// age_penalty and formula are unset for algorithms we can't break down.
+	Likes float64 `protobuf:"fixed64,5,opt,name=likes,proto3" json:"likes,omitempty"`
+	// age_hours is how old the post was when it was scored.
+	AgeHours float64 `protobuf:"fixed64,6,opt,name=age_hours,json=ageHours,proto3" json:"age_hours,omitempty"`
+	// age_penalty is what the like count was divided by to give the score. It's
+	// unset for algorithms which don't divide by an age penalty.
+	AgePenalty float64 `protobuf:"fixed64,7,opt,name=age_penalty,json=agePenalty,proto3" json:"age_penalty,omitempty"`
+	// rank is the 1-indexed position the post would have in the feed, if it
+	// passed every filter.
+	Rank int64 `protobuf:"varint,8,opt,name=rank,proto3" json:"rank,omitempty"`
+	// formula is how the score is calculated from likes and age_hours.
+	Formula string `protobuf:"bytes,9,opt,name=formula,proto3" json:"formula,omitempty"`
+	// rank_approximate is true for feeds which jitter scores or limit posts per
+	// author, where rank is the position by score alone.
+	RankApproximate bool `protobuf:"varint,10,opt,name=rank_approximate,json=rankApproximate,proto3" json:"rank_approximate,omitempty"`
+}
+
+func (x *PostScoreExplanation) Reset() {
+	*x = PostScoreExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostScoreExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostScoreExplanation) ProtoMessage() {}
+
+func (x *PostScoreExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostScoreExplanation.ProtoReflect.Descriptor instead.
+func (*PostScoreExplanation) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PostScoreExplanation) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *PostScoreExplanation) GetGenerationSeq() int64 {
+	if x != nil {
+		return x.GenerationSeq
+	}
+	return 0
+}
+
+func (x *PostScoreExplanation) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *PostScoreExplanation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PostScoreExplanation) GetLikes() float64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *PostScoreExplanation) GetAgeHours() float64 {
+	if x != nil {
+		return x.AgeHours
+	}
+	return 0
+}
+
+func (x *PostScoreExplanation) GetAgePenalty() float64 {
+	if x != nil {
+		return x.AgePenalty
+	}
+	return 0
+}
+
+func (x *PostScoreExplanation) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PostScoreExplanation) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+func (x *PostScoreExplanation) GetRankApproximate() bool {
+	if x != nil {
+		return x.RankApproximate
+	}
+	return false
+}
+
+type ExplainPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// included is true if the post passes every filter.
+	Included bool                `protobuf:"varint,1,opt,name=included,proto3" json:"included,omitempty"`
+	Filters  []*PostFilterResult `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	// score is unset for chronological feeds, and for posts that weren't scored
+	// in the latest generation.
+	Score *PostScoreExplanation `protobuf:"bytes,3,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ExplainPostResponse) Reset() {
+	*x = ExplainPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bff_v1_moderation_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainPostResponse) ProtoMessage() {}
+
+func (x *ExplainPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bff_v1_moderation_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainPostResponse.ProtoReflect.Descriptor instead.
+func (*ExplainPostResponse) Descriptor() ([]byte, []int) {
+	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExplainPostResponse) GetIncluded() bool {
+	if x != nil {
+		return x.Included
+	}
+	return false
+}
+
+func (x *ExplainPostResponse) GetFilters() []*PostFilterResult {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *ExplainPostResponse) GetScore() *PostScoreExplanation {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+var File_bff_v1_moderation_service_proto protoreflect.FileDescriptor
+
+var file_bff_v1_moderation_service_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x62, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x06, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x62, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x04, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x22, 0x23, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x22,
+	0x73, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0e, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0d, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0x5a, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x73,
+	0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x73, 0x65, 0x65, 0x6e, 0x41, 0x74,
+	0x22, 0x7b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x06, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x99, 0x01, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1e, 0x0a,
+	0x1c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a,
+	0x20, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x66,
+	0x0a, 0x1b, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12,
+	0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1e, 0x0a, 0x1c, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x61,
+	0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x20, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x61,
+	0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x68, 0x65,
+	0x6c, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x68, 0x65, 0x6c, 0x64,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x94, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x55, 0x72, 0x69, 0x12, 0x39, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x66, 0x66, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x68, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x69,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x55, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x56, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62,
+	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x31, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x15, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x16, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62,
+	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x34, 0x0a, 0x1a, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x18, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x19, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x1d, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x0f, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x44, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x10,
+	0x42, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2e, 0x0a, 0x14, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x11, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x39, 0x0a, 0x12, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x6d, 0x0a, 0x1c, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x13, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62,
+	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x37, 0x0a, 0x1d, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x1b, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x72, 0x67, 0x65, 0x64, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73,
+	0x22, 0xf3, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x55, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x46, 0x0a, 0x0a,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x66,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x28, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x47,
+	0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d,
+	0x0a, 0x17, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x7a, 0x0a,
+	0x1b, 0x53, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x53, 0x46, 0x57, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x6e, 0x73, 0x66,
+	0x77, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e,
+	0x53, 0x46, 0x57, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0c, 0x6e, 0x73, 0x66,
+	0x77, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0x43, 0x0a, 0x1c, 0x53, 0x65, 0x74,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x53, 0x46, 0x57, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xba,
+	0x01, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x53, 0x46, 0x57, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x4b, 0x0a, 0x14, 0x6e, 0x73, 0x66, 0x77, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x4e, 0x53, 0x46, 0x57, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x12, 0x6e, 0x73,
+	0x66, 0x77, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x49, 0x0a, 0x13, 0x6e, 0x73, 0x66, 0x77, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x53, 0x46, 0x57,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x11, 0x6e, 0x73, 0x66, 0x77, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x20, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x63, 0x0a, 0x19, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a,
+	0x25, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x69, 0x64, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x64, 0x69, 0x64, 0x73, 0x54, 0x6f, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x28, 0x0a, 0x10,
+	0x64, 0x69, 0x64, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x64, 0x73, 0x54, 0x6f, 0x55, 0x6e,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x75, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x69, 0x64, 0x73, 0x22, 0x90, 0x03,
+	0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x3a, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x72, 0x79, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64,
+	0x22, 0xc1, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x62, 0x66,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x4f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x61,
+	0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x22, 0x0a, 0x10,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x35, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x23, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x12,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04,
+	0x74, 0x61, 0x73, 0x6b, 0x22, 0x39, 0x0a, 0x1a, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x22,
+	0x3f, 0x0a, 0x1b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62,
+	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b,
+	0x22, 0xd2, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x42, 0x0a,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41,
+	0x74, 0x12, 0x42, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x41, 0x74, 0x22, 0x75, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x1c,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62,
+	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x4b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x2e, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x4e, 0x0a, 0x1d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x2f, 0x0a, 0x1d, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x20, 0x0a, 0x1e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x69, 0x70, 0x72, 0x6f, 0x63, 0x61,
+	0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x44, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x5f,
+	0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e,
+	0x65, 0x72, 0x44, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x5f, 0x67,
+	0x69, 0x76, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x47, 0x69, 0x76, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x5f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x6c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x7a, 0x0a,
+	0x0c, 0x44, 0x61, 0x6d, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x6c,
+	0x69, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x72, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x72, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x10, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x72, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x3b,
+	0x0a, 0x0e, 0x64, 0x61, 0x6d, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x6d, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0d, 0x64, 0x61,
+	0x6d, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x3f, 0x0a, 0x12, 0x45,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x10,
+	0x50, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x22, 0xd1, 0x02, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x6c, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x67, 0x12,
+	0x25, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6b, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x67, 0x65, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x67, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x61, 0x6e, 0x6b, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x32, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x2a, 0x81, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x21,
+	0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f,
+	0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56,
+	0x41, 0x4c, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x2a, 0x91, 0x02, 0x0a, 0x0e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52,
+	0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x45, 0x4c, 0x44, 0x5f, 0x42, 0x41, 0x43,
+	0x4b, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x4e, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x41, 0x43, 0x4b,
+	0x45, 0x44, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x07,
+	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x53, 0x10, 0x08, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x09,
+	0x12, 0x12, 0x0a, 0x0e, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x10, 0x0a, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x17, 0x0a, 0x13,
+	0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f,
+	0x52, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x50, 0x55, 0x52, 0x47, 0x45, 0x44, 0x10, 0x0d, 0x2a, 0x9d, 0x01, 0x0a,
+	0x09, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x41,
+	0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x13, 0x0a,
+	0x0f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45,
+	0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x32, 0xc8, 0x12, 0x0a,
+	0x11, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12,
+	0x23, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14,
+	0x48, 0x6f, 0x6c, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
+	0x6c, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x66, 0x66, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x19, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x42, 0x61, 0x6e,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x6e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x6e,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x62,
+	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x66,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x11, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x20, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x19, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62,
+	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a,
+	0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x48, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1a,
+	0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x53, 0x65, 0x74,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x53, 0x46, 0x57, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x12, 0x23, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x4e, 0x53, 0x46, 0x57, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x53, 0x46, 0x57, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7e,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2c, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x18, 0x2e, 0x62, 0x66,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x2e,
+	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x2e,
+	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x19, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x22, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x66,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x66, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x16, 0x44, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x66, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x66, 0x66, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x0b, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x62,
+	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x79, 0x6e, 0x65, 0x74,
+	0x2f, 0x62, 0x73, 0x6b, 0x79, 0x2d, 0x66, 0x75, 0x72, 0x72, 0x79, 0x2d, 0x66, 0x65, 0x65, 0x64,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x66,
+	0x66, 0x76, 0x31, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bff_v1_moderation_service_proto_rawDescOnce sync.Once
+	file_bff_v1_moderation_service_proto_rawDescData = file_bff_v1_moderation_service_proto_rawDesc
+)
+
+func file_bff_v1_moderation_service_proto_rawDescGZIP() []byte {
+	file_bff_v1_moderation_service_proto_rawDescOnce.Do(func() {
+		file_bff_v1_moderation_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_bff_v1_moderation_service_proto_rawDescData)
+	})
+	return file_bff_v1_moderation_service_proto_rawDescData
+}
+
+var file_bff_v1_moderation_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_bff_v1_moderation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
+var file_bff_v1_moderation_service_proto_goTypes = []interface{}{
+	(ApprovalQueueAction)(0),                      // 0: bff.v1.ApprovalQueueAction
+	(AuditEventType)(0),                           // 1: bff.v1.AuditEventType
+	(TaskState)(0),                                // 2: bff.v1.TaskState
+	(*Post)(nil),                                  // 3: bff.v1.Post
+	(*GetActorRequest)(nil),                       // 4: bff.v1.GetActorRequest
+	(*GetActorResponse)(nil),                      // 5: bff.v1.GetActorResponse
+	(*ActorHandle)(nil),                           // 6: bff.v1.ActorHandle
+	(*ListActorsRequest)(nil),                     // 7: bff.v1.ListActorsRequest
+	(*ListActorsResponse)(nil),                    // 8: bff.v1.ListActorsResponse
+	(*PingRequest)(nil),                           // 9: bff.v1.PingRequest
+	(*PingResponse)(nil),                          // 10: bff.v1.PingResponse
+	(*ProcessApprovalQueueRequest)(nil),           // 11: bff.v1.ProcessApprovalQueueRequest
+	(*ProcessApprovalQueueResponse)(nil),          // 12: bff.v1.ProcessApprovalQueueResponse
+	(*ProcessApprovalQueueAuditPayload)(nil),      // 13: bff.v1.ProcessApprovalQueueAuditPayload
+	(*HoldBackPendingActorRequest)(nil),           // 14: bff.v1.HoldBackPendingActorRequest
+	(*HoldBackPendingActorResponse)(nil),          // 15: bff.v1.HoldBackPendingActorResponse
+	(*HoldBackPendingActorAuditPayload)(nil),      // 16: bff.v1.HoldBackPendingActorAuditPayload
+	(*ListAuditEventsRequest)(nil),                // 17: bff.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),               // 18: bff.v1.ListAuditEventsResponse
+	(*CreateCommentAuditEventRequest)(nil),        // 19: bff.v1.CreateCommentAuditEventRequest
+	(*CreateCommentAuditEventResponse)(nil),       // 20: bff.v1.CreateCommentAuditEventResponse
+	(*CommentAuditPayload)(nil),                   // 21: bff.v1.CommentAuditPayload
+	(*CreateActorRequest)(nil),                    // 22: bff.v1.CreateActorRequest
+	(*CreateActorResponse)(nil),                   // 23: bff.v1.CreateActorResponse
+	(*CreateActorAuditPayload)(nil),               // 24: bff.v1.CreateActorAuditPayload
+	(*UnapproveActorRequest)(nil),                 // 25: bff.v1.UnapproveActorRequest
+	(*UnapproveActorResponse)(nil),                // 26: bff.v1.UnapproveActorResponse
+	(*UnapproveActorAuditPayload)(nil),            // 27: bff.v1.UnapproveActorAuditPayload
+	(*ForceApproveActorRequest)(nil),              // 28: bff.v1.ForceApproveActorRequest
+	(*ForceApproveActorResponse)(nil),             // 29: bff.v1.ForceApproveActorResponse
+	(*ForceApproveActorAuditPayload)(nil),         // 30: bff.v1.ForceApproveActorAuditPayload
+	(*BanActorRequest)(nil),                       // 31: bff.v1.BanActorRequest
+	(*BanActorResponse)(nil),                      // 32: bff.v1.BanActorResponse
+	(*BanActorAuditPayload)(nil),                  // 33: bff.v1.BanActorAuditPayload
+	(*PurgeActorRequest)(nil),                     // 34: bff.v1.PurgeActorRequest
+	(*PurgeActorResponse)(nil),                    // 35: bff.v1.PurgeActorResponse
+	(*ActorDataDeletedAuditPayload)(nil),          // 36: bff.v1.ActorDataDeletedAuditPayload
+	(*RestoreActorRequest)(nil),                   // 37: bff.v1.RestoreActorRequest
+	(*RestoreActorResponse)(nil),                  // 38: bff.v1.RestoreActorResponse
+	(*ActorDataRestoredAuditPayload)(nil),         // 39: bff.v1.ActorDataRestoredAuditPayload
+	(*ActorDataPurgedAuditPayload)(nil),           // 40: bff.v1.ActorDataPurgedAuditPayload
+	(*AuditEvent)(nil),                            // 41: bff.v1.AuditEvent
+	(*ListRolesRequest)(nil),                      // 42: bff.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),                     // 43: bff.v1.ListRolesResponse
+	(*Role)(nil),                                  // 44: bff.v1.Role
+	(*AssignRolesRequest)(nil),                    // 45: bff.v1.AssignRolesRequest
+	(*AssignRolesResponse)(nil),                   // 46: bff.v1.AssignRolesResponse
+	(*AssignRolesAuditPayload)(nil),               // 47: bff.v1.AssignRolesAuditPayload
+	(*SetActorNSFWOverrideRequest)(nil),           // 48: bff.v1.SetActorNSFWOverrideRequest
+	(*SetActorNSFWOverrideResponse)(nil),          // 49: bff.v1.SetActorNSFWOverrideResponse
+	(*SetActorNSFWOverrideAuditPayload)(nil),      // 50: bff.v1.SetActorNSFWOverrideAuditPayload
+	(*AccountStatusChangedAuditPayload)(nil),      // 51: bff.v1.AccountStatusChangedAuditPayload
+	(*HandleChangedAuditPayload)(nil),             // 52: bff.v1.HandleChangedAuditPayload
+	(*GetFollowReconciliationReportRequest)(nil),  // 53: bff.v1.GetFollowReconciliationReportRequest
+	(*GetFollowReconciliationReportResponse)(nil), // 54: bff.v1.GetFollowReconciliationReportResponse
+	(*Task)(nil),                                  // 55: bff.v1.Task
+	(*ListTasksRequest)(nil),                      // 56: bff.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                     // 57: bff.v1.ListTasksResponse
+	(*GetTaskRequest)(nil),                        // 58: bff.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),                       // 59: bff.v1.GetTaskResponse
+	(*RetryTaskRequest)(nil),                      // 60: bff.v1.RetryTaskRequest
+	(*RetryTaskResponse)(nil),                     // 61: bff.v1.RetryTaskResponse
+	(*CancelTaskRequest)(nil),                     // 62: bff.v1.CancelTaskRequest
+	(*CancelTaskResponse)(nil),                    // 63: bff.v1.CancelTaskResponse
+	(*RefreshActorProfileRequest)(nil),            // 64: bff.v1.RefreshActorProfileRequest
+	(*RefreshActorProfileResponse)(nil),           // 65: bff.v1.RefreshActorProfileResponse
+	(*DeadLetterEvent)(nil),                       // 66: bff.v1.DeadLetterEvent
+	(*ListDeadLetterEventsRequest)(nil),           // 67: bff.v1.ListDeadLetterEventsRequest
+	(*ListDeadLetterEventsResponse)(nil),          // 68: bff.v1.ListDeadLetterEventsResponse
+	(*GetDeadLetterEventRequest)(nil),             // 69: bff.v1.GetDeadLetterEventRequest
+	(*GetDeadLetterEventResponse)(nil),            // 70: bff.v1.GetDeadLetterEventResponse
+	(*ReplayDeadLetterEventRequest)(nil),          // 71: bff.v1.ReplayDeadLetterEventRequest
+	(*ReplayDeadLetterEventResponse)(nil),         // 72: bff.v1.ReplayDeadLetterEventResponse
+	(*DiscardDeadLetterEventRequest)(nil),         // 73: bff.v1.DiscardDeadLetterEventRequest
+	(*DiscardDeadLetterEventResponse)(nil),        // 74: bff.v1.DiscardDeadLetterEventResponse
+	(*ReciprocalLikers)(nil),                      // 75: bff.v1.ReciprocalLikers
+	(*DampenedPost)(nil),                          // 76: bff.v1.DampenedPost
+	(*ListScoringFlagsRequest)(nil),               // 77: bff.v1.ListScoringFlagsRequest
+	(*ListScoringFlagsResponse)(nil),              // 78: bff.v1.ListScoringFlagsResponse
+	(*ExplainPostRequest)(nil),                    // 79: bff.v1.ExplainPostRequest
+	(*PostFilterResult)(nil),                      // 80: bff.v1.PostFilterResult
+	(*PostScoreExplanation)(nil),                  // 81: bff.v1.PostScoreExplanation
+	(*ExplainPostResponse)(nil),                   // 82: bff.v1.ExplainPostResponse
+	nil,                                           // 83: bff.v1.ListRolesResponse.RolesEntry
+	(*timestamppb.Timestamp)(nil),                 // 84: google.protobuf.Timestamp
+	(*Actor)(nil),                                 // 85: bff.v1.Actor
+	(ActorStatus)(0),                              // 86: bff.v1.ActorStatus
+	(*durationpb.Duration)(nil),                   // 87: google.protobuf.Duration
+	(*anypb.Any)(nil),                             // 88: google.protobuf.Any
+	(ActorNSFWOverride)(0),                        // 89: bff.v1.ActorNSFWOverride
+}
+var file_bff_v1_moderation_service_proto_depIdxs = []int32{
+	84, // 0: bff.v1.Post.created_at:type_name -> google.protobuf.Timestamp
+	84, // 1: bff.v1.Post.indexed_at:type_name -> google.protobuf.Timestamp
+	85, // 2: bff.v1.GetActorResponse.actor:type_name -> bff.v1.Actor
+	6,  // 3: bff.v1.GetActorResponse.handle_history:type_name -> bff.v1.ActorHandle
+	84, // 4: bff.v1.ActorHandle.seen_at:type_name -> google.protobuf.Timestamp
+	86, // 5: bff.v1.ListActorsRequest.filter_status:type_name -> bff.v1.ActorStatus
+	85, // 6: bff.v1.ListActorsResponse.actors:type_name -> bff.v1.Actor
+	0,  // 7: bff.v1.ProcessApprovalQueueRequest.action:type_name -> bff.v1.ApprovalQueueAction
+	0,  // 8: bff.v1.ProcessApprovalQueueAuditPayload.action:type_name -> bff.v1.ApprovalQueueAction
+	87, // 9: bff.v1.HoldBackPendingActorRequest.duration:type_name -> google.protobuf.Duration
+	84, // 10: bff.v1.HoldBackPendingActorAuditPayload.held_until:type_name -> google.protobuf.Timestamp
+	1,  // 11: bff.v1.ListAuditEventsRequest.filter_types:type_name -> bff.v1.AuditEventType
+	41, // 12: bff.v1.ListAuditEventsResponse.audit_events:type_name -> bff.v1.AuditEvent
+	41, // 13: bff.v1.CreateCommentAuditEventResponse.audit_event:type_name -> bff.v1.AuditEvent
+	85, // 14: bff.v1.CreateActorResponse.actor:type_name -> bff.v1.Actor
+	85, // 15: bff.v1.UnapproveActorResponse.actor:type_name -> bff.v1.Actor
+	85, // 16: bff.v1.ForceApproveActorResponse.actor:type_name -> bff.v1.Actor
+	85, // 17: bff.v1.BanActorResponse.actor:type_name -> bff.v1.Actor
+	85, // 18: bff.v1.PurgeActorResponse.actor:type_name -> bff.v1.Actor
+	84, // 19: bff.v1.ActorDataDeletedAuditPayload.purge_at:type_name -> google.protobuf.Timestamp
+	85, // 20: bff.v1.RestoreActorResponse.actor:type_name -> bff.v1.Actor
+	84, // 21: bff.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	88, // 22: bff.v1.AuditEvent.payload:type_name -> google.protobuf.Any
+	83, // 23: bff.v1.ListRolesResponse.roles:type_name -> bff.v1.ListRolesResponse.RolesEntry
+	89, // 24: bff.v1.SetActorNSFWOverrideRequest.nsfw_override:type_name -> bff.v1.ActorNSFWOverride
+	85, // 25: bff.v1.SetActorNSFWOverrideResponse.actor:type_name -> bff.v1.Actor
+	89, // 26: bff.v1.SetActorNSFWOverrideAuditPayload.nsfw_override_before:type_name -> bff.v1.ActorNSFWOverride
+	89, // 27: bff.v1.SetActorNSFWOverrideAuditPayload.nsfw_override_after:type_name -> bff.v1.ActorNSFWOverride
+	2,  // 28: bff.v1.Task.state:type_name -> bff.v1.TaskState
+	84, // 29: bff.v1.Task.next_try_at:type_name -> google.protobuf.Timestamp
+	84, // 30: bff.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	84, // 31: bff.v1.Task.finished_at:type_name -> google.protobuf.Timestamp
+	2,  // 32: bff.v1.ListTasksRequest.filter_state:type_name -> bff.v1.TaskState
+	55, // 33: bff.v1.ListTasksResponse.tasks:type_name -> bff.v1.Task
+	55, // 34: bff.v1.GetTaskResponse.task:type_name -> bff.v1.Task
+	55, // 35: bff.v1.RetryTaskResponse.task:type_name -> bff.v1.Task
+	55, // 36: bff.v1.CancelTaskResponse.task:type_name -> bff.v1.Task
+	55, // 37: bff.v1.RefreshActorProfileResponse.task:type_name -> bff.v1.Task
+	84, // 38: bff.v1.DeadLetterEvent.created_at:type_name -> google.protobuf.Timestamp
+	84, // 39: bff.v1.DeadLetterEvent.last_attempt_at:type_name -> google.protobuf.Timestamp
+	84, // 40: bff.v1.DeadLetterEvent.next_attempt_at:type_name -> google.protobuf.Timestamp
+	66, // 41: bff.v1.ListDeadLetterEventsResponse.events:type_name -> bff.v1.DeadLetterEvent
+	66, // 42: bff.v1.GetDeadLetterEventResponse.event:type_name -> bff.v1.DeadLetterEvent
+	66, // 43: bff.v1.ReplayDeadLetterEventResponse.event:type_name -> bff.v1.DeadLetterEvent
+	75, // 44: bff.v1.ListScoringFlagsResponse.reciprocal_likers:type_name -> bff.v1.ReciprocalLikers
+	76, // 45: bff.v1.ListScoringFlagsResponse.dampened_posts:type_name -> bff.v1.DampenedPost
+	84, // 46: bff.v1.PostScoreExplanation.generated_at:type_name -> google.protobuf.Timestamp
+	80, // 47: bff.v1.ExplainPostResponse.filters:type_name -> bff.v1.PostFilterResult
+	81, // 48: bff.v1.ExplainPostResponse.score:type_name -> bff.v1.PostScoreExplanation
+	44, // 49: bff.v1.ListRolesResponse.RolesEntry.value:type_name -> bff.v1.Role
+	9,  // 50: bff.v1.ModerationService.Ping:input_type -> bff.v1.PingRequest
+	11, // 51: bff.v1.ModerationService.ProcessApprovalQueue:input_type -> bff.v1.ProcessApprovalQueueRequest
+	14, // 52: bff.v1.ModerationService.HoldBackPendingActor:input_type -> bff.v1.HoldBackPendingActorRequest
+	7,  // 53: bff.v1.ModerationService.ListActors:input_type -> bff.v1.ListActorsRequest
+	4,  // 54: bff.v1.ModerationService.GetActor:input_type -> bff.v1.GetActorRequest
+	31, // 55: bff.v1.ModerationService.BanActor:input_type -> bff.v1.BanActorRequest
+	25, // 56: bff.v1.ModerationService.UnapproveActor:input_type -> bff.v1.UnapproveActorRequest
+	28, // 57: bff.v1.ModerationService.ForceApproveActor:input_type -> bff.v1.ForceApproveActorRequest
+	22, // 58: bff.v1.ModerationService.CreateActor:input_type -> bff.v1.CreateActorRequest
+	34, // 59: bff.v1.ModerationService.PurgeActor:input_type -> bff.v1.PurgeActorRequest
+	37, // 60: bff.v1.ModerationService.RestoreActor:input_type -> bff.v1.RestoreActorRequest
+	17, // 61: bff.v1.ModerationService.ListAuditEvents:input_type -> bff.v1.ListAuditEventsRequest
+	19, // 62: bff.v1.ModerationService.CreateCommentAuditEvent:input_type -> bff.v1.CreateCommentAuditEventRequest
+	42, // 63: bff.v1.ModerationService.ListRoles:input_type -> bff.v1.ListRolesRequest
+	45, // 64: bff.v1.ModerationService.AssignRoles:input_type -> bff.v1.AssignRolesRequest
+	48, // 65: bff.v1.ModerationService.SetActorNSFWOverride:input_type -> bff.v1.SetActorNSFWOverrideRequest
+	53, // 66: bff.v1.ModerationService.GetFollowReconciliationReport:input_type -> bff.v1.GetFollowReconciliationReportRequest
+	56, // 67: bff.v1.ModerationService.ListTasks:input_type -> bff.v1.ListTasksRequest
+	58, // 68: bff.v1.ModerationService.GetTask:input_type -> bff.v1.GetTaskRequest
+	60, // 69: bff.v1.ModerationService.RetryTask:input_type -> bff.v1.RetryTaskRequest
+	62, // 70: bff.v1.ModerationService.CancelTask:input_type -> bff.v1.CancelTaskRequest
+	64, // 71: bff.v1.ModerationService.RefreshActorProfile:input_type -> bff.v1.RefreshActorProfileRequest
+	67, // 72: bff.v1.ModerationService.ListDeadLetterEvents:input_type -> bff.v1.ListDeadLetterEventsRequest
+	69, // 73: bff.v1.ModerationService.GetDeadLetterEvent:input_type -> bff.v1.GetDeadLetterEventRequest
+	71, // 74: bff.v1.ModerationService.ReplayDeadLetterEvent:input_type -> bff.v1.ReplayDeadLetterEventRequest
+	73, // 75: bff.v1.ModerationService.DiscardDeadLetterEvent:input_type -> bff.v1.DiscardDeadLetterEventRequest
+	77, // 76: bff.v1.ModerationService.ListScoringFlags:input_type -> bff.v1.ListScoringFlagsRequest
+	79, // 77: bff.v1.ModerationService.ExplainPost:input_type -> bff.v1.ExplainPostRequest
+	10, // 78: bff.v1.ModerationService.Ping:output_type -> bff.v1.PingResponse
+	12, // 79: bff.v1.ModerationService.ProcessApprovalQueue:output_type -> bff.v1.ProcessApprovalQueueResponse
+	15, // 80: bff.v1.ModerationService.HoldBackPendingActor:output_type -> bff.v1.HoldBackPendingActorResponse
+	8,  // 81: bff.v1.ModerationService.ListActors:output_type -> bff.v1.ListActorsResponse
+	5,  // 82: bff.v1.ModerationService.GetActor:output_type -> bff.v1.GetActorResponse
+	32, // 83: bff.v1.ModerationService.BanActor:output_type -> bff.v1.BanActorResponse
+	26, // 84: bff.v1.ModerationService.UnapproveActor:output_type -> bff.v1.UnapproveActorResponse
+	29, // 85: bff.v1.ModerationService.ForceApproveActor:output_type -> bff.v1.ForceApproveActorResponse
+	23, // 86: bff.v1.ModerationService.CreateActor:output_type -> bff.v1.CreateActorResponse
+	35, // 87: bff.v1.ModerationService.PurgeActor:output_type -> bff.v1.PurgeActorResponse
+	38, // 88: bff.v1.ModerationService.RestoreActor:output_type -> bff.v1.RestoreActorResponse
+	18, // 89: bff.v1.ModerationService.ListAuditEvents:output_type -> bff.v1.ListAuditEventsResponse
+	20, // 90: bff.v1.ModerationService.CreateCommentAuditEvent:output_type -> bff.v1.CreateCommentAuditEventResponse
+	43, // 91: bff.v1.ModerationService.ListRoles:output_type -> bff.v1.ListRolesResponse
+	46, // 92: bff.v1.ModerationService.AssignRoles:output_type -> bff.v1.AssignRolesResponse
+	49, // 93: bff.v1.ModerationService.SetActorNSFWOverride:output_type -> bff.v1.SetActorNSFWOverrideResponse
+	54, // 94: bff.v1.ModerationService.GetFollowReconciliationReport:output_type -> bff.v1.GetFollowReconciliationReportResponse
+	57, // 95: bff.v1.ModerationService.ListTasks:output_type -> bff.v1.ListTasksResponse
+	59, // 96: bff.v1.ModerationService.GetTask:output_type -> bff.v1.GetTaskResponse
+	61, // 97: bff.v1.ModerationService.RetryTask:output_type -> bff.v1.RetryTaskResponse
+	63, // 98: bff.v1.ModerationService.CancelTask:output_type -> bff.v1.CancelTaskResponse
+	65, // 99: bff.v1.ModerationService.RefreshActorProfile:output_type -> bff.v1.RefreshActorProfileResponse
+	68, // 100: bff.v1.ModerationService.ListDeadLetterEvents:output_type -> bff.v1.ListDeadLetterEventsResponse
+	70, // 101: bff.v1.ModerationService.GetDeadLetterEvent:output_type -> bff.v1.GetDeadLetterEventResponse
+	72, // 102: bff.v1.ModerationService.ReplayDeadLetterEvent:output_type -> bff.v1.ReplayDeadLetterEventResponse
+	74, // 103: bff.v1.ModerationService.DiscardDeadLetterEvent:output_type -> bff.v1.DiscardDeadLetterEventResponse
+	78, // 104: bff.v1.ModerationService.ListScoringFlags:output_type -> bff.v1.ListScoringFlagsResponse
+	82, // 105: bff.v1.ModerationService.ExplainPost:output_type -> bff.v1.ExplainPostResponse
+	78, // [78:106] is the sub-list for method output_type
+	50, // [50:78] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
+}
+
+func init() { file_bff_v1_moderation_service_proto_init() }
+func file_bff_v1_moderation_service_proto_init() {
+	if File_bff_v1_moderation_service_proto != nil {
+		return
+	}
+	file_bff_v1_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_bff_v1_moderation_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Post); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActorHandle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListActorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListActorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessApprovalQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessApprovalQueueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessApprovalQueueAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoldBackPendingActorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoldBackPendingActorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoldBackPendingActorAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommentAuditEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommentAuditEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateActorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateActorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateActorAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnapproveActorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnapproveActorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnapproveActorAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceApproveActorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceApproveActorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceApproveActorAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanActorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanActorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanActorAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeActorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeActorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActorDataDeletedAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreActorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreActorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActorDataRestoredAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActorDataPurgedAuditPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRolesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AssignRolesAuditPayload.ProtoReflect.Descriptor instead.
-func (*AssignRolesAuditPayload) Descriptor() ([]byte, []int) {
-	return file_bff_v1_moderation_service_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *AssignRolesAuditPayload) GetRolesBefore() []string {
-	if x != nil {
-		return x.RolesBefore
-	}
-	return nil
-}
-
-func (x *AssignRolesAuditPayload) GetRolesAfter() []string {
-	if x != nil {
-		return x.RolesAfter
-	}
-	return nil
-}
-
-var File_bff_v1_moderation_service_proto protoreflect.FileDescriptor
-
-var file_bff_v1_moderation_service_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x62, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x06, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x62, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x04, 0x50, 0x6f,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x22, 0x23, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x22,
-	0x37, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x7b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
-	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x0d, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66,
-	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x1b, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x66,
-	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1e, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x75, 0x64,
-	0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x66, 0x66, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x1b, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x61,
-	0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1e,
-	0x0a, 0x1c, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d,
-	0x0a, 0x20, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x68, 0x65, 0x6c, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x94, 0x02,
-	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x44,
-	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x64,
-	0x12, 0x39, 0x0a, 0x19, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x16, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x55, 0x72, 0x69, 0x12, 0x39, 0x0a, 0x0c, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x22, 0x68, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x35, 0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x89,
-	0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44,
-	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x55, 0x72, 0x69,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x1f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
-	0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64,
-	0x69, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3a,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
-	0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x17, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4c, 0x0a,
-	0x15, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
-	0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x44, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x16, 0x55,
-	0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
-	0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x34, 0x0a, 0x1a, 0x55, 0x6e,
-	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69,
-	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x22, 0x4f, 0x0a, 0x18, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x22, 0x40, 0x0a, 0x19, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
-	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x22, 0x37, 0x0a, 0x1d, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x0f,
-	0x42, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x10, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2e, 0x0a,
-	0x14, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf3, 0x01,
-	0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x64, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x44, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x64, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x44, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x55, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
-	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62,
-	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x46, 0x0a, 0x0a, 0x52, 0x6f, 0x6c,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x28, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x47, 0x0a, 0x12, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72,
-	0x6f, 0x6c, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x17, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x5f,
-	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6c,
-	0x65, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x2a, 0x81, 0x01, 0x0a, 0x13, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x51,
-	0x55, 0x45, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x50, 0x50,
-	0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c,
-	0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x41,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x2a, 0x99,
-	0x01, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0c,
-	0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
-	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x45,
-	0x4c, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x52,
-	0x43, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a,
-	0x0a, 0x55, 0x4e, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0b, 0x0a,
-	0x07, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41,
-	0x4e, 0x4e, 0x45, 0x44, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
-	0x45, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x53, 0x10, 0x08, 0x32, 0xa6, 0x08, 0x0a, 0x11, 0x4d,
-	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x33, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x23, 0x2e,
-	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x48, 0x6f,
-	0x6c, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x23, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64,
-	0x42, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x19, 0x2e,
-	0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x17, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x66,
-	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x42, 0x61, 0x6e, 0x41, 0x63,
-	0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e,
-	0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62,
-	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x6e, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x62, 0x66, 0x66,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x66, 0x66, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x46,
-	0x6f, 0x72, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72,
-	0x12, 0x20, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63,
-	0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x54, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x26, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x66, 0x66,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c,
-	0x65, 0x73, 0x12, 0x18, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62,
-	0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x79, 0x6e, 0x65, 0x74, 0x2f, 0x62, 0x73, 0x6b,
-	0x79, 0x2d, 0x66, 0x75, 0x72, 0x72, 0x79, 0x2d, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x62, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x66, 0x66, 0x76, 0x31, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_bff_v1_moderation_service_proto_rawDescOnce sync.Once
-	file_bff_v1_moderation_service_proto_rawDescData = file_bff_v1_moderation_service_proto_rawDesc
-)
-
-func file_bff_v1_moderation_service_proto_rawDescGZIP() []byte {
-	file_bff_v1_moderation_service_proto_rawDescOnce.Do(func() {
-		file_bff_v1_moderation_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_bff_v1_moderation_service_proto_rawDescData)
-	})
-	return file_bff_v1_moderation_service_proto_rawDescData
-}
-
-var file_bff_v1_moderation_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_bff_v1_moderation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
-var file_bff_v1_moderation_service_proto_goTypes = []interface{}{
-	(ApprovalQueueAction)(0),                 // 0: bff.v1.ApprovalQueueAction
-	(AuditEventType)(0),                      // 1: bff.v1.AuditEventType
-	(*Post)(nil),                             // 2: bff.v1.Post
-	(*GetActorRequest)(nil),                  // 3: bff.v1.GetActorRequest
-	(*GetActorResponse)(nil),                 // 4: bff.v1.GetActorResponse
-	(*ListActorsRequest)(nil),                // 5: bff.v1.ListActorsRequest
-	(*ListActorsResponse)(nil),               // 6: bff.v1.ListActorsResponse
-	(*PingRequest)(nil),                      // 7: bff.v1.PingRequest
-	(*PingResponse)(nil),                     // 8: bff.v1.PingResponse
-	(*ProcessApprovalQueueRequest)(nil),      // 9: bff.v1.ProcessApprovalQueueRequest
-	(*ProcessApprovalQueueResponse)(nil),     // 10: bff.v1.ProcessApprovalQueueResponse
-	(*ProcessApprovalQueueAuditPayload)(nil), // 11: bff.v1.ProcessApprovalQueueAuditPayload
-	(*HoldBackPendingActorRequest)(nil),      // 12: bff.v1.HoldBackPendingActorRequest
-	(*HoldBackPendingActorResponse)(nil),     // 13: bff.v1.HoldBackPendingActorResponse
-	(*HoldBackPendingActorAuditPayload)(nil), // 14: bff.v1.HoldBackPendingActorAuditPayload
-	(*ListAuditEventsRequest)(nil),           // 15: bff.v1.ListAuditEventsRequest
-	(*ListAuditEventsResponse)(nil),          // 16: bff.v1.ListAuditEventsResponse
-	(*CreateCommentAuditEventRequest)(nil),   // 17: bff.v1.CreateCommentAuditEventRequest
-	(*CreateCommentAuditEventResponse)(nil),  // 18: bff.v1.CreateCommentAuditEventResponse
-	(*CommentAuditPayload)(nil),              // 19: bff.v1.CommentAuditPayload
-	(*CreateActorRequest)(nil),               // 20: bff.v1.CreateActorRequest
-	(*CreateActorResponse)(nil),              // 21: bff.v1.CreateActorResponse
-	(*CreateActorAuditPayload)(nil),          // 22: bff.v1.CreateActorAuditPayload
-	(*UnapproveActorRequest)(nil),            // 23: bff.v1.UnapproveActorRequest
-	(*UnapproveActorResponse)(nil),           // 24: bff.v1.UnapproveActorResponse
-	(*UnapproveActorAuditPayload)(nil),       // 25: bff.v1.UnapproveActorAuditPayload
-	(*ForceApproveActorRequest)(nil),         // 26: bff.v1.ForceApproveActorRequest
-	(*ForceApproveActorResponse)(nil),        // 27: bff.v1.ForceApproveActorResponse
-	(*ForceApproveActorAuditPayload)(nil),    // 28: bff.v1.ForceApproveActorAuditPayload
-	(*BanActorRequest)(nil),                  // 29: bff.v1.BanActorRequest
-	(*BanActorResponse)(nil),                 // 30: bff.v1.BanActorResponse
-	(*BanActorAuditPayload)(nil),             // 31: bff.v1.BanActorAuditPayload
-	(*AuditEvent)(nil),                       // 32: bff.v1.AuditEvent
-	(*ListRolesRequest)(nil),                 // 33: bff.v1.ListRolesRequest
-	(*ListRolesResponse)(nil),                // 34: bff.v1.ListRolesResponse
-	(*Role)(nil),                             // 35: bff.v1.Role
-	(*AssignRolesRequest)(nil),               // 36: bff.v1.AssignRolesRequest
-	(*AssignRolesResponse)(nil),              // 37: bff.v1.AssignRolesResponse
-	(*AssignRolesAuditPayload)(nil),          // 38: bff.v1.AssignRolesAuditPayload
-	nil,                                      // 39: bff.v1.ListRolesResponse.RolesEntry
-	(*timestamppb.Timestamp)(nil),            // 40: google.protobuf.Timestamp
-	(*Actor)(nil),                            // 41: bff.v1.Actor
-	(ActorStatus)(0),                         // 42: bff.v1.ActorStatus
-	(*durationpb.Duration)(nil),              // 43: google.protobuf.Duration
-	(*anypb.Any)(nil),                        // 44: google.protobuf.Any
-}
-var file_bff_v1_moderation_service_proto_depIdxs = []int32{
-	40, // 0: bff.v1.Post.created_at:type_name -> google.protobuf.Timestamp
-	40, // 1: bff.v1.Post.indexed_at:type_name -> google.protobuf.Timestamp
-	41, // 2: bff.v1.GetActorResponse.actor:type_name -> bff.v1.Actor
-	42, // 3: bff.v1.ListActorsRequest.filter_status:type_name -> bff.v1.ActorStatus
-	41, // 4: bff.v1.ListActorsResponse.actors:type_name -> bff.v1.Actor
-	0,  // 5: bff.v1.ProcessApprovalQueueRequest.action:type_name -> bff.v1.ApprovalQueueAction
-	0,  // 6: bff.v1.ProcessApprovalQueueAuditPayload.action:type_name -> bff.v1.ApprovalQueueAction
-	43, // 7: bff.v1.HoldBackPendingActorRequest.duration:type_name -> google.protobuf.Duration
-	40, // 8: bff.v1.HoldBackPendingActorAuditPayload.held_until:type_name -> google.protobuf.Timestamp
-	1,  // 9: bff.v1.ListAuditEventsRequest.filter_types:type_name -> bff.v1.AuditEventType
-	32, // 10: bff.v1.ListAuditEventsResponse.audit_events:type_name -> bff.v1.AuditEvent
-	32, // 11: bff.v1.CreateCommentAuditEventResponse.audit_event:type_name -> bff.v1.AuditEvent
-	41, // 12: bff.v1.CreateActorResponse.actor:type_name -> bff.v1.Actor
-	41, // 13: bff.v1.UnapproveActorResponse.actor:type_name -> bff.v1.Actor
-	41, // 14: bff.v1.ForceApproveActorResponse.actor:type_name -> bff.v1.Actor
-	41, // 15: bff.v1.BanActorResponse.actor:type_name -> bff.v1.Actor
-	40, // 16: bff.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
-	44, // 17: bff.v1.AuditEvent.payload:type_name -> google.protobuf.Any
-	39, // 18: bff.v1.ListRolesResponse.roles:type_name -> bff.v1.ListRolesResponse.RolesEntry
-	35, // 19: bff.v1.ListRolesResponse.RolesEntry.value:type_name -> bff.v1.Role
-	7,  // 20: bff.v1.ModerationService.Ping:input_type -> bff.v1.PingRequest
-	9,  // 21: bff.v1.ModerationService.ProcessApprovalQueue:input_type -> bff.v1.ProcessApprovalQueueRequest
-	12, // 22: bff.v1.ModerationService.HoldBackPendingActor:input_type -> bff.v1.HoldBackPendingActorRequest
-	5,  // 23: bff.v1.ModerationService.ListActors:input_type -> bff.v1.ListActorsRequest
-	3,  // 24: bff.v1.ModerationService.GetActor:input_type -> bff.v1.GetActorRequest
-	29, // 25: bff.v1.ModerationService.BanActor:input_type -> bff.v1.BanActorRequest
-	23, // 26: bff.v1.ModerationService.UnapproveActor:input_type -> bff.v1.UnapproveActorRequest
-	26, // 27: bff.v1.ModerationService.ForceApproveActor:input_type -> bff.v1.ForceApproveActorRequest
-	20, // 28: bff.v1.ModerationService.CreateActor:input_type -> bff.v1.CreateActorRequest
-	15, // 29: bff.v1.ModerationService.ListAuditEvents:input_type -> bff.v1.ListAuditEventsRequest
-	17, // 30: bff.v1.ModerationService.CreateCommentAuditEvent:input_type -> bff.v1.CreateCommentAuditEventRequest
-	33, // 31: bff.v1.ModerationService.ListRoles:input_type -> bff.v1.ListRolesRequest
-	36, // 32: bff.v1.ModerationService.AssignRoles:input_type -> bff.v1.AssignRolesRequest
-	8,  // 33: bff.v1.ModerationService.Ping:output_type -> bff.v1.PingResponse
-	10, // 34: bff.v1.ModerationService.ProcessApprovalQueue:output_type -> bff.v1.ProcessApprovalQueueResponse
-	13, // 35: bff.v1.ModerationService.HoldBackPendingActor:output_type -> bff.v1.HoldBackPendingActorResponse
-	6,  // 36: bff.v1.ModerationService.ListActors:output_type -> bff.v1.ListActorsResponse
-	4,  // 37: bff.v1.ModerationService.GetActor:output_type -> bff.v1.GetActorResponse
-	30, // 38: bff.v1.ModerationService.BanActor:output_type -> bff.v1.BanActorResponse
-	24, // 39: bff.v1.ModerationService.UnapproveActor:output_type -> bff.v1.UnapproveActorResponse
-	27, // 40: bff.v1.ModerationService.ForceApproveActor:output_type -> bff.v1.ForceApproveActorResponse
-	21, // 41: bff.v1.ModerationService.CreateActor:output_type -> bff.v1.CreateActorResponse
-	16, // 42: bff.v1.ModerationService.ListAuditEvents:output_type -> bff.v1.ListAuditEventsResponse
-	18, // 43: bff.v1.ModerationService.CreateCommentAuditEvent:output_type -> bff.v1.CreateCommentAuditEventResponse
-	34, // 44: bff.v1.ModerationService.ListRoles:output_type -> bff.v1.ListRolesResponse
-	37, // 45: bff.v1.ModerationService.AssignRoles:output_type -> bff.v1.AssignRolesResponse
-	33, // [33:46] is the sub-list for method output_type
-	20, // [20:33] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
-}
-
-func init() { file_bff_v1_moderation_service_proto_init() }
-func file_bff_v1_moderation_service_proto_init() {
-	if File_bff_v1_moderation_service_proto != nil {
-		return
-	}
-	file_bff_v1_types_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_bff_v1_moderation_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Post); i {
+		file_bff_v1_moderation_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRolesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2501,8 +6084,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetActorRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Role); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2513,8 +6096,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetActorResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignRolesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2525,8 +6108,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListActorsRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignRolesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2537,8 +6120,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListActorsResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignRolesAuditPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2549,8 +6132,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetActorNSFWOverrideRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2561,8 +6144,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetActorNSFWOverrideResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2573,8 +6156,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessApprovalQueueRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetActorNSFWOverrideAuditPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2585,8 +6168,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessApprovalQueueResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountStatusChangedAuditPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2597,8 +6180,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessApprovalQueueAuditPayload); i {
+		file_bff_v1_moderation_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleChangedAuditPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2609,8 +6192,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HoldBackPendingActorRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowReconciliationReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2621,8 +6204,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HoldBackPendingActorResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowReconciliationReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2633,8 +6216,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HoldBackPendingActorAuditPayload); i {
+		file_bff_v1_moderation_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Task); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2645,8 +6228,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAuditEventsRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2657,8 +6240,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAuditEventsResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2669,8 +6252,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCommentAuditEventRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2681,8 +6264,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCommentAuditEventResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2693,8 +6276,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentAuditPayload); i {
+		file_bff_v1_moderation_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2705,8 +6288,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateActorRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryTaskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2717,8 +6300,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateActorResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2729,8 +6312,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateActorAuditPayload); i {
+		file_bff_v1_moderation_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTaskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2741,8 +6324,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnapproveActorRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshActorProfileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2753,8 +6336,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnapproveActorResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshActorProfileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2765,8 +6348,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnapproveActorAuditPayload); i {
+		file_bff_v1_moderation_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetterEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2777,8 +6360,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForceApproveActorRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLetterEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2789,8 +6372,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForceApproveActorResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLetterEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2801,8 +6384,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForceApproveActorAuditPayload); i {
+		file_bff_v1_moderation_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLetterEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2813,8 +6396,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BanActorRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLetterEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2825,8 +6408,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BanActorResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLetterEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2837,8 +6420,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BanActorAuditPayload); i {
+		file_bff_v1_moderation_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLetterEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2849,8 +6432,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuditEvent); i {
+		file_bff_v1_moderation_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardDeadLetterEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2861,8 +6444,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRolesRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardDeadLetterEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2873,8 +6456,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRolesResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReciprocalLikers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2885,8 +6468,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Role); i {
+		file_bff_v1_moderation_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DampenedPost); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2897,8 +6480,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignRolesRequest); i {
+		file_bff_v1_moderation_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScoringFlagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2909,8 +6492,8 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignRolesResponse); i {
+		file_bff_v1_moderation_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScoringFlagsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2921,8 +6504,44 @@ func file_bff_v1_moderation_service_proto_init() {
 				return nil
 			}
 		}
-		file_bff_v1_moderation_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignRolesAuditPayload); i {
+		file_bff_v1_moderation_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostFilterResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostScoreExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bff_v1_moderation_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2939,8 +6558,8 @@ func file_bff_v1_moderation_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bff_v1_moderation_service_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   38,
+			NumEnums:      3,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
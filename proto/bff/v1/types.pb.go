@@ -76,6 +76,60 @@ func (ActorStatus) EnumDescriptor() ([]byte, []int) {
 	return file_bff_v1_types_proto_rawDescGZIP(), []int{0}
 }
 
+// ActorNSFWOverride overrides whether an actor's posts are NSFW.
+type ActorNSFWOverride int32
+
+const (
+	// ACTOR_NSFW_OVERRIDE_UNSPECIFIED means the actor's posts are classified by
+	// the NSFW rules.
+	ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_UNSPECIFIED ActorNSFWOverride = 0
+	// ACTOR_NSFW_OVERRIDE_NSFW treats all of the actor's posts as NSFW.
+	ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_NSFW ActorNSFWOverride = 1
+	// ACTOR_NSFW_OVERRIDE_SFW treats none of the actor's posts as NSFW.
+	ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_SFW ActorNSFWOverride = 2
+)
+
+// Enum value maps for ActorNSFWOverride.
+var (
+	ActorNSFWOverride_name = map[int32]string{
+		0: "ACTOR_NSFW_OVERRIDE_UNSPECIFIED",
+		1: "ACTOR_NSFW_OVERRIDE_NSFW",
+		2: "ACTOR_NSFW_OVERRIDE_SFW",
+	}
+	ActorNSFWOverride_value = map[string]int32{
+		"ACTOR_NSFW_OVERRIDE_UNSPECIFIED": 0,
+		"ACTOR_NSFW_OVERRIDE_NSFW":        1,
+		"ACTOR_NSFW_OVERRIDE_SFW":         2,
+	}
+)
+
+func (x ActorNSFWOverride) Enum() *ActorNSFWOverride {
+	p := new(ActorNSFWOverride)
+	*p = x
+	return p
+}
+
+func (x ActorNSFWOverride) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActorNSFWOverride) Descriptor() protoreflect.EnumDescriptor {
+	return file_bff_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (ActorNSFWOverride) Type() protoreflect.EnumType {
+	return &file_bff_v1_types_proto_enumTypes[1]
+}
+
+func (x ActorNSFWOverride) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActorNSFWOverride.Descriptor instead.
+func (ActorNSFWOverride) EnumDescriptor() ([]byte, []int) {
+	return file_bff_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
 type Actor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -85,7 +139,7 @@ type Actor struct {
 	// for fetching and mutating actors.
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 	// is_artist is a flag indicating this account is primarily an artist. It
-	// does not currently control any feed placement.
+	// controls placement in the artist feeds.
 	IsArtist bool `protobuf:"varint,3,opt,name=is_artist,json=isArtist,proto3" json:"is_artist,omitempty"`
 	// comment is a short string that is applied to an account when it is added
 	// to the system. This will eventually be replaced by a more powerful system.
@@ -102,6 +156,24 @@ type Actor struct {
 	// is ignored in the queue to be processed later, e.g. when the actor doesn’t
 	// have an avatar
 	HeldUntil *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=held_until,json=heldUntil,proto3" json:"held_until,omitempty"`
+	// handle is the actor's handle as last seen on the network. It may be empty
+	// if the actor has not changed their handle since we started tracking them.
+	Handle string `protobuf:"bytes,9,opt,name=handle,proto3" json:"handle,omitempty"`
+	// account_status is set when the actor's account is inactive on the
+	// network, e.g. "deactivated" or "takendown". Posts by actors with inactive
+	// accounts are hidden from feeds.
+	AccountStatus string `protobuf:"bytes,10,opt,name=account_status,json=accountStatus,proto3" json:"account_status,omitempty"`
+	// deleted_at is set when the actor's data has been deleted, e.g. because
+	// their account was deleted. Their data is hidden, and is purged after a
+	// grace period.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// purged_at is set once the actor's data has been permanently deleted.
+	PurgedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=purged_at,json=purgedAt,proto3" json:"purged_at,omitempty"`
+	// approved_at is when the actor was most recently approved.
+	ApprovedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	// nsfw_override is set by moderators to override whether the actor's posts
+	// are NSFW.
+	NsfwOverride ActorNSFWOverride `protobuf:"varint,14,opt,name=nsfw_override,json=nsfwOverride,proto3,enum=bff.v1.ActorNSFWOverride" json:"nsfw_override,omitempty"`
 }
 
 func (x *Actor) Reset() {
@@ -185,13 +257,55 @@ func (x *Actor) GetHeldUntil() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Actor) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *Actor) GetAccountStatus() string {
+	if x != nil {
+		return x.AccountStatus
+	}
+	return ""
+}
+
+func (x *Actor) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Actor) GetPurgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgedAt
+	}
+	return nil
+}
+
+func (x *Actor) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *Actor) GetNsfwOverride() ActorNSFWOverride {
+	if x != nil {
+		return x.NsfwOverride
+	}
+	return ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_UNSPECIFIED
+}
+
 var File_bff_v1_types_proto protoreflect.FileDescriptor
 
 var file_bff_v1_types_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x62, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x02,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x04,
 	0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
 	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
@@ -208,7 +322,26 @@ var file_bff_v1_types_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x68, 0x65, 0x6c, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x2a,
+	0x68, 0x65, 0x6c, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x6e, 0x73, 0x66,
+	0x77, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x62, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e,
+	0x53, 0x46, 0x57, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0c, 0x6e, 0x73, 0x66,
+	0x77, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x2a,
 	0x90, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
 	0x1c, 0x0a, 0x18, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
 	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
@@ -218,11 +351,18 @@ var file_bff_v1_types_proto_rawDesc = []byte{
 	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
 	0x55, 0x53, 0x5f, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x41,
 	0x43, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
-	0x10, 0x04, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x79, 0x6e, 0x65, 0x74, 0x2f, 0x62, 0x73, 0x6b, 0x79,
-	0x2d, 0x66, 0x75, 0x72, 0x72, 0x79, 0x2d, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x62, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x66, 0x66, 0x76, 0x31, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x10, 0x04, 0x2a, 0x73, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x53, 0x46, 0x57, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x43, 0x54, 0x4f, 0x52,
+	0x5f, 0x4e, 0x53, 0x46, 0x57, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x53, 0x46, 0x57, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52,
+	0x49, 0x44, 0x45, 0x5f, 0x4e, 0x53, 0x46, 0x57, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43,
+	0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x53, 0x46, 0x57, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44,
+	0x45, 0x5f, 0x53, 0x46, 0x57, 0x10, 0x02, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x79, 0x6e, 0x65, 0x74,
+	0x2f, 0x62, 0x73, 0x6b, 0x79, 0x2d, 0x66, 0x75, 0x72, 0x72, 0x79, 0x2d, 0x66, 0x65, 0x65, 0x64,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x66,
+	0x66, 0x76, 0x31, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -237,22 +377,27 @@ func file_bff_v1_types_proto_rawDescGZIP() []byte {
 	return file_bff_v1_types_proto_rawDescData
 }
 
-var file_bff_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_bff_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_bff_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_bff_v1_types_proto_goTypes = []interface{}{
 	(ActorStatus)(0),              // 0: bff.v1.ActorStatus
-	(*Actor)(nil),                 // 1: bff.v1.Actor
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(ActorNSFWOverride)(0),        // 1: bff.v1.ActorNSFWOverride
+	(*Actor)(nil),                 // 2: bff.v1.Actor
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
 }
 var file_bff_v1_types_proto_depIdxs = []int32{
 	0, // 0: bff.v1.Actor.status:type_name -> bff.v1.ActorStatus
-	2, // 1: bff.v1.Actor.created_at:type_name -> google.protobuf.Timestamp
-	2, // 2: bff.v1.Actor.held_until:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 1: bff.v1.Actor.created_at:type_name -> google.protobuf.Timestamp
+	3, // 2: bff.v1.Actor.held_until:type_name -> google.protobuf.Timestamp
+	3, // 3: bff.v1.Actor.deleted_at:type_name -> google.protobuf.Timestamp
+	3, // 4: bff.v1.Actor.purged_at:type_name -> google.protobuf.Timestamp
+	3, // 5: bff.v1.Actor.approved_at:type_name -> google.protobuf.Timestamp
+	1, // 6: bff.v1.Actor.nsfw_override:type_name -> bff.v1.ActorNSFWOverride
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_bff_v1_types_proto_init() }
@@ -279,7 +424,7 @@ func file_bff_v1_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bff_v1_types_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
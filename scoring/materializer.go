@@ -2,6 +2,7 @@ package scoring
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -10,37 +11,224 @@ import (
 	"github.com/strideynet/bsky-furry-feed/store"
 )
 
+// DefaultDampeners are the like dampeners used by the "classic_dampened"
+// algorithm.
+var DefaultDampeners = store.LikeDampeners{
+	NewLikerPeriod:          7 * 24 * time.Hour,
+	NewLikerWeight:          0.25,
+	MaxLikesPerAuthorPerDay: 3,
+	ReciprocalPeriod:        7 * 24 * time.Hour,
+	ReciprocalMinLikes:      5,
+	ReciprocalWeight:        0.5,
+}
+
+// reciprocalLikersRefreshInterval is how often the pairs of reciprocal likers
+// flagged by DefaultDampeners are refreshed. Finding them scans a week of
+// likes, so it's done far less often than scores are materialized.
+const reciprocalLikersRefreshInterval = time.Hour
+
 type Materializer struct {
 	log   *slog.Logger
 	store *store.PGXStore
 	opts  Opts
+	algs  []namedAlgorithm
+	// lastMaterialized is when each algorithm last had a generation
+	// materialized by this replica.
+	lastMaterialized map[string]time.Time
+	// lastRefreshedReciprocalLikers is when this replica last refreshed the
+	// pairs of reciprocal likers.
+	lastRefreshedReciprocalLikers time.Time
 }
 
 type Opts struct {
+	// Algorithms are the names of the algorithms to materialize, usually
+	// those read by the registered feeds. Defaults to "classic".
+	Algorithms []string
+	// MaterializationInterval is how often algorithms are materialized,
+	// unless they have a longer interval of their own.
 	MaterializationInterval time.Duration
 	RetentionPeriod         time.Duration
 	LookbackPeriod          time.Duration
+	// MaxScoreDecay is how far, as a fraction, an incrementally rescored
+	// score may move before the post is rescored, when its likes haven't
+	// changed. Defaults to 0.05.
+	MaxScoreDecay float64
+	// ReplicaID identifies this replica in leader election. If set, scores
+	// are only materialized whilst this replica is the leader, so the
+	// materializer can be enabled on several replicas.
+	ReplicaID string
+}
+
+const defaultMaxScoreDecay = 0.05
+
+// algorithm is a scoring algorithm that the materializer can keep scores for.
+type algorithm struct {
+	// interval is how often a generation is materialized. It's raised to
+	// Opts.MaterializationInterval if shorter.
+	interval    time.Duration
+	materialize func(m *Materializer, ctx context.Context, after time.Time) (int64, error)
+}
+
+type namedAlgorithm struct {
+	algorithm
+	name string
+}
+
+var algorithms = map[string]algorithm{
+	// classic scores posts by their likes from candidate actors.
+	"classic": {
+		materialize: (*Materializer).rescoreClassic,
+	},
+	// network scores posts by their likes and reposts from anyone. No feed
+	// reads it yet, so it's only materialized if one is added.
+	"network": {
+		materialize: (*Materializer).rescoreNetwork,
+	},
+	// discovery scores image posts by how few likes they have for their
+	// age, to surface posts that haven't been seen much. Only posts more
+	// than an hour old are scored, so their scores change slowly.
+	"discovery": {
+		interval:    5 * time.Minute,
+		materialize: (*Materializer).rescoreDiscovery,
+	},
+	// classic_dampened scores posts in the same way as classic, but
+	// discounts likes which look like they're gaming the scores. Pre-scored
+	// feeds opt into it with their Dampened option.
+	"classic_dampened": {
+		materialize: func(m *Materializer, ctx context.Context, after time.Time) (int64, error) {
+			return m.store.MaterializeDampenedPostScores(ctx, "classic_dampened", after, DefaultDampeners)
+		},
+	},
 }
 
 func NewMaterializer(
 	log *slog.Logger, store *store.PGXStore, opts Opts,
-) *Materializer {
+) (*Materializer, error) {
+	names := opts.Algorithms
+	if len(names) == 0 {
+		names = []string{"classic"}
+	}
+	algs := make([]namedAlgorithm, 0, len(names))
+	for _, name := range names {
+		alg, ok := algorithms[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown scoring algorithm %q", name)
+		}
+		alg.interval = max(alg.interval, opts.MaterializationInterval)
+		algs = append(algs, namedAlgorithm{algorithm: alg, name: name})
+	}
+
 	return &Materializer{
-		log:   log,
-		store: store,
-		opts:  opts,
+		log:              log,
+		store:            store,
+		opts:             opts,
+		algs:             algs,
+		lastMaterialized: map[string]time.Time{},
+	}, nil
+}
+
+// due returns whether a generation of alg should be materialized at now.
+// Algorithms are checked each MaterializationInterval, so one which is due
+// within half an interval is materialized now rather than an interval late.
+func (m *Materializer) due(alg namedAlgorithm, now time.Time) bool {
+	last, ok := m.lastMaterialized[alg.name]
+	if !ok {
+		return true
 	}
+	return now.Sub(last) >= alg.interval-m.opts.MaterializationInterval/2
 }
 
 func (m *Materializer) materialize(ctx context.Context) error {
+	var errs []error
+	for _, alg := range m.algs {
+		now := time.Now()
+		if !m.due(alg, now) {
+			continue
+		}
+		seq, err := alg.materialize(m, ctx, now.Add(-m.opts.LookbackPeriod))
+		if err != nil {
+			// Carry on with the other algorithms, so one failing doesn't
+			// leave every feed stale.
+			errs = append(errs, fmt.Errorf("materializing %s scores: %w", alg.name, err))
+			continue
+		}
+		m.lastMaterialized[alg.name] = now
+		m.log.Info(
+			"materialized generation",
+			slog.String("alg", alg.name),
+			slog.Int64("seq", seq),
+			slog.Duration("duration", time.Since(now)),
+		)
+	}
+	return errors.Join(errs...)
+}
+
+// rescoreClassic only rescores posts whose scores have changed, rather than
+// every post in the lookback period.
+func (m *Materializer) rescoreClassic(ctx context.Context, after time.Time) (int64, error) {
+	res, err := m.store.RescoreClassicPostScores(ctx, after, m.maxScoreDecay())
+	if err != nil {
+		return 0, err
+	}
+	m.logRescore("classic", res)
+	return res.GenerationSeq, nil
+}
+
+// rescoreNetwork only rescores posts whose scores have changed, in the same
+// way as rescoreClassic.
+func (m *Materializer) rescoreNetwork(ctx context.Context, after time.Time) (int64, error) {
+	res, err := m.store.RescoreNetworkPostScores(ctx, after, m.maxScoreDecay())
+	if err != nil {
+		return 0, err
+	}
+	m.logRescore("network", res)
+	return res.GenerationSeq, nil
+}
+
+// rescoreDiscovery only rescores posts whose scores have changed, in the
+// same way as rescoreClassic.
+func (m *Materializer) rescoreDiscovery(ctx context.Context, after time.Time) (int64, error) {
+	res, err := m.store.RescoreDiscoveryPostScores(ctx, after, m.maxScoreDecay())
+	if err != nil {
+		return 0, err
+	}
+	m.logRescore("discovery", res)
+	return res.GenerationSeq, nil
+}
+
+func (m *Materializer) maxScoreDecay() float64 {
+	if m.opts.MaxScoreDecay == 0 {
+		return defaultMaxScoreDecay
+	}
+	return m.opts.MaxScoreDecay
+}
+
+func (m *Materializer) logRescore(alg string, res store.RescoreResult) {
+	m.log.Info(
+		"rescored posts",
+		slog.String("alg", alg),
+		slog.Int64("seq", res.GenerationSeq),
+		slog.Int64("rescored", res.Rescored),
+		slog.Int64("superseded", res.Superseded),
+	)
+}
+
+// refreshReciprocalLikers refreshes the pairs of reciprocal likers if they're
+// due, allowing the same slack as due. They're refreshed even if no
+// dampened algorithm is materialized, as moderators can list them.
+func (m *Materializer) refreshReciprocalLikers(ctx context.Context) error {
 	now := time.Now()
-	seq, err := m.store.MaterializeClassicPostScores(ctx, now.Add(-m.opts.LookbackPeriod))
+	if now.Sub(m.lastRefreshedReciprocalLikers) < reciprocalLikersRefreshInterval-m.opts.MaterializationInterval/2 {
+		return nil
+	}
+	n, err := m.store.RefreshReciprocalLikers(ctx, DefaultDampeners)
 	if err != nil {
 		return err
 	}
+	m.lastRefreshedReciprocalLikers = now
 	m.log.Info(
-		"materialized generation",
-		slog.Int64("seq", seq),
+		"refreshed reciprocal likers",
+		slog.Int64("n", n),
 		slog.Duration("duration", time.Since(now)),
 	)
 	return nil
@@ -52,13 +240,21 @@ func (m *Materializer) cleanup(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	m.log.Info("cleaned up old rows", slog.Int64("n", n))
+	generations, err := m.store.DeleteOldScoreGenerations(ctx, now.Add(-m.opts.RetentionPeriod))
+	if err != nil {
+		return err
+	}
+	m.log.Info("cleaned up old rows", slog.Int64("n", n), slog.Int64("generations", generations))
 	return nil
 }
 
 func (m *Materializer) step(ctx context.Context) error {
 	// NOTE: materalize and cleanup don't run a transaction together (they don't need to, since it's okay if we keep old materialized results around for too long).
 	// However, we should do the cleanup _after_ the materialization, in case the materialization fails and we converge on purging the entire table.
+	if err := m.refreshReciprocalLikers(ctx); err != nil {
+		// Dampened scores can carry on with the pairs from the last refresh.
+		m.log.Error("failed to refresh reciprocal likers", bfflog.Err(err))
+	}
 	if err := m.materialize(ctx); err != nil {
 		return fmt.Errorf("materializing: %w", err)
 	}
@@ -69,6 +265,16 @@ func (m *Materializer) step(ctx context.Context) error {
 }
 
 func (m *Materializer) Run(ctx context.Context) error {
+	if m.opts.ReplicaID == "" {
+		return m.run(ctx)
+	}
+	elector := store.NewLeaderElector(
+		m.log, m.store, "score_materializer", store.LeaderElectorOpts{Holder: m.opts.ReplicaID},
+	)
+	return elector.Run(ctx, m.run)
+}
+
+func (m *Materializer) run(ctx context.Context) error {
 	t := time.NewTicker(m.opts.MaterializationInterval)
 	for {
 		select {
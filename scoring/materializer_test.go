@@ -0,0 +1,345 @@
+package scoring
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bffv1pb "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+func TestNewMaterializer(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewMaterializer(slog.Default(), nil, Opts{MaterializationInterval: time.Minute})
+	require.NoError(t, err)
+	require.Len(t, m.algs, 1)
+	assert.Equal(t, "classic", m.algs[0].name)
+
+	_, err = NewMaterializer(slog.Default(), nil, Opts{Algorithms: []string{"classic", "unknown"}})
+	assert.ErrorContains(t, err, `unknown scoring algorithm "unknown"`)
+}
+
+func TestMaterializer_due(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewMaterializer(slog.Default(), nil, Opts{
+		Algorithms:              []string{"classic", "discovery"},
+		MaterializationInterval: time.Minute,
+	})
+	require.NoError(t, err)
+	classic, discovery := m.algs[0], m.algs[1]
+
+	// Everything is materialized the first time.
+	now := time.Now()
+	assert.True(t, m.due(classic, now))
+	assert.True(t, m.due(discovery, now))
+	m.lastMaterialized["classic"] = now
+	m.lastMaterialized["discovery"] = now
+
+	// Ticks arrive a little early or late, so each algorithm is due once
+	// it's within half a tick of its interval.
+	for _, tick := range []time.Duration{
+		time.Minute - time.Second, 2*time.Minute + time.Second, 4 * time.Minute,
+	} {
+		assert.True(t, m.due(classic, now.Add(tick)), tick)
+		assert.False(t, m.due(discovery, now.Add(tick)), tick)
+	}
+	assert.True(t, m.due(discovery, now.Add(5*time.Minute-time.Second)))
+}
+
+func TestLikeDampeners(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	const authorDID = "did:plc:author"
+	const fanDID = "did:plc:fan"
+	const friendDID = "did:plc:friend"
+	for _, did := range []string{authorDID, fanDID, friendDID} {
+		_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+			Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+			DID:    did,
+		})
+		require.NoError(t, err)
+	}
+
+	now := time.Now()
+	post := func(did string, n int) string {
+		uri := fmt.Sprintf("at://%s/app.bsky.feed.post/%d", did, n)
+		require.NoError(t, harness.Store.CreatePost(ctx, store.CreatePostOpts{
+			URI:       uri,
+			ActorDID:  did,
+			CreatedAt: now,
+			IndexedAt: now,
+		}))
+		return uri
+	}
+	like := func(did string, subjectURI string, n int) {
+		require.NoError(t, harness.Store.CreateLike(ctx, store.CreateLikeOpts{
+			URI:        fmt.Sprintf("at://%s/app.bsky.feed.like/%d", did, n),
+			ActorDID:   did,
+			SubjectURI: subjectURI,
+			CreatedAt:  now.Add(time.Duration(n) * time.Second),
+			IndexedAt:  now,
+		}))
+	}
+
+	// The fan likes four of the author's posts, but only three count.
+	var authorPosts []string
+	for i := range 4 {
+		uri := post(authorDID, i)
+		authorPosts = append(authorPosts, uri)
+		like(fanDID, uri, i)
+	}
+	// The author and their friend like two of each other's posts.
+	for i := range 2 {
+		like(friendDID, authorPosts[i], i)
+		like(authorDID, post(friendDID, i), i)
+	}
+
+	dampeners := store.LikeDampeners{
+		MaxLikesPerAuthorPerDay: 3,
+		ReciprocalPeriod:        time.Hour,
+		ReciprocalMinLikes:      2,
+		ReciprocalWeight:        0.5,
+	}
+	// Nothing is flagged until the pairs are refreshed.
+	likers, err := harness.Store.ListReciprocalLikers(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, likers)
+	flagged, err := harness.Store.RefreshReciprocalLikers(ctx, dampeners)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, flagged)
+	likers, err = harness.Store.ListReciprocalLikers(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []*bffv1pb.ReciprocalLikers{{
+		ActorDid:      authorDID,
+		PartnerDid:    friendDID,
+		LikesGiven:    2,
+		LikesReceived: 2,
+	}}, likers)
+
+	posts, err := harness.Store.ListDampenedPosts(ctx, now.Add(-time.Hour), dampeners, 10)
+	require.NoError(t, err)
+	weighted := map[string]float64{}
+	for _, p := range posts {
+		weighted[p.Uri] = p.WeightedLikes
+	}
+	assert.Equal(t, map[string]float64{
+		authorPosts[0]: 1.5,
+		authorPosts[1]: 1.5,
+		authorPosts[3]: 0,
+		"at://did:plc:friend/app.bsky.feed.post/0": 0.5,
+		"at://did:plc:friend/app.bsky.feed.post/1": 0.5,
+	}, weighted)
+
+	// Everyone was approved just now, so all their likes are new.
+	dampeners.NewLikerPeriod = time.Hour
+	dampeners.NewLikerWeight = 0.25
+	posts, err = harness.Store.ListDampenedPosts(ctx, now.Add(-time.Hour), dampeners, 10)
+	require.NoError(t, err)
+	assert.Len(t, posts, 6)
+
+	// The zero value dampens nothing.
+	posts, err = harness.Store.ListDampenedPosts(ctx, now.Add(-time.Hour), store.LikeDampeners{}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+
+	seq, err := harness.Store.MaterializeDampenedPostScores(ctx, "classic_dampened", now.Add(-time.Hour), DefaultDampeners)
+	require.NoError(t, err)
+	latest, err := harness.Store.GetLatestScoreGeneration(ctx, "classic_dampened")
+	require.NoError(t, err)
+	assert.Equal(t, seq, latest)
+}
+
+const benchmarkLookbackPeriod = 24 * time.Hour
+
+// seedBenchmarkDataset creates a database of posts spread across the lookback
+// period, and likes of them. It returns a function which adds another like,
+// and the URL of the database.
+func seedBenchmarkDataset(ctx context.Context, b *testing.B) (*store.PGXStore, func(), string) {
+	const (
+		actors = 200
+		posts  = 20000
+		likes  = 100000
+	)
+
+	dbURL := testenv.StartDatabase(ctx, b)
+	pgxStore, err := store.ConnectPGXStore(ctx, slog.Default(), &store.DirectConnector{URI: dbURL})
+	require.NoError(b, err)
+	b.Cleanup(pgxStore.Close)
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	now := time.Now()
+	dids := make([]string, 0, actors)
+	for i := range actors {
+		did := fmt.Sprintf("did:plc:actor%d", i)
+		dids = append(dids, did)
+		_, err := pgxStore.CreateActor(ctx, store.CreateActorOpts{
+			Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+			DID:    did,
+		})
+		require.NoError(b, err)
+	}
+	uris := make([]string, 0, posts)
+	for i := range posts {
+		did := dids[rng.IntN(actors)]
+		uri := fmt.Sprintf("at://%s/app.bsky.feed.post/%d", did, i)
+		uris = append(uris, uri)
+		indexedAt := now.Add(-time.Duration(rng.Int64N(int64(benchmarkLookbackPeriod))))
+		require.NoError(b, pgxStore.CreatePost(ctx, store.CreatePostOpts{
+			URI:       uri,
+			ActorDID:  did,
+			CreatedAt: indexedAt,
+			IndexedAt: indexedAt,
+		}))
+	}
+	nLikes := 0
+	like := func() {
+		did := dids[rng.IntN(actors)]
+		// Skew likes towards a small number of posts, as in reality.
+		subject := uris[int(float64(posts)*math.Pow(rng.Float64(), 3))]
+		require.NoError(b, pgxStore.CreateLike(ctx, store.CreateLikeOpts{
+			URI:        fmt.Sprintf("at://%s/app.bsky.feed.like/%d", did, nLikes),
+			ActorDID:   did,
+			SubjectURI: subject,
+			CreatedAt:  now,
+			IndexedAt:  now,
+		}))
+		nLikes++
+	}
+	for range likes {
+		like()
+	}
+	return pgxStore, like, dbURL
+}
+
+// BenchmarkMaterializer compares recomputing every classic score against
+// rescoring incrementally, with a few posts being liked between each
+// generation.
+func BenchmarkMaterializer(b *testing.B) {
+	if testing.Short() {
+		b.Skip("skipping integration benchmark")
+	}
+
+	const likesPerGen = 50
+
+	ctx := context.Background()
+	pgxStore, like, dbURL := seedBenchmarkDataset(ctx, b)
+	db, err := pgxpool.New(ctx, dbURL)
+	require.NoError(b, err)
+	b.Cleanup(db.Close)
+	now := time.Now()
+
+	algs := []struct {
+		name        string
+		materialize func(after time.Time) error
+	}{
+		{
+			name: "full",
+			materialize: func(after time.Time) error {
+				_, err := testenv.MaterializeFullClassicScores(ctx, db, after)
+				return err
+			},
+		},
+		{
+			name: "incremental",
+			materialize: func(after time.Time) error {
+				_, err := pgxStore.RescoreClassicPostScores(ctx, after, defaultMaxScoreDecay)
+				return err
+			},
+		},
+	}
+	for _, alg := range algs {
+		b.Run(alg.name, func(b *testing.B) {
+			require.NoError(b, alg.materialize(now.Add(-benchmarkLookbackPeriod)))
+			b.ResetTimer()
+			for range b.N {
+				b.StopTimer()
+				for range likesPerGen {
+					like()
+				}
+				b.StartTimer()
+				require.NoError(b, alg.materialize(now.Add(-benchmarkLookbackPeriod)))
+			}
+			b.StopTimer()
+			// Clean up between sub-benchmarks, so that the table doesn't
+			// grow from one to the next.
+			_, err := pgxStore.DeleteOldPostScores(ctx, time.Now().Add(time.Hour))
+			require.NoError(b, err)
+		})
+	}
+}
+
+// BenchmarkListScoredPosts lists pages of incrementally rescored classic
+// scores, from the latest generation and from one which has since been
+// superseded, as when a feed is paged through whilst a new generation is
+// materialized.
+func BenchmarkListScoredPosts(b *testing.B) {
+	if testing.Short() {
+		b.Skip("skipping integration benchmark")
+	}
+
+	const (
+		generations = 5
+		likesPerGen = 500
+		pages       = 5
+	)
+
+	ctx := context.Background()
+	pgxStore, like, _ := seedBenchmarkDataset(ctx, b)
+	after := time.Now().Add(-benchmarkLookbackPeriod)
+
+	var seqs []int64
+	for range generations {
+		for range likesPerGen {
+			like()
+		}
+		res, err := pgxStore.RescoreClassicPostScores(ctx, after, defaultMaxScoreDecay)
+		require.NoError(b, err)
+		seqs = append(seqs, res.GenerationSeq)
+	}
+
+	for _, bc := range []struct {
+		name string
+		seq  int64
+	}{
+		{name: "latest", seq: seqs[len(seqs)-1]},
+		{name: "superseded", seq: seqs[0]},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			for range b.N {
+				cursor := store.ListPostsForHotFeedCursor{
+					GenerationSeq: bc.seq,
+					AfterScore:    float32(math.Inf(1)),
+				}
+				for range pages {
+					posts, err := pgxStore.ListScoredPosts(ctx, store.ListPostsForHotFeedOpts{
+						Alg:    "classic",
+						Cursor: cursor,
+						Limit:  30,
+					})
+					require.NoError(b, err)
+					require.Len(b, posts, 30)
+					last := posts[len(posts)-1]
+					cursor.AfterScore, cursor.AfterURI = last.Score, last.URI
+				}
+			}
+		})
+	}
+}
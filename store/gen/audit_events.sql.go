@@ -110,6 +110,26 @@ WHERE
             'ASSIGNED_ROLES' = any($5)
             AND payload ->> '@type' = 'type.googleapis.com/bff.v1.AssignRolesAuditPayload'
         )
+        OR (
+            'ACCOUNT_STATUS_CHANGED' = any($5)
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.AccountStatusChangedAuditPayload'
+        )
+        OR (
+            'HANDLE_CHANGED' = any($5)
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.HandleChangedAuditPayload'
+        )
+        OR (
+            'ACTOR_DATA_DELETED' = any($5)
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.ActorDataDeletedAuditPayload'
+        )
+        OR (
+            'ACTOR_DATA_RESTORED' = any($5)
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.ActorDataRestoredAuditPayload'
+        )
+        OR (
+            'ACTOR_DATA_PURGED' = any($5)
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.ActorDataPurgedAuditPayload'
+        )
     )
 ORDER BY
     ae.created_at DESC
@@ -158,3 +178,51 @@ func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams
 	}
 	return items, nil
 }
+
+const listAuditEventsCreatedAfter = `-- name: ListAuditEventsCreatedAfter :many
+SELECT id, actor_did, subject_did, subject_record_uri, created_at, payload
+FROM
+    audit_events AS ae
+WHERE
+    (ae.created_at, ae.id) > (
+        $1::timestamptz, $2::text
+    )
+ORDER BY
+    ae.created_at ASC, ae.id ASC
+LIMIT $3
+`
+
+type ListAuditEventsCreatedAfterParams struct {
+	AfterCreatedAt pgtype.Timestamptz
+	AfterID        string
+	Limit          int32
+}
+
+// Lists audit events oldest first, starting after the given position, so
+// that consumers can process them in order.
+func (q *Queries) ListAuditEventsCreatedAfter(ctx context.Context, arg ListAuditEventsCreatedAfterParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEventsCreatedAfter, arg.AfterCreatedAt, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorDID,
+			&i.SubjectDid,
+			&i.SubjectRecordUri,
+			&i.CreatedAt,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -11,12 +11,23 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const clearCandidateActorProfile = `-- name: ClearCandidateActorProfile :exec
+UPDATE candidate_actors ca
+SET current_profile_commit_cid = NULL
+WHERE ca.did = $1
+`
+
+func (q *Queries) ClearCandidateActorProfile(ctx context.Context, did string) error {
+	_, err := q.db.Exec(ctx, clearCandidateActorProfile, did)
+	return err
+}
+
 const createCandidateActor = `-- name: CreateCandidateActor :one
 INSERT INTO
 candidate_actors (did, created_at, is_artist, comment, status, roles)
 VALUES
 ($1, $2, $3, $4, $5, $6)
-RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until
+RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
 `
 
 type CreateCandidateActorParams struct {
@@ -47,6 +58,13 @@ func (q *Queries) CreateCandidateActor(ctx context.Context, arg CreateCandidateA
 		&i.Roles,
 		&i.CurrentProfileCommitCid,
 		&i.HeldUntil,
+		&i.AccountActive,
+		&i.AccountStatus,
+		&i.Handle,
+		&i.DeletedAt,
+		&i.PurgedAt,
+		&i.ApprovedAt,
+		&i.NSFWOverride,
 	)
 	return i, err
 }
@@ -106,6 +124,21 @@ func (q *Queries) CreateLatestActorProfile(ctx context.Context, arg CreateLatest
 	return err
 }
 
+const deleteActorProfiles = `-- name: DeleteActorProfiles :execrows
+DELETE FROM actor_profiles
+WHERE actor_did = $1
+`
+
+// The actor's current profile must be cleared first, as it references one of
+// these.
+func (q *Queries) DeleteActorProfiles(ctx context.Context, actorDid string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActorProfiles, actorDid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getActorProfileHistory = `-- name: GetActorProfileHistory :many
 SELECT ap.actor_did, ap.commit_cid, ap.created_at, ap.indexed_at, ap.display_name, ap.description, ap.self_labels
 FROM
@@ -145,7 +178,7 @@ func (q *Queries) GetActorProfileHistory(ctx context.Context, actorDid string) (
 }
 
 const getCandidateActorByDID = `-- name: GetCandidateActorByDID :one
-SELECT did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until
+SELECT did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
 FROM
     candidate_actors
 WHERE
@@ -164,6 +197,13 @@ func (q *Queries) GetCandidateActorByDID(ctx context.Context, did string) (Candi
 		&i.Roles,
 		&i.CurrentProfileCommitCid,
 		&i.HeldUntil,
+		&i.AccountActive,
+		&i.AccountStatus,
+		&i.Handle,
+		&i.DeletedAt,
+		&i.PurgedAt,
+		&i.ApprovedAt,
+		&i.NSFWOverride,
 	)
 	return i, err
 }
@@ -216,7 +256,7 @@ func (q *Queries) HoldBackPendingActor(ctx context.Context, arg HoldBackPendingA
 }
 
 const listCandidateActors = `-- name: ListCandidateActors :many
-SELECT did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until
+SELECT did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
 FROM
     candidate_actors AS ca
 WHERE
@@ -246,6 +286,13 @@ func (q *Queries) ListCandidateActors(ctx context.Context, status NullActorStatu
 			&i.Roles,
 			&i.CurrentProfileCommitCid,
 			&i.HeldUntil,
+			&i.AccountActive,
+			&i.AccountStatus,
+			&i.Handle,
+			&i.DeletedAt,
+			&i.PurgedAt,
+			&i.ApprovedAt,
+			&i.NSFWOverride,
 		); err != nil {
 			return nil, err
 		}
@@ -258,7 +305,7 @@ func (q *Queries) ListCandidateActors(ctx context.Context, status NullActorStatu
 }
 
 const listCandidateActorsRequiringProfileBackfill = `-- name: ListCandidateActorsRequiringProfileBackfill :many
-SELECT did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until
+SELECT did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
 FROM
     candidate_actors AS ca
 WHERE
@@ -286,6 +333,13 @@ func (q *Queries) ListCandidateActorsRequiringProfileBackfill(ctx context.Contex
 			&i.Roles,
 			&i.CurrentProfileCommitCid,
 			&i.HeldUntil,
+			&i.AccountActive,
+			&i.AccountStatus,
+			&i.Handle,
+			&i.DeletedAt,
+			&i.PurgedAt,
+			&i.ApprovedAt,
+			&i.NSFWOverride,
 		); err != nil {
 			return nil, err
 		}
@@ -297,6 +351,114 @@ func (q *Queries) ListCandidateActorsRequiringProfileBackfill(ctx context.Contex
 	return items, nil
 }
 
+const purgeCandidateActor = `-- name: PurgeCandidateActor :one
+UPDATE candidate_actors ca
+SET
+    purged_at = NOW(),
+    current_profile_commit_cid = NULL,
+    handle = NULL
+WHERE
+    ca.did = $1
+    AND ca.deleted_at = $2
+    AND ca.purged_at IS NULL
+RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
+`
+
+type PurgeCandidateActorParams struct {
+	DID       string
+	DeletedAt pgtype.Timestamptz
+}
+
+// Marks the actor as purged and clears what identifies them, leaving a
+// tombstone. Only updates, and returns, the actor if their data was deleted at
+// deleted_at and has not been purged, so a purge scheduled before the actor
+// was restored does nothing.
+func (q *Queries) PurgeCandidateActor(ctx context.Context, arg PurgeCandidateActorParams) (CandidateActor, error) {
+	row := q.db.QueryRow(ctx, purgeCandidateActor, arg.DID, arg.DeletedAt)
+	var i CandidateActor
+	err := row.Scan(
+		&i.DID,
+		&i.CreatedAt,
+		&i.IsArtist,
+		&i.Comment,
+		&i.Status,
+		&i.Roles,
+		&i.CurrentProfileCommitCid,
+		&i.HeldUntil,
+		&i.AccountActive,
+		&i.AccountStatus,
+		&i.Handle,
+		&i.DeletedAt,
+		&i.PurgedAt,
+		&i.ApprovedAt,
+		&i.NSFWOverride,
+	)
+	return i, err
+}
+
+const restoreCandidateActor = `-- name: RestoreCandidateActor :one
+UPDATE candidate_actors ca
+SET deleted_at = NULL
+WHERE ca.did = $1 AND ca.deleted_at IS NOT NULL AND ca.purged_at IS NULL
+RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
+`
+
+// Only updates, and returns, the actor if their data is deleted but not yet
+// purged.
+func (q *Queries) RestoreCandidateActor(ctx context.Context, did string) (CandidateActor, error) {
+	row := q.db.QueryRow(ctx, restoreCandidateActor, did)
+	var i CandidateActor
+	err := row.Scan(
+		&i.DID,
+		&i.CreatedAt,
+		&i.IsArtist,
+		&i.Comment,
+		&i.Status,
+		&i.Roles,
+		&i.CurrentProfileCommitCid,
+		&i.HeldUntil,
+		&i.AccountActive,
+		&i.AccountStatus,
+		&i.Handle,
+		&i.DeletedAt,
+		&i.PurgedAt,
+		&i.ApprovedAt,
+		&i.NSFWOverride,
+	)
+	return i, err
+}
+
+const softDeleteCandidateActor = `-- name: SoftDeleteCandidateActor :one
+UPDATE candidate_actors ca
+SET deleted_at = NOW()
+WHERE ca.did = $1 AND ca.deleted_at IS NULL
+RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
+`
+
+// Only updates, and returns, the actor if their data isn't already deleted.
+func (q *Queries) SoftDeleteCandidateActor(ctx context.Context, did string) (CandidateActor, error) {
+	row := q.db.QueryRow(ctx, softDeleteCandidateActor, did)
+	var i CandidateActor
+	err := row.Scan(
+		&i.DID,
+		&i.CreatedAt,
+		&i.IsArtist,
+		&i.Comment,
+		&i.Status,
+		&i.Roles,
+		&i.CurrentProfileCommitCid,
+		&i.HeldUntil,
+		&i.AccountActive,
+		&i.AccountStatus,
+		&i.Handle,
+		&i.DeletedAt,
+		&i.PurgedAt,
+		&i.ApprovedAt,
+		&i.NSFWOverride,
+	)
+	return i, err
+}
+
 const updateCandidateActor = `-- name: UpdateCandidateActor :one
 UPDATE candidate_actors ca
 SET
@@ -306,7 +468,7 @@ SET
     roles = COALESCE($4, ca.roles)
 WHERE
     did = $5
-RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until
+RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
 `
 
 type UpdateCandidateActorParams struct {
@@ -335,6 +497,127 @@ func (q *Queries) UpdateCandidateActor(ctx context.Context, arg UpdateCandidateA
 		&i.Roles,
 		&i.CurrentProfileCommitCid,
 		&i.HeldUntil,
+		&i.AccountActive,
+		&i.AccountStatus,
+		&i.Handle,
+		&i.DeletedAt,
+		&i.PurgedAt,
+		&i.ApprovedAt,
+		&i.NSFWOverride,
+	)
+	return i, err
+}
+
+const updateCandidateActorAccountStatus = `-- name: UpdateCandidateActorAccountStatus :one
+UPDATE candidate_actors ca
+SET
+    account_active = $1,
+    account_status = $2
+WHERE
+    ca.did = $3
+    AND (
+        ca.account_active != $1
+        OR ca.account_status IS DISTINCT FROM $2
+    )
+RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
+`
+
+type UpdateCandidateActorAccountStatusParams struct {
+	AccountActive bool
+	AccountStatus pgtype.Text
+	DID           string
+}
+
+// Only updates, and returns, the actor if their account status has changed.
+func (q *Queries) UpdateCandidateActorAccountStatus(ctx context.Context, arg UpdateCandidateActorAccountStatusParams) (CandidateActor, error) {
+	row := q.db.QueryRow(ctx, updateCandidateActorAccountStatus, arg.AccountActive, arg.AccountStatus, arg.DID)
+	var i CandidateActor
+	err := row.Scan(
+		&i.DID,
+		&i.CreatedAt,
+		&i.IsArtist,
+		&i.Comment,
+		&i.Status,
+		&i.Roles,
+		&i.CurrentProfileCommitCid,
+		&i.HeldUntil,
+		&i.AccountActive,
+		&i.AccountStatus,
+		&i.Handle,
+		&i.DeletedAt,
+		&i.PurgedAt,
+		&i.ApprovedAt,
+		&i.NSFWOverride,
+	)
+	return i, err
+}
+
+const updateCandidateActorHandle = `-- name: UpdateCandidateActorHandle :one
+UPDATE candidate_actors ca
+SET handle = $1::TEXT
+WHERE ca.did = $2
+RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
+`
+
+type UpdateCandidateActorHandleParams struct {
+	Handle string
+	DID    string
+}
+
+func (q *Queries) UpdateCandidateActorHandle(ctx context.Context, arg UpdateCandidateActorHandleParams) (CandidateActor, error) {
+	row := q.db.QueryRow(ctx, updateCandidateActorHandle, arg.Handle, arg.DID)
+	var i CandidateActor
+	err := row.Scan(
+		&i.DID,
+		&i.CreatedAt,
+		&i.IsArtist,
+		&i.Comment,
+		&i.Status,
+		&i.Roles,
+		&i.CurrentProfileCommitCid,
+		&i.HeldUntil,
+		&i.AccountActive,
+		&i.AccountStatus,
+		&i.Handle,
+		&i.DeletedAt,
+		&i.PurgedAt,
+		&i.ApprovedAt,
+		&i.NSFWOverride,
+	)
+	return i, err
+}
+
+const updateCandidateActorNSFWOverride = `-- name: UpdateCandidateActorNSFWOverride :one
+UPDATE candidate_actors ca
+SET nsfw_override = $1::BOOLEAN
+WHERE ca.did = $2
+RETURNING did, created_at, is_artist, comment, status, roles, current_profile_commit_cid, held_until, account_active, account_status, handle, deleted_at, purged_at, approved_at, nsfw_override
+`
+
+type UpdateCandidateActorNSFWOverrideParams struct {
+	NSFWOverride pgtype.Bool
+	DID          string
+}
+
+func (q *Queries) UpdateCandidateActorNSFWOverride(ctx context.Context, arg UpdateCandidateActorNSFWOverrideParams) (CandidateActor, error) {
+	row := q.db.QueryRow(ctx, updateCandidateActorNSFWOverride, arg.NSFWOverride, arg.DID)
+	var i CandidateActor
+	err := row.Scan(
+		&i.DID,
+		&i.CreatedAt,
+		&i.IsArtist,
+		&i.Comment,
+		&i.Status,
+		&i.Roles,
+		&i.CurrentProfileCommitCid,
+		&i.HeldUntil,
+		&i.AccountActive,
+		&i.AccountStatus,
+		&i.Handle,
+		&i.DeletedAt,
+		&i.PurgedAt,
+		&i.ApprovedAt,
+		&i.NSFWOverride,
 	)
 	return i, err
 }
@@ -19,6 +19,7 @@ candidate_follows (
 )
 VALUES
 ($1, $2, $3, $4, $5)
+ON CONFLICT (uri) DO NOTHING
 `
 
 type CreateCandidateFollowParams struct {
@@ -40,6 +41,37 @@ func (q *Queries) CreateCandidateFollow(ctx context.Context, arg CreateCandidate
 	return err
 }
 
+const deleteCandidateFollowsByActor = `-- name: DeleteCandidateFollowsByActor :execrows
+DELETE FROM candidate_follows
+WHERE actor_did = $1
+`
+
+func (q *Queries) DeleteCandidateFollowsByActor(ctx context.Context, actorDid string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCandidateFollowsByActor, actorDid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreCandidateFollowsByActor = `-- name: RestoreCandidateFollowsByActor :exec
+UPDATE candidate_follows
+SET deleted_at = NULL
+WHERE actor_did = $1 AND deleted_at = $2
+`
+
+type RestoreCandidateFollowsByActorParams struct {
+	ActorDID  string
+	DeletedAt pgtype.Timestamptz
+}
+
+// Undoes SoftDeleteCandidateFollowsByActor. Rows deleted at other times, e.g.
+// by the actor, are left alone.
+func (q *Queries) RestoreCandidateFollowsByActor(ctx context.Context, arg RestoreCandidateFollowsByActorParams) error {
+	_, err := q.db.Exec(ctx, restoreCandidateFollowsByActor, arg.ActorDID, arg.DeletedAt)
+	return err
+}
+
 const softDeleteCandidateFollow = `-- name: SoftDeleteCandidateFollow :exec
 UPDATE
 candidate_follows
@@ -53,3 +85,19 @@ func (q *Queries) SoftDeleteCandidateFollow(ctx context.Context, uri string) err
 	_, err := q.db.Exec(ctx, softDeleteCandidateFollow, uri)
 	return err
 }
+
+const softDeleteCandidateFollowsByActor = `-- name: SoftDeleteCandidateFollowsByActor :exec
+UPDATE candidate_follows
+SET deleted_at = $1
+WHERE actor_did = $2 AND deleted_at IS NULL
+`
+
+type SoftDeleteCandidateFollowsByActorParams struct {
+	DeletedAt pgtype.Timestamptz
+	ActorDID  string
+}
+
+func (q *Queries) SoftDeleteCandidateFollowsByActor(ctx context.Context, arg SoftDeleteCandidateFollowsByActorParams) error {
+	_, err := q.db.Exec(ctx, softDeleteCandidateFollowsByActor, arg.DeletedAt, arg.ActorDID)
+	return err
+}
@@ -19,6 +19,7 @@ candidate_likes (
 )
 VALUES
 ($1, $2, $3, $4, $5)
+ON CONFLICT (uri) DO NOTHING
 `
 
 type CreateCandidateLikeParams struct {
@@ -40,6 +41,37 @@ func (q *Queries) CreateCandidateLike(ctx context.Context, arg CreateCandidateLi
 	return err
 }
 
+const deleteCandidateLikesByActor = `-- name: DeleteCandidateLikesByActor :execrows
+DELETE FROM candidate_likes
+WHERE actor_did = $1
+`
+
+func (q *Queries) DeleteCandidateLikesByActor(ctx context.Context, actorDid string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCandidateLikesByActor, actorDid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreCandidateLikesByActor = `-- name: RestoreCandidateLikesByActor :exec
+UPDATE candidate_likes
+SET deleted_at = NULL
+WHERE actor_did = $1 AND deleted_at = $2
+`
+
+type RestoreCandidateLikesByActorParams struct {
+	ActorDID  string
+	DeletedAt pgtype.Timestamptz
+}
+
+// Undoes SoftDeleteCandidateLikesByActor. Rows deleted at other times, e.g.
+// by the actor, are left alone.
+func (q *Queries) RestoreCandidateLikesByActor(ctx context.Context, arg RestoreCandidateLikesByActorParams) error {
+	_, err := q.db.Exec(ctx, restoreCandidateLikesByActor, arg.ActorDID, arg.DeletedAt)
+	return err
+}
+
 const softDeleteCandidateLike = `-- name: SoftDeleteCandidateLike :exec
 UPDATE
 candidate_likes
@@ -53,3 +85,19 @@ func (q *Queries) SoftDeleteCandidateLike(ctx context.Context, uri string) error
 	_, err := q.db.Exec(ctx, softDeleteCandidateLike, uri)
 	return err
 }
+
+const softDeleteCandidateLikesByActor = `-- name: SoftDeleteCandidateLikesByActor :exec
+UPDATE candidate_likes
+SET deleted_at = $1
+WHERE actor_did = $2 AND deleted_at IS NULL
+`
+
+type SoftDeleteCandidateLikesByActorParams struct {
+	DeletedAt pgtype.Timestamptz
+	ActorDID  string
+}
+
+func (q *Queries) SoftDeleteCandidateLikesByActor(ctx context.Context, arg SoftDeleteCandidateLikesByActorParams) error {
+	_, err := q.db.Exec(ctx, softDeleteCandidateLikesByActor, arg.DeletedAt, arg.ActorDID)
+	return err
+}
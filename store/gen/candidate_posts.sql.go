@@ -12,6 +12,130 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countScoredPostsAbove = `-- name: CountScoredPostsAbove :one
+WITH args AS (
+    SELECT $8::TEXT [] AS allowed_embeds
+),
+
+-- A score is part of every generation from generation_seq up to, but not
+-- including, valid_until_seq. The scores in the generation are selected in
+-- three parts, so that each can be read from an index in score order: those
+-- scored in the generation, those scored earlier which are still current, and
+-- those scored earlier which have since been superseded.
+scores AS (
+    SELECT ps.uri, ps.score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = $9
+        AND ps.generation_seq = $10
+    UNION ALL
+    SELECT ps.uri, ps.score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = $9
+        AND ps.generation_seq < $10
+        AND ps.valid_until_seq IS NULL
+    UNION ALL
+    SELECT ps.uri, ps.score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = $9
+        AND ps.generation_seq < $10
+        AND ps.valid_until_seq > $10
+        AND ps.valid_until_seq > ps.generation_seq + 1
+)
+
+SELECT COUNT(*)
+FROM
+    candidate_posts AS cp
+INNER JOIN candidate_actors AS ca ON cp.actor_did = ca.did
+INNER JOIN scores AS ph ON cp.uri = ph.uri
+NATURAL JOIN args
+WHERE
+    cp.is_hidden = FALSE
+    AND ca.status = 'approved'
+    AND ca.account_active
+    -- Filter by whether the actor is an artist. If unspecified, do not filter.
+    AND (
+        $1::BOOLEAN IS NULL
+        OR ca.is_artist = $1
+    )
+    -- Match actors with at least one of the queried roles.
+    -- If unspecified, do not filter.
+    AND (
+        COALESCE($2::TEXT [], '{}') = '{}'
+        OR $2::TEXT [] && ca.roles
+    )
+    AND (
+        COALESCE($3::TEXT [], '{}') = '{}'
+        OR $3::TEXT [] && cp.hashtags
+    )
+    AND (
+        COALESCE($4::TEXT [], '{}') = '{}'
+        OR NOT $4::TEXT [] && cp.hashtags
+    )
+    AND (
+        CARDINALITY(args.allowed_embeds) = 0
+        OR (
+            'none' = ANY(args.allowed_embeds)
+            AND COALESCE(cp.has_media, FALSE) = FALSE
+            AND COALESCE(cp.has_video, FALSE) = FALSE
+        )
+        OR (
+            'image' = ANY(args.allowed_embeds)
+            AND COALESCE(cp.has_media, FALSE) = TRUE
+        )
+        OR (
+            'video' = ANY(args.allowed_embeds)
+            AND COALESCE(cp.has_video, FALSE) = TRUE
+        )
+    )
+    AND (
+        $5::BOOLEAN IS NULL
+        OR cp.is_nsfw = $5
+    )
+    AND cp.deleted_at IS NULL
+    AND (
+        ROW(ph.score, ph.uri)
+        > ROW(($6)::REAL, ($7)::TEXT)
+    )
+    AND cp.indexed_at > NOW() - INTERVAL '7 day'
+    AND cp.created_at > NOW() - INTERVAL '7 day'
+`
+
+type CountScoredPostsAboveParams struct {
+	IsArtist           pgtype.Bool
+	ActorRoles         []string
+	Hashtags           []string
+	DisallowedHashtags []string
+	IsNSFW             pgtype.Bool
+	Score              float32
+	URI                string
+	AllowedEmbeds      []string
+	Alg                string
+	GenerationSeq      int64
+}
+
+// Counts the posts which ListScoredPosts would list before the post with the
+// given score and URI.
+func (q *Queries) CountScoredPostsAbove(ctx context.Context, arg CountScoredPostsAboveParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countScoredPostsAbove,
+		arg.IsArtist,
+		arg.ActorRoles,
+		arg.Hashtags,
+		arg.DisallowedHashtags,
+		arg.IsNSFW,
+		arg.Score,
+		arg.URI,
+		arg.AllowedEmbeds,
+		arg.Alg,
+		arg.GenerationSeq,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createCandidatePost = `-- name: CreateCandidatePost :exec
 INSERT INTO
 candidate_posts (
@@ -23,10 +147,12 @@ candidate_posts (
     has_media,
     has_video,
     raw,
-    self_labels
+    self_labels,
+    is_nsfw
 )
 VALUES
-($1, $2, $3, $4, $5, $6, $7, $8, $9)
+($1, $2, $3, $4, $5, $6, $7, $8, $9, classify_post_nsfw($2, $5, $9))
+ON CONFLICT (uri) DO NOTHING
 `
 
 type CreateCandidatePostParams struct {
@@ -56,12 +182,25 @@ func (q *Queries) CreateCandidatePost(ctx context.Context, arg CreateCandidatePo
 	return err
 }
 
+const deleteCandidatePostsByActor = `-- name: DeleteCandidatePostsByActor :execrows
+DELETE FROM candidate_posts
+WHERE actor_did = $1
+`
+
+func (q *Queries) DeleteCandidatePostsByActor(ctx context.Context, actorDid string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCandidatePostsByActor, actorDid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getFurryNewFeed = `-- name: GetFurryNewFeed :many
 WITH args AS (
-    SELECT $7::TEXT [] AS allowed_embeds
+    SELECT $9::TEXT [] AS allowed_embeds
 )
 
-SELECT cp.uri, cp.actor_did, cp.created_at, cp.indexed_at, cp.is_hidden, cp.deleted_at, cp.raw, cp.hashtags, cp.has_media, cp.self_labels, cp.has_video
+SELECT cp.uri, cp.actor_did, cp.created_at, cp.indexed_at, cp.is_hidden, cp.deleted_at, cp.raw, cp.hashtags, cp.has_media, cp.self_labels, cp.has_video, cp.is_nsfw
 FROM
     candidate_posts AS cp
 INNER JOIN candidate_actors AS ca ON cp.actor_did = ca.did
@@ -69,6 +208,19 @@ NATURAL JOIN args
 WHERE
     -- Only include posts by approved actors
     ca.status = 'approved'
+    -- Remove posts by actors whose accounts are deactivated or taken down
+    AND ca.account_active
+    -- Filter by whether the actor is an artist. If unspecified, do not filter.
+    AND (
+        $1::BOOLEAN IS NULL
+        OR ca.is_artist = $1
+    )
+    -- Match actors with at least one of the queried roles.
+    -- If unspecified, do not filter.
+    AND (
+        COALESCE($2::TEXT [], '{}') = '{}'
+        OR $2::TEXT [] && ca.roles
+    )
     -- Remove posts hidden by our moderators
     AND cp.is_hidden = FALSE
     -- Remove posts deleted by the actors
@@ -79,13 +231,13 @@ WHERE
             -- Match at least one of the queried hashtags.
             -- If unspecified, do not filter.
             (
-                COALESCE($1::TEXT [], '{}') = '{}'
-                OR $1::TEXT [] && cp.hashtags
+                COALESCE($3::TEXT [], '{}') = '{}'
+                OR $3::TEXT [] && cp.hashtags
             )
             -- If any hashtags are disallowed, filter them out.
             AND (
-                COALESCE($2::TEXT [], '{}') = '{}'
-                OR NOT $2::TEXT [] && cp.hashtags
+                COALESCE($4::TEXT [], '{}') = '{}'
+                OR NOT $4::TEXT [] && cp.hashtags
             )
             AND (
                 CARDINALITY(args.allowed_embeds) = 0
@@ -105,26 +257,25 @@ WHERE
             )
             -- Filter by NSFW status. If unspecified, do not filter.
             AND (
-                $3::BOOLEAN IS NULL
-                OR (
-                    (ARRAY['nsfw', 'mursuit', 'murrsuit', 'nsfwfurry', 'furrynsfw'] && cp.hashtags)
-                    OR (ARRAY['porn', 'nudity', 'sexual'] && cp.self_labels)
-                ) = $3
+                $5::BOOLEAN IS NULL
+                OR cp.is_nsfw = $5
             )
         )
         -- Pinned DID criteria.
-        OR cp.actor_did = ANY($4::TEXT [])
+        OR cp.actor_did = ANY($6::TEXT [])
     )
     -- Remove posts newer than the cursor timestamp
-    AND (cp.indexed_at < $5)
+    AND (cp.indexed_at < $7)
     AND cp.indexed_at > NOW() - INTERVAL '7 day'
     AND cp.created_at > NOW() - INTERVAL '7 day'
 ORDER BY
     cp.indexed_at DESC
-LIMIT $6
+LIMIT $8
 `
 
 type GetFurryNewFeedParams struct {
+	IsArtist           pgtype.Bool
+	ActorRoles         []string
 	Hashtags           []string
 	DisallowedHashtags []string
 	IsNSFW             pgtype.Bool
@@ -136,6 +287,8 @@ type GetFurryNewFeedParams struct {
 
 func (q *Queries) GetFurryNewFeed(ctx context.Context, arg GetFurryNewFeedParams) ([]CandidatePost, error) {
 	rows, err := q.db.Query(ctx, getFurryNewFeed,
+		arg.IsArtist,
+		arg.ActorRoles,
 		arg.Hashtags,
 		arg.DisallowedHashtags,
 		arg.IsNSFW,
@@ -163,6 +316,7 @@ func (q *Queries) GetFurryNewFeed(ctx context.Context, arg GetFurryNewFeedParams
 			&i.HasMedia,
 			&i.SelfLabels,
 			&i.HasVideo,
+			&i.IsNSFW,
 		); err != nil {
 			return nil, err
 		}
@@ -175,7 +329,7 @@ func (q *Queries) GetFurryNewFeed(ctx context.Context, arg GetFurryNewFeedParams
 }
 
 const getPostByURI = `-- name: GetPostByURI :one
-SELECT uri, actor_did, created_at, indexed_at, is_hidden, deleted_at, raw, hashtags, has_media, self_labels, has_video
+SELECT uri, actor_did, created_at, indexed_at, is_hidden, deleted_at, raw, hashtags, has_media, self_labels, has_video, is_nsfw
 FROM
     candidate_posts AS cp
 WHERE
@@ -198,29 +352,129 @@ func (q *Queries) GetPostByURI(ctx context.Context, uri string) (CandidatePost,
 		&i.HasMedia,
 		&i.SelfLabels,
 		&i.HasVideo,
+		&i.IsNSFW,
 	)
 	return i, err
 }
 
+const listHiddenCandidatePostURIs = `-- name: ListHiddenCandidatePostURIs :many
+SELECT uri
+FROM candidate_posts
+WHERE
+    is_hidden = TRUE
+    AND deleted_at IS NULL
+`
+
+func (q *Queries) ListHiddenCandidatePostURIs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listHiddenCandidatePostURIs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var uri string
+		if err := rows.Scan(&uri); err != nil {
+			return nil, err
+		}
+		items = append(items, uri)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listScoredPosts = `-- name: ListScoredPosts :many
 WITH args AS (
     SELECT $9::TEXT [] AS allowed_embeds
+),
+
+-- A score is part of every generation from generation_seq up to, but not
+-- including, valid_until_seq. The scores in the generation are selected in
+-- three parts, so that each can be read from an index in score order: those
+-- scored in the generation, those scored earlier which are still current, and
+-- those scored earlier which have since been superseded. The planner folds
+-- away the jitter when there's no seed, so the index order is kept.
+scores AS (
+    SELECT
+        ps.uri,
+        CASE
+            WHEN $10::TEXT IS NULL THEN ps.score
+            ELSE (
+                ps.score * (
+                    1 + $11::REAL
+                    * ('x' || SUBSTR(MD5($10::TEXT || ps.uri), 1, 8))::BIT(32)::INT
+                    / 2147483648.0
+                )
+            )::REAL
+        END AS score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = $12
+        AND ps.generation_seq = $13
+    UNION ALL
+    SELECT
+        ps.uri,
+        CASE
+            WHEN $10::TEXT IS NULL THEN ps.score
+            ELSE (
+                ps.score * (
+                    1 + $11::REAL
+                    * ('x' || SUBSTR(MD5($10::TEXT || ps.uri), 1, 8))::BIT(32)::INT
+                    / 2147483648.0
+                )
+            )::REAL
+        END AS score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = $12
+        AND ps.generation_seq < $13
+        AND ps.valid_until_seq IS NULL
+    UNION ALL
+    SELECT
+        ps.uri,
+        CASE
+            WHEN $10::TEXT IS NULL THEN ps.score
+            ELSE (
+                ps.score * (
+                    1 + $11::REAL
+                    * ('x' || SUBSTR(MD5($10::TEXT || ps.uri), 1, 8))::BIT(32)::INT
+                    / 2147483648.0
+                )
+            )::REAL
+        END AS score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = $12
+        AND ps.generation_seq < $13
+        AND ps.valid_until_seq > $13
+        AND ps.valid_until_seq > ps.generation_seq + 1
 )
 
 SELECT
-    cp.uri, cp.actor_did, cp.created_at, cp.indexed_at, cp.is_hidden, cp.deleted_at, cp.raw, cp.hashtags, cp.has_media, cp.self_labels, cp.has_video,
+    cp.*,
     ph.score
 FROM
     candidate_posts AS cp
 INNER JOIN candidate_actors AS ca ON cp.actor_did = ca.did
-INNER JOIN post_scores AS ph
-    ON
-        cp.uri = ph.uri AND ph.alg = $1
-        AND ph.generation_seq = $2
+INNER JOIN scores AS ph ON cp.uri = ph.uri
 NATURAL JOIN args
 WHERE
     cp.is_hidden = FALSE
     AND ca.status = 'approved'
+    AND ca.account_active
+    -- Filter by whether the actor is an artist. If unspecified, do not filter.
+    AND (
+        $1::BOOLEAN IS NULL
+        OR ca.is_artist = $1
+    )
+    -- Match actors with at least one of the queried roles.
+    -- If unspecified, do not filter.
+    AND (
+        COALESCE($2::TEXT [], '{}') = '{}'
+        OR $2::TEXT [] && ca.roles
+    )
     -- Match at least one of the queried hashtags.
     -- If unspecified, do not filter.
     AND (
@@ -251,10 +505,7 @@ WHERE
     -- Filter by NSFW status. If unspecified, do not filter.
     AND (
         $5::BOOLEAN IS NULL
-        OR (
-            (ARRAY['nsfw', 'mursuit', 'murrsuit', 'nsfwfurry', 'furrynsfw'] && cp.hashtags)
-            OR (ARRAY['porn', 'nudity', 'sexual'] && cp.self_labels)
-        ) = $5
+        OR cp.is_nsfw = $5
     )
     AND cp.deleted_at IS NULL
     AND (
@@ -269,8 +520,8 @@ LIMIT $8
 `
 
 type ListScoredPostsParams struct {
-	Alg                string
-	GenerationSeq      int64
+	IsArtist           pgtype.Bool
+	ActorRoles         []string
 	Hashtags           []string
 	DisallowedHashtags []string
 	IsNSFW             pgtype.Bool
@@ -278,6 +529,10 @@ type ListScoredPostsParams struct {
 	AfterURI           string
 	Limit              int32
 	AllowedEmbeds      []string
+	Seed               pgtype.Text
+	Jitter             float32
+	Alg                string
+	GenerationSeq      int64
 }
 
 type ListScoredPostsRow struct {
@@ -292,13 +547,17 @@ type ListScoredPostsRow struct {
 	HasMedia   pgtype.Bool
 	SelfLabels []string
 	HasVideo   pgtype.Bool
+	IsNSFW     bool
 	Score      float32
 }
 
+// Lists posts in score order. If a seed is given, each score is multiplied by
+// a random factor between 1 - jitter and 1 + jitter. The factor is derived
+// from the seed and the URI, so the same seed always gives the same order.
 func (q *Queries) ListScoredPosts(ctx context.Context, arg ListScoredPostsParams) ([]ListScoredPostsRow, error) {
 	rows, err := q.db.Query(ctx, listScoredPosts,
-		arg.Alg,
-		arg.GenerationSeq,
+		arg.IsArtist,
+		arg.ActorRoles,
 		arg.Hashtags,
 		arg.DisallowedHashtags,
 		arg.IsNSFW,
@@ -306,6 +565,10 @@ func (q *Queries) ListScoredPosts(ctx context.Context, arg ListScoredPostsParams
 		arg.AfterURI,
 		arg.Limit,
 		arg.AllowedEmbeds,
+		arg.Seed,
+		arg.Jitter,
+		arg.Alg,
+		arg.GenerationSeq,
 	)
 	if err != nil {
 		return nil, err
@@ -326,6 +589,7 @@ func (q *Queries) ListScoredPosts(ctx context.Context, arg ListScoredPostsParams
 			&i.HasMedia,
 			&i.SelfLabels,
 			&i.HasVideo,
+			&i.IsNSFW,
 			&i.Score,
 		); err != nil {
 			return nil, err
@@ -338,6 +602,89 @@ func (q *Queries) ListScoredPosts(ctx context.Context, arg ListScoredPostsParams
 	return items, nil
 }
 
+const reclassifyActorNSFWPosts = `-- name: ReclassifyActorNSFWPosts :execrows
+UPDATE candidate_posts
+SET is_nsfw = classify_post_nsfw(actor_did, hashtags, self_labels)
+WHERE
+    actor_did = $1
+    AND is_nsfw != classify_post_nsfw(actor_did, hashtags, self_labels)
+`
+
+// Reclassifies whether an actor's posts are NSFW, after their override or
+// profile has changed.
+func (q *Queries) ReclassifyActorNSFWPosts(ctx context.Context, actorDid string) (int64, error) {
+	result, err := q.db.Exec(ctx, reclassifyActorNSFWPosts, actorDid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reclassifyNSFWPosts = `-- name: ReclassifyNSFWPosts :one
+WITH batch AS (
+    SELECT
+        uri,
+        classify_post_nsfw(actor_did, hashtags, self_labels) AS is_nsfw
+    FROM candidate_posts
+    WHERE uri > $1::TEXT
+    ORDER BY uri ASC
+    LIMIT $2
+),
+
+reclassified AS (
+    UPDATE candidate_posts AS cp
+    SET is_nsfw = batch.is_nsfw
+    FROM batch
+    WHERE
+        cp.uri = batch.uri
+        AND cp.is_nsfw != batch.is_nsfw
+    RETURNING cp.uri
+)
+
+SELECT
+    COALESCE((SELECT MAX(batch.uri) FROM batch), '')::TEXT AS last_uri,
+    (SELECT COUNT(*) FROM reclassified) AS reclassified
+`
+
+type ReclassifyNSFWPostsParams struct {
+	AfterURI  string
+	BatchSize int32
+}
+
+type ReclassifyNSFWPostsRow struct {
+	LastURI      string
+	Reclassified int64
+}
+
+// Reclassifies whether a batch of posts are NSFW, after the NSFW rules have
+// changed. Posts are visited in URI order, starting after after_uri, and the
+// last URI visited is returned so the next batch can carry on from it. Once
+// every post has been visited, the last URI is empty.
+func (q *Queries) ReclassifyNSFWPosts(ctx context.Context, arg ReclassifyNSFWPostsParams) (ReclassifyNSFWPostsRow, error) {
+	row := q.db.QueryRow(ctx, reclassifyNSFWPosts, arg.AfterURI, arg.BatchSize)
+	var i ReclassifyNSFWPostsRow
+	err := row.Scan(&i.LastURI, &i.Reclassified)
+	return i, err
+}
+
+const restoreCandidatePostsByActor = `-- name: RestoreCandidatePostsByActor :exec
+UPDATE candidate_posts
+SET deleted_at = NULL
+WHERE actor_did = $1 AND deleted_at = $2
+`
+
+type RestoreCandidatePostsByActorParams struct {
+	ActorDID  string
+	DeletedAt pgtype.Timestamptz
+}
+
+// Undoes SoftDeleteCandidatePostsByActor. Rows deleted at other times, e.g.
+// by the actor, are left alone.
+func (q *Queries) RestoreCandidatePostsByActor(ctx context.Context, arg RestoreCandidatePostsByActorParams) error {
+	_, err := q.db.Exec(ctx, restoreCandidatePostsByActor, arg.ActorDID, arg.DeletedAt)
+	return err
+}
+
 const softDeleteCandidatePost = `-- name: SoftDeleteCandidatePost :exec
 UPDATE
 candidate_posts
@@ -351,3 +698,19 @@ func (q *Queries) SoftDeleteCandidatePost(ctx context.Context, uri string) error
 	_, err := q.db.Exec(ctx, softDeleteCandidatePost, uri)
 	return err
 }
+
+const softDeleteCandidatePostsByActor = `-- name: SoftDeleteCandidatePostsByActor :exec
+UPDATE candidate_posts
+SET deleted_at = $1
+WHERE actor_did = $2 AND deleted_at IS NULL
+`
+
+type SoftDeleteCandidatePostsByActorParams struct {
+	DeletedAt pgtype.Timestamptz
+	ActorDID  string
+}
+
+func (q *Queries) SoftDeleteCandidatePostsByActor(ctx context.Context, arg SoftDeleteCandidatePostsByActorParams) error {
+	_, err := q.db.Exec(ctx, softDeleteCandidatePostsByActor, arg.DeletedAt, arg.ActorDID)
+	return err
+}
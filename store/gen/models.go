@@ -56,6 +56,99 @@ func (ns NullActorStatus) Value() (driver.Value, error) {
 	return string(ns.ActorStatus), nil
 }
 
+type NSFWRuleKind string
+
+const (
+	NSFWRuleKindHashtag      NSFWRuleKind = "hashtag"
+	NSFWRuleKindPostLabel    NSFWRuleKind = "post_label"
+	NSFWRuleKindProfileLabel NSFWRuleKind = "profile_label"
+)
+
+func (e *NSFWRuleKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NSFWRuleKind(s)
+	case string:
+		*e = NSFWRuleKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NSFWRuleKind: %T", src)
+	}
+	return nil
+}
+
+type NullNSFWRuleKind struct {
+	NSFWRuleKind NSFWRuleKind
+	Valid        bool // Valid is true if NSFWRuleKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNSFWRuleKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.NSFWRuleKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NSFWRuleKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNSFWRuleKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NSFWRuleKind), nil
+}
+
+type TaskStatus string
+
+const (
+	TaskStatusPending   TaskStatus = "pending"
+	TaskStatusDone      TaskStatus = "done"
+	TaskStatusDead      TaskStatus = "dead"
+	TaskStatusCancelled TaskStatus = "cancelled"
+)
+
+func (e *TaskStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TaskStatus(s)
+	case string:
+		*e = TaskStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TaskStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTaskStatus struct {
+	TaskStatus TaskStatus
+	Valid      bool // Valid is true if TaskStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTaskStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TaskStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TaskStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTaskStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TaskStatus), nil
+}
+
+type ActorHandle struct {
+	ActorDID string
+	Handle   string
+	SeenAt   pgtype.Timestamptz
+}
+
 type ActorProfile struct {
 	ActorDID    string
 	CommitCID   string
@@ -84,6 +177,13 @@ type CandidateActor struct {
 	Roles                   []string
 	CurrentProfileCommitCid pgtype.Text
 	HeldUntil               pgtype.Timestamptz
+	AccountActive           bool
+	AccountStatus           pgtype.Text
+	Handle                  pgtype.Text
+	DeletedAt               pgtype.Timestamptz
+	PurgedAt                pgtype.Timestamptz
+	ApprovedAt              pgtype.Timestamptz
+	NSFWOverride            pgtype.Bool
 }
 
 type CandidateFollow struct {
@@ -116,31 +216,124 @@ type CandidatePost struct {
 	HasMedia   pgtype.Bool
 	SelfLabels []string
 	HasVideo   pgtype.Bool
+	IsNSFW     bool
 }
 
-type FirehoseCommitCursor struct {
-	Cursor int64
+type CuratedListItem struct {
+	ListUri    string
+	SubjectDid string
+	Rkey       string
 }
 
-type FollowTask struct {
-	ID             int64
-	ActorDID       string
-	NextTryAt      pgtype.Timestamptz
-	CreatedAt      pgtype.Timestamptz
-	Tries          int32
-	ShouldUnfollow bool
-	FinishedAt     pgtype.Timestamptz
-	LastError      pgtype.Text
+type DeadLetterEvent struct {
+	ID            int64
+	ActorDID      string
+	Event         []byte
+	LastError     string
+	Attempts      int32
+	CreatedAt     pgtype.Timestamptz
+	LastAttemptAt pgtype.Timestamptz
+	NextAttemptAt pgtype.Timestamptz
+}
+
+type IngesterReplica struct {
+	ID         string
+	LastSeenAt pgtype.Timestamptz
+}
+
+type IngesterShard struct {
+	ShardCount     int32
+	Shard          int32
+	Owner          pgtype.Text
+	LeaseExpiresAt pgtype.Timestamptz
+	Cursor         pgtype.Int8
 }
 
 type JetstreamCursor struct {
 	Cursor int64
 }
 
+type Label struct {
+	Seq int64
+	Src string
+	URI string
+	Val string
+	Neg bool
+	Cts pgtype.Timestamptz
+	Sig []byte
+}
+
+type LeaderLease struct {
+	Name       string
+	Holder     string
+	AcquiredAt pgtype.Timestamptz
+	ExpiresAt  pgtype.Timestamptz
+}
+
+type NSFWRule struct {
+	Kind      NSFWRuleKind
+	Value     string
+	CreatedAt pgtype.Timestamptz
+}
+
+type PostLikeCount struct {
+	URI   string
+	Likes int64
+}
+
+type PostNetworkCount struct {
+	URI     string
+	Likes   int64
+	Reposts int64
+}
+
+type PostNetworkInteraction struct {
+	URI        string
+	SubjectURI string
+	IsRepost   bool
+	CountedAt  pgtype.Timestamptz
+}
+
 type PostScore struct {
 	URI           string
 	Alg           string
 	GenerationSeq int64
 	Score         float32
 	GeneratedAt   pgtype.Timestamptz
+	ValidUntilSeq pgtype.Int8
+	SupersededAt  pgtype.Timestamptz
+	Likes         pgtype.Int8
+}
+
+type PostScoreGeneration struct {
+	Alg           string
+	GenerationSeq int64
+	GeneratedAt   pgtype.Timestamptz
+}
+
+type ReciprocalLikePair struct {
+	ActorDID      string
+	PartnerDid    string
+	LikesGiven    int64
+	LikesReceived int64
+	RefreshedAt   pgtype.Timestamptz
+}
+
+type RelayCursor struct {
+	Cursor int64
+}
+
+type Task struct {
+	ID           int64
+	Type         string
+	Payload      []byte
+	Status       TaskStatus
+	Tries        int32
+	MaxTries     int32
+	NextTryAt    pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+	FinishedAt   pgtype.Timestamptz
+	LastError    pgtype.Text
+	DedupeKey    pgtype.Text
+	ClaimedUntil pgtype.Timestamptz
 }
@@ -13,9 +13,13 @@ import (
 
 const deleteOldPostScores = `-- name: DeleteOldPostScores :execrows
 DELETE FROM post_scores
-WHERE generated_at < $1::TIMESTAMPTZ
+WHERE
+    valid_until_seq IS NOT NULL
+    AND COALESCE(superseded_at, generated_at) < $1::TIMESTAMPTZ
 `
 
+// Deletes scores that stopped being current before the given time. Current
+// scores are kept however old they are.
 func (q *Queries) DeleteOldPostScores(ctx context.Context, before pgtype.Timestamptz) (int64, error) {
 	result, err := q.db.Exec(ctx, deleteOldPostScores, before)
 	if err != nil {
@@ -24,11 +28,57 @@ func (q *Queries) DeleteOldPostScores(ctx context.Context, before pgtype.Timesta
 	return result.RowsAffected(), nil
 }
 
+const deleteOldScoreGenerations = `-- name: DeleteOldScoreGenerations :execrows
+DELETE FROM post_score_generations AS psg
+WHERE
+    psg.generated_at < $1::TIMESTAMPTZ
+    AND psg.generation_seq < (
+        SELECT MAX(latest.generation_seq)
+        FROM post_score_generations AS latest
+        WHERE latest.alg = psg.alg
+    )
+`
+
+// Deletes generations created before the given time, other than the latest
+// generation of each algorithm.
+func (q *Queries) DeleteOldScoreGenerations(ctx context.Context, before pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOldScoreGenerations, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePostScoresByActor = `-- name: DeletePostScoresByActor :execrows
+DELETE FROM post_scores AS ps
+USING candidate_posts AS cp
+WHERE ps.uri = cp.uri AND cp.actor_did = $1
+`
+
+// Deletes the scores of an actor's posts. This must be done before the posts
+// are deleted.
+func (q *Queries) DeletePostScoresByActor(ctx context.Context, actorDid string) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePostScoresByActor, actorDid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReciprocalLikePairs = `-- name: DeleteReciprocalLikePairs :exec
+DELETE FROM reciprocal_like_pairs
+`
+
+func (q *Queries) DeleteReciprocalLikePairs(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteReciprocalLikePairs)
+	return err
+}
+
 const getLatestScoreGeneration = `-- name: GetLatestScoreGeneration :one
-SELECT ph.generation_seq
-FROM post_scores AS ph
-WHERE ph.alg = $1
-ORDER BY ph.generation_seq DESC
+SELECT psg.generation_seq
+FROM post_score_generations AS psg
+WHERE psg.alg = $1
+ORDER BY psg.generation_seq DESC
 LIMIT 1
 `
 
@@ -39,31 +89,571 @@ func (q *Queries) GetLatestScoreGeneration(ctx context.Context, alg string) (int
 	return generation_seq, err
 }
 
-const materializePostScores = `-- name: MaterializePostScores :one
-WITH seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq)
+const getPostScore = `-- name: GetPostScore :one
+SELECT uri, alg, generation_seq, score, generated_at, valid_until_seq, superseded_at, likes
+FROM post_scores AS ps
+WHERE
+    ps.uri = $1
+    AND ps.alg = $2
+    AND ps.generation_seq <= $3
+    AND (
+        ps.valid_until_seq IS NULL
+        OR ps.valid_until_seq > $3
+    )
+`
+
+type GetPostScoreParams struct {
+	URI           string
+	Alg           string
+	GenerationSeq int64
+}
+
+func (q *Queries) GetPostScore(ctx context.Context, arg GetPostScoreParams) (PostScore, error) {
+	row := q.db.QueryRow(ctx, getPostScore, arg.URI, arg.Alg, arg.GenerationSeq)
+	var i PostScore
+	err := row.Scan(
+		&i.URI,
+		&i.Alg,
+		&i.GenerationSeq,
+		&i.Score,
+		&i.GeneratedAt,
+		&i.ValidUntilSeq,
+		&i.SupersededAt,
+		&i.Likes,
+	)
+	return i, err
+}
+
+const insertReciprocalLikePairs = `-- name: InsertReciprocalLikePairs :execrows
+INSERT INTO reciprocal_like_pairs (
+    actor_did, partner_did, likes_given, likes_received
+)
+SELECT
+    rl.actor_did,
+    rl.partner_did,
+    rl.likes_given,
+    rl.likes_received
+FROM reciprocal_likers(
+    $1::TIMESTAMPTZ, $2::INT
+) AS rl
+`
+
+type InsertReciprocalLikePairsParams struct {
+	Since    pgtype.Timestamptz
+	MinLikes int32
+}
+
+// Flags the pairs of actors who have each liked at least min_likes of the
+// other's posts since the given time, for weighted_candidate_likes to dampen.
+func (q *Queries) InsertReciprocalLikePairs(ctx context.Context, arg InsertReciprocalLikePairsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertReciprocalLikePairs, arg.Since, arg.MinLikes)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listDampenedPosts = `-- name: ListDampenedPosts :many
+SELECT
+    wcl.subject_uri,
+    wcl.author_did,
+    COUNT(*) AS likes,
+    SUM(wcl.weight)::FLOAT8 AS weighted_likes
+FROM weighted_candidate_likes(
+    $1::TIMESTAMPTZ,
+    $2::TIMESTAMPTZ,
+    $3::FLOAT8,
+    $4::INT,
+    $5::FLOAT8
+) AS wcl
+GROUP BY wcl.subject_uri, wcl.author_did
+HAVING SUM(wcl.weight) < COUNT(*)
+ORDER BY COUNT(*) - SUM(wcl.weight) DESC, wcl.subject_uri ASC
+LIMIT $6
+`
+
+type ListDampenedPostsParams struct {
+	After                   pgtype.Timestamptz
+	NewLikerSince           pgtype.Timestamptz
+	NewLikerWeight          float64
+	MaxLikesPerAuthorPerDay int32
+	ReciprocalWeight        float64
+	MaxResults              int32
+}
+
+type ListDampenedPostsRow struct {
+	SubjectURI    string
+	AuthorDid     string
+	Likes         int64
+	WeightedLikes float64
+}
+
+// Lists posts whose likes have been dampened, with the posts that lost the
+// most likes first.
+func (q *Queries) ListDampenedPosts(ctx context.Context, arg ListDampenedPostsParams) ([]ListDampenedPostsRow, error) {
+	rows, err := q.db.Query(ctx, listDampenedPosts,
+		arg.After,
+		arg.NewLikerSince,
+		arg.NewLikerWeight,
+		arg.MaxLikesPerAuthorPerDay,
+		arg.ReciprocalWeight,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDampenedPostsRow
+	for rows.Next() {
+		var i ListDampenedPostsRow
+		if err := rows.Scan(
+			&i.SubjectURI,
+			&i.AuthorDid,
+			&i.Likes,
+			&i.WeightedLikes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReciprocalLikers = `-- name: ListReciprocalLikers :many
+SELECT
+    rlp.actor_did,
+    rlp.partner_did,
+    rlp.likes_given,
+    rlp.likes_received
+FROM reciprocal_like_pairs AS rlp
+WHERE rlp.actor_did < rlp.partner_did
+ORDER BY
+    LEAST(rlp.likes_given, rlp.likes_received) DESC,
+    rlp.actor_did ASC,
+    rlp.partner_did ASC
+LIMIT $1
+`
+
+type ListReciprocalLikersRow struct {
+	ActorDID      string
+	PartnerDid    string
+	LikesGiven    int64
+	LikesReceived int64
+}
+
+// Lists the flagged pairs of actors who like each other's posts, with the
+// most active pairs first. Each pair is only listed once.
+func (q *Queries) ListReciprocalLikers(ctx context.Context, maxResults int32) ([]ListReciprocalLikersRow, error) {
+	rows, err := q.db.Query(ctx, listReciprocalLikers, maxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReciprocalLikersRow
+	for rows.Next() {
+		var i ListReciprocalLikersRow
+		if err := rows.Scan(
+			&i.ActorDID,
+			&i.PartnerDid,
+			&i.LikesGiven,
+			&i.LikesReceived,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const materializeDampenedPostScores = `-- name: MaterializeDampenedPostScores :one
+WITH
+seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq),
+generation AS (
+    INSERT INTO post_score_generations (alg, generation_seq)
+    SELECT $1::TEXT, seq.seq FROM seq
+),
+superseded AS (
+    UPDATE post_scores AS ps
+    SET
+        valid_until_seq = (SELECT seq FROM seq),
+        superseded_at = NOW()
+    WHERE ps.alg = $1::TEXT AND ps.valid_until_seq IS NULL
+),
+weighted AS (
+    SELECT
+        wcl.subject_uri,
+        SUM(wcl.weight) AS likes
+    FROM weighted_candidate_likes(
+        $2::TIMESTAMPTZ,
+        $3::TIMESTAMPTZ,
+        $4::FLOAT8,
+        $5::INT,
+        $6::FLOAT8
+    ) AS wcl
+    GROUP BY wcl.subject_uri
+)
 
-INSERT INTO post_scores (uri, alg, score, generation_seq)
+INSERT INTO post_scores (uri, alg, score, generation_seq, valid_until_seq)
 SELECT
     cp.uri AS uri,
-    'classic' AS alg,
-    (
-        SELECT COUNT(*)
-        FROM candidate_likes AS cl
-        WHERE cl.subject_uri = cp.uri AND cl.deleted_at IS NULL
-    )
+    $1::TEXT AS alg,
+    COALESCE(w.likes, 0)
     / (EXTRACT(EPOCH FROM NOW() - cp.indexed_at) / (60 * 60) + 2)
     ^ 1.85 AS score,
-    (SELECT seq FROM seq) AS generation_seq
+    (SELECT seq FROM seq) AS generation_seq,
+    (SELECT seq FROM seq) + 1 AS valid_until_seq
 FROM candidate_posts AS cp
+LEFT JOIN weighted AS w ON cp.uri = w.subject_uri
 WHERE
     cp.deleted_at IS NULL
-    AND cp.indexed_at >= $1::TIMESTAMPTZ
+    AND cp.indexed_at >= $2::TIMESTAMPTZ
 RETURNING (SELECT seq FROM seq)
 `
 
-func (q *Queries) MaterializePostScores(ctx context.Context, after pgtype.Timestamptz) (int64, error) {
-	row := q.db.QueryRow(ctx, materializePostScores, after)
+type MaterializeDampenedPostScoresParams struct {
+	Alg                     string
+	After                   pgtype.Timestamptz
+	NewLikerSince           pgtype.Timestamptz
+	NewLikerWeight          float64
+	MaxLikesPerAuthorPerDay int32
+	ReciprocalWeight        float64
+}
+
+// Scores posts in the same way as the "classic" algorithm, but with each like
+// weighted by the dampeners in weighted_candidate_likes.
+func (q *Queries) MaterializeDampenedPostScores(ctx context.Context, arg MaterializeDampenedPostScoresParams) (int64, error) {
+	row := q.db.QueryRow(ctx, materializeDampenedPostScores,
+		arg.Alg,
+		arg.After,
+		arg.NewLikerSince,
+		arg.NewLikerWeight,
+		arg.MaxLikesPerAuthorPerDay,
+		arg.ReciprocalWeight,
+	)
 	var seq int64
 	err := row.Scan(&seq)
 	return seq, err
 }
+
+const rescoreDiscoveryPostScores = `-- name: RescoreDiscoveryPostScores :one
+WITH
+seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq),
+generation AS (
+    INSERT INTO post_score_generations (alg, generation_seq)
+    SELECT 'discovery', seq.seq FROM seq
+),
+current_scores AS (
+    SELECT
+        ps.uri,
+        ps.likes,
+        ps.generated_at
+    FROM post_scores AS ps
+    WHERE ps.alg = 'discovery' AND ps.valid_until_seq IS NULL
+),
+eligible_posts AS (
+    SELECT
+        cp.uri,
+        EXTRACT(EPOCH FROM NOW() - cp.indexed_at) / (60 * 60) AS age_hours,
+        COALESCE(plc.likes, 0) AS likes
+    FROM candidate_posts AS cp
+    LEFT JOIN post_like_counts AS plc ON cp.uri = plc.uri
+    WHERE
+        cp.deleted_at IS NULL
+        AND cp.is_hidden = FALSE
+        AND COALESCE(cp.has_media, FALSE) = TRUE
+        AND cp.indexed_at >= $1::TIMESTAMPTZ
+        AND cp.indexed_at < NOW() - INTERVAL '1 hour'
+),
+rescored AS (
+    SELECT
+        ep.uri,
+        ep.likes,
+        1 / (
+            (1 + ep.likes / (ep.age_hours + 1))
+            * SQRT(ep.age_hours + 2)
+        ) AS score
+    FROM eligible_posts AS ep
+    LEFT JOIN current_scores AS cs ON ep.uri = cs.uri
+    WHERE
+        cs.uri IS NULL
+        OR cs.likes IS DISTINCT FROM ep.likes
+        -- Scores move by less than 1 / (age_hours + 1) of themselves an
+        -- hour, whether they're falling with age or rising as the post's
+        -- likes age.
+        OR EXTRACT(EPOCH FROM NOW() - cs.generated_at) / (60 * 60)
+        / (ep.age_hours + 1) > $2::FLOAT8
+),
+superseded AS (
+    UPDATE post_scores AS ps
+    SET
+        valid_until_seq = (SELECT seq FROM seq),
+        superseded_at = NOW()
+    WHERE
+        ps.alg = 'discovery'
+        AND ps.valid_until_seq IS NULL
+        AND (
+            ps.uri IN (SELECT r.uri FROM rescored AS r)
+            OR NOT EXISTS (
+                SELECT 1 FROM eligible_posts AS ep WHERE ep.uri = ps.uri
+            )
+        )
+    RETURNING ps.uri
+),
+inserted AS (
+    INSERT INTO post_scores (uri, alg, score, generation_seq, likes)
+    SELECT
+        r.uri,
+        'discovery',
+        r.score,
+        (SELECT seq FROM seq),
+        r.likes
+    FROM rescored AS r
+    RETURNING uri
+)
+
+SELECT
+    (SELECT seq FROM seq)::BIGINT AS generation_seq,
+    (SELECT COUNT(*) FROM inserted) AS rescored,
+    (SELECT COUNT(*) FROM superseded) AS superseded
+`
+
+type RescoreDiscoveryPostScoresParams struct {
+	After    pgtype.Timestamptz
+	MaxDecay float64
+}
+
+type RescoreDiscoveryPostScoresRow struct {
+	GenerationSeq int64
+	Rescored      int64
+	Superseded    int64
+}
+
+// Creates a generation of the "discovery" algorithm, which favours image
+// posts with few likes for their age. Posts less than an hour old aren't
+// scored, as they're still near the top of the chronological feeds. As with
+// RescorePostScores, only posts whose like count has changed, or whose score
+// may have moved by more than max_decay, are rescored. Scores of posts that are
+// no longer eligible are superseded without a replacement.
+func (q *Queries) RescoreDiscoveryPostScores(ctx context.Context, arg RescoreDiscoveryPostScoresParams) (RescoreDiscoveryPostScoresRow, error) {
+	row := q.db.QueryRow(ctx, rescoreDiscoveryPostScores, arg.After, arg.MaxDecay)
+	var i RescoreDiscoveryPostScoresRow
+	err := row.Scan(
+		&i.GenerationSeq,
+		&i.Rescored,
+		&i.Superseded,
+	)
+	return i, err
+}
+
+const rescoreNetworkPostScores = `-- name: RescoreNetworkPostScores :one
+WITH
+seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq),
+generation AS (
+    INSERT INTO post_score_generations (alg, generation_seq)
+    SELECT 'network', seq.seq FROM seq
+),
+current_scores AS (
+    SELECT
+        ps.uri,
+        ps.likes,
+        ps.generated_at
+    FROM post_scores AS ps
+    WHERE ps.alg = 'network' AND ps.valid_until_seq IS NULL
+),
+window_posts AS (
+    SELECT
+        cp.uri,
+        EXTRACT(EPOCH FROM NOW() - cp.indexed_at) / (60 * 60) AS age_hours,
+        COALESCE(pnc.likes, 0) + COALESCE(pnc.reposts, 0) AS interactions
+    FROM candidate_posts AS cp
+    LEFT JOIN post_network_counts AS pnc ON cp.uri = pnc.uri
+    WHERE
+        cp.deleted_at IS NULL
+        AND cp.indexed_at >= $1::TIMESTAMPTZ
+),
+rescored AS (
+    SELECT
+        wp.uri,
+        wp.interactions,
+        wp.interactions / (wp.age_hours + 2) ^ 1.85 AS score
+    FROM window_posts AS wp
+    LEFT JOIN current_scores AS cs ON wp.uri = cs.uri
+    WHERE
+        cs.uri IS NULL
+        OR cs.likes IS DISTINCT FROM wp.interactions
+        OR (
+            wp.interactions > 0
+            AND 1.85 * EXTRACT(EPOCH FROM NOW() - cs.generated_at) / (60 * 60)
+            / (wp.age_hours + 2) > $2::FLOAT8
+        )
+),
+superseded AS (
+    UPDATE post_scores AS ps
+    SET
+        valid_until_seq = (SELECT seq FROM seq),
+        superseded_at = NOW()
+    WHERE
+        ps.alg = 'network'
+        AND ps.valid_until_seq IS NULL
+        AND (
+            ps.uri IN (SELECT r.uri FROM rescored AS r)
+            OR NOT EXISTS (
+                SELECT 1 FROM window_posts AS wp WHERE wp.uri = ps.uri
+            )
+        )
+    RETURNING ps.uri
+),
+inserted AS (
+    INSERT INTO post_scores (uri, alg, score, generation_seq, likes)
+    SELECT
+        r.uri,
+        'network',
+        r.score,
+        (SELECT seq FROM seq),
+        r.interactions
+    FROM rescored AS r
+    RETURNING uri
+)
+
+SELECT
+    (SELECT seq FROM seq)::BIGINT AS generation_seq,
+    (SELECT COUNT(*) FROM inserted) AS rescored,
+    (SELECT COUNT(*) FROM superseded) AS superseded
+`
+
+type RescoreNetworkPostScoresParams struct {
+	After    pgtype.Timestamptz
+	MaxDecay float64
+}
+
+type RescoreNetworkPostScoresRow struct {
+	GenerationSeq int64
+	Rescored      int64
+	Superseded    int64
+}
+
+// Creates a generation of the "network" algorithm, which scores posts in the
+// same way as the "classic" algorithm, but by their likes and reposts from
+// anyone on the network. As with RescorePostScores, only posts whose count has
+// changed, or whose score has decayed by more than max_decay, are rescored.
+func (q *Queries) RescoreNetworkPostScores(ctx context.Context, arg RescoreNetworkPostScoresParams) (RescoreNetworkPostScoresRow, error) {
+	row := q.db.QueryRow(ctx, rescoreNetworkPostScores, arg.After, arg.MaxDecay)
+	var i RescoreNetworkPostScoresRow
+	err := row.Scan(
+		&i.GenerationSeq,
+		&i.Rescored,
+		&i.Superseded,
+	)
+	return i, err
+}
+
+const rescorePostScores = `-- name: RescorePostScores :one
+WITH
+seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq),
+generation AS (
+    INSERT INTO post_score_generations (alg, generation_seq)
+    SELECT 'classic', seq.seq FROM seq
+),
+current_scores AS (
+    SELECT
+        ps.uri,
+        ps.likes,
+        ps.generated_at
+    FROM post_scores AS ps
+    WHERE ps.alg = 'classic' AND ps.valid_until_seq IS NULL
+),
+window_posts AS (
+    SELECT
+        cp.uri,
+        EXTRACT(EPOCH FROM NOW() - cp.indexed_at) / (60 * 60) AS age_hours,
+        COALESCE(plc.likes, 0) AS likes
+    FROM candidate_posts AS cp
+    LEFT JOIN post_like_counts AS plc ON cp.uri = plc.uri
+    WHERE
+        cp.deleted_at IS NULL
+        AND cp.indexed_at >= $1::TIMESTAMPTZ
+),
+rescored AS (
+    SELECT
+        wp.uri,
+        wp.likes,
+        wp.likes / (wp.age_hours + 2) ^ 1.85 AS score
+    FROM window_posts AS wp
+    LEFT JOIN current_scores AS cs ON wp.uri = cs.uri
+    WHERE
+        cs.uri IS NULL
+        OR cs.likes IS DISTINCT FROM wp.likes
+        -- Scores fall by roughly 1.85 / (age_hours + 2) of themselves an
+        -- hour, and scores of posts without likes never change.
+        OR (
+            wp.likes > 0
+            AND 1.85 * EXTRACT(EPOCH FROM NOW() - cs.generated_at) / (60 * 60)
+            / (wp.age_hours + 2) > $2::FLOAT8
+        )
+),
+superseded AS (
+    UPDATE post_scores AS ps
+    SET
+        valid_until_seq = (SELECT seq FROM seq),
+        superseded_at = NOW()
+    WHERE
+        ps.alg = 'classic'
+        AND ps.valid_until_seq IS NULL
+        AND (
+            ps.uri IN (SELECT r.uri FROM rescored AS r)
+            OR NOT EXISTS (
+                SELECT 1 FROM window_posts AS wp WHERE wp.uri = ps.uri
+            )
+        )
+    RETURNING ps.uri
+),
+inserted AS (
+    INSERT INTO post_scores (uri, alg, score, generation_seq, likes)
+    SELECT
+        r.uri,
+        'classic',
+        r.score,
+        (SELECT seq FROM seq),
+        r.likes
+    FROM rescored AS r
+    RETURNING uri
+)
+
+SELECT
+    (SELECT seq FROM seq)::BIGINT AS generation_seq,
+    (SELECT COUNT(*) FROM inserted) AS rescored,
+    (SELECT COUNT(*) FROM superseded) AS superseded
+`
+
+type RescorePostScoresParams struct {
+	After    pgtype.Timestamptz
+	MaxDecay float64
+}
+
+type RescorePostScoresRow struct {
+	GenerationSeq int64
+	Rescored      int64
+	Superseded    int64
+}
+
+// Creates a generation of the "classic" algorithm, carrying over the scores
+// of posts whose like count hasn't changed from the previous generation. As
+// scores decay with age, they're also recomputed once they've decayed by more
+// than max_decay, as a fraction of the score. Scores of posts that are no
+// longer in the lookback window are superseded without a replacement.
+func (q *Queries) RescorePostScores(ctx context.Context, arg RescorePostScoresParams) (RescorePostScoresRow, error) {
+	row := q.db.QueryRow(ctx, rescorePostScores, arg.After, arg.MaxDecay)
+	var i RescorePostScoresRow
+	err := row.Scan(
+		&i.GenerationSeq,
+		&i.Rescored,
+		&i.Superseded,
+	)
+	return i, err
+}
@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+)
+
+var isLeader = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bff_leader_elected",
+	Help: "Whether this replica holds the lease, and is running the named process.",
+}, []string{"lease"})
+
+var leaderChanges = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bff_leader_changes_total",
+	Help: "The total number of times this replica has started or stopped leading the named process.",
+}, []string{"lease"})
+
+const (
+	defaultLeaderLeaseTTL      = 30 * time.Second
+	defaultLeaderRenewInterval = 10 * time.Second
+)
+
+type LeaderElectorOpts struct {
+	// Holder uniquely identifies this replica whilst it's running.
+	Holder string
+	// LeaseTTL is how long a lease lasts without being renewed. Defaults to
+	// 30 seconds.
+	LeaseTTL time.Duration
+	// RenewInterval is how often the leader renews its lease, and how often
+	// the other replicas try to take it. Defaults to 10 seconds.
+	RenewInterval time.Duration
+}
+
+// LeaderElector makes sure that only one replica runs a process at a time,
+// using a lease in the leader_leases table.
+type LeaderElector struct {
+	log   *slog.Logger
+	store *PGXStore
+	name  string
+	opts  LeaderElectorOpts
+}
+
+func NewLeaderElector(
+	log *slog.Logger, store *PGXStore, name string, opts LeaderElectorOpts,
+) *LeaderElector {
+	if opts.LeaseTTL == 0 {
+		opts.LeaseTTL = defaultLeaderLeaseTTL
+	}
+	if opts.RenewInterval == 0 {
+		opts.RenewInterval = defaultLeaderRenewInterval
+	}
+	return &LeaderElector{
+		log:   log.With(slog.String("lease", name), slog.String("holder", opts.Holder)),
+		store: store,
+		name:  name,
+		opts:  opts,
+	}
+}
+
+// Run calls fn whilst this replica is the leader, until ctx is cancelled. If
+// the lease can't be renewed, the context passed to fn is cancelled before
+// the lease expires, and fn is called again once the lease is taken back. If
+// fn returns an error, Run gives up the lease and returns it.
+func (le *LeaderElector) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	var (
+		leading       bool
+		lastRenewedAt time.Time
+		stop          = func() {}
+		done          <-chan error
+	)
+	start := func() {
+		leaderCtx, cancel := context.WithCancel(ctx)
+		ch := make(chan error, 1)
+		go func() {
+			ch <- fn(leaderCtx)
+		}()
+		stop = func() {
+			cancel()
+			<-ch
+		}
+		done = ch
+		leading = true
+		isLeader.WithLabelValues(le.name).Set(1)
+		leaderChanges.WithLabelValues(le.name).Inc()
+		le.log.Info("elected leader")
+	}
+	resign := func() {
+		stop()
+		stop, done = func() {}, nil
+		leading = false
+		isLeader.WithLabelValues(le.name).Set(0)
+		leaderChanges.WithLabelValues(le.name).Inc()
+	}
+	defer func() {
+		if !leading {
+			return
+		}
+		resign()
+		// Give up the lease so another replica can take over without
+		// waiting for it to expire.
+		exitCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*10)
+		defer cancel()
+		if err := le.store.ReleaseLeaderLease(exitCtx, le.name, le.opts.Holder); err != nil {
+			le.log.Warn("failed to release lease", bfflog.Err(err))
+		}
+	}()
+
+	ticker := time.NewTicker(le.opts.RenewInterval)
+	defer ticker.Stop()
+	for {
+		held, err := le.store.AcquireLeaderLease(ctx, le.name, le.opts.Holder, le.opts.LeaseTTL)
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err != nil:
+			le.log.Error("failed to acquire lease", bfflog.Err(err))
+			// Stop before our lease expires, as another replica may then
+			// take over.
+			if leading && time.Since(lastRenewedAt) >= le.opts.LeaseTTL-le.opts.RenewInterval {
+				le.log.Warn("stepping down, as the lease could not be renewed")
+				resign()
+			}
+		case held:
+			lastRenewedAt = time.Now()
+			if !leading {
+				start()
+			}
+		case leading:
+			// Another replica took the lease, e.g. because we were paused
+			// for longer than it lasts.
+			le.log.Warn("lost lease to another replica")
+			resign()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-done:
+			// fn has already returned, so there's nothing to stop.
+			stop = func() {}
+			if err == nil || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("running as leader: %w", err)
+		case <-ticker.C:
+		}
+	}
+}
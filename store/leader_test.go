@@ -0,0 +1,66 @@
+package store_test
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+func TestLeaderElector(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	var leaders atomic.Int32
+	elected := make([]atomic.Bool, 2)
+	run := func(ctx context.Context, i int) (stop func()) {
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan error, 1)
+		elector := store.NewLeaderElector(slog.Default(), harness.Store, "test", store.LeaderElectorOpts{
+			Holder:        []string{"a", "b"}[i],
+			LeaseTTL:      time.Second,
+			RenewInterval: 100 * time.Millisecond,
+		})
+		go func() {
+			done <- elector.Run(ctx, func(ctx context.Context) error {
+				// Only one replica should ever be leading at once.
+				assert.Equal(t, int32(1), leaders.Add(1))
+				elected[i].Store(true)
+				<-ctx.Done()
+				elected[i].Store(false)
+				leaders.Add(-1)
+				return nil
+			})
+		}()
+		return func() {
+			cancel()
+			require.NoError(t, <-done)
+		}
+	}
+
+	stopA := run(ctx, 0)
+	require.Eventually(t, elected[0].Load, 5*time.Second, 10*time.Millisecond)
+	stopB := run(ctx, 1)
+	defer stopB()
+
+	// b waits whilst a holds the lease.
+	time.Sleep(500 * time.Millisecond)
+	assert.False(t, elected[1].Load())
+
+	// a releases the lease when it stops, so b takes over straight away
+	// rather than waiting for it to expire.
+	stopA()
+	require.Eventually(t, elected[1].Load, 500*time.Millisecond, 10*time.Millisecond)
+}
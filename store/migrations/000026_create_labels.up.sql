@@ -0,0 +1,11 @@
+CREATE TABLE labels (
+    seq BIGSERIAL PRIMARY KEY,
+    src TEXT NOT NULL,
+    uri TEXT NOT NULL,
+    val TEXT NOT NULL,
+    neg BOOLEAN NOT NULL DEFAULT FALSE,
+    cts TIMESTAMPTZ NOT NULL,
+    sig BYTEA NOT NULL
+);
+
+CREATE INDEX labels_src_uri_val_idx ON public.labels (src, uri, val, seq DESC);
@@ -0,0 +1,42 @@
+CREATE TYPE task_status AS ENUM ('pending', 'done', 'dead');
+
+CREATE TABLE tasks (
+    id BIGSERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status TASK_STATUS NOT NULL DEFAULT 'pending',
+    tries INT NOT NULL DEFAULT 0,
+    max_tries INT NOT NULL,
+    next_try_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    last_error TEXT,
+    -- dedupe_key prevents more than one pending task with the same key from
+    -- being enqueued, e.g. for periodic jobs enqueued by several workers.
+    dedupe_key TEXT
+);
+
+CREATE INDEX tasks_pending_idx ON public.tasks (next_try_at, id)
+WHERE status = 'pending';
+CREATE UNIQUE INDEX tasks_dedupe_key_idx ON public.tasks (dedupe_key)
+WHERE status = 'pending';
+
+-- Carry over any follow tasks that have not yet finished or exhausted their
+-- retries.
+INSERT INTO tasks (
+    type, payload, tries, max_tries, next_try_at, created_at, last_error
+)
+SELECT
+    CASE WHEN should_unfollow THEN 'unfollow' ELSE 'follow' END,
+    JSONB_BUILD_OBJECT('actor_did', actor_did),
+    tries,
+    3,
+    next_try_at,
+    created_at,
+    last_error
+FROM follow_tasks
+WHERE
+    finished_at IS NULL
+    AND tries < 3;
+
+DROP TABLE follow_tasks;
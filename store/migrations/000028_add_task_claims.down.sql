@@ -0,0 +1,16 @@
+ALTER TABLE tasks DROP COLUMN claimed_until;
+
+-- Postgres does not support removing a value from an enum, so we recreate it.
+UPDATE tasks SET status = 'dead' WHERE status = 'cancelled';
+DROP INDEX tasks_pending_idx;
+DROP INDEX tasks_dedupe_key_idx;
+ALTER TABLE tasks ALTER COLUMN status DROP DEFAULT;
+ALTER TYPE task_status RENAME TO task_status_old;
+CREATE TYPE task_status AS ENUM ('pending', 'done', 'dead');
+ALTER TABLE tasks ALTER COLUMN status TYPE task_status USING status::TEXT::TASK_STATUS;
+ALTER TABLE tasks ALTER COLUMN status SET DEFAULT 'pending';
+DROP TYPE task_status_old;
+CREATE INDEX tasks_pending_idx ON public.tasks (next_try_at, id)
+WHERE status = 'pending';
+CREATE UNIQUE INDEX tasks_dedupe_key_idx ON public.tasks (dedupe_key)
+WHERE status = 'pending';
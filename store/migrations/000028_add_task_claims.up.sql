@@ -0,0 +1,5 @@
+ALTER TYPE task_status ADD VALUE 'cancelled';
+
+-- claimed_until is set whilst a worker is running a task, so that running
+-- tasks can be distinguished from those waiting to be retried.
+ALTER TABLE tasks ADD COLUMN claimed_until TIMESTAMPTZ;
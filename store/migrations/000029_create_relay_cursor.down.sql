@@ -0,0 +1 @@
+DROP TABLE relay_cursor;
@@ -0,0 +1,4 @@
+CREATE TABLE relay_cursor (
+    cursor BIGINT NOT NULL
+);
+CREATE UNIQUE INDEX relay_cursor_single_row_idx ON relay_cursor ((0));
@@ -0,0 +1,19 @@
+-- dead_letter_events holds Jetstream events which the ingester failed to
+-- handle, so that they can be retried rather than lost.
+CREATE TABLE dead_letter_events (
+    id BIGSERIAL PRIMARY KEY,
+    actor_did TEXT NOT NULL,
+    -- event is the Jetstream event as received.
+    event JSONB NOT NULL,
+    last_error TEXT NOT NULL,
+    attempts INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    last_attempt_at TIMESTAMPTZ NOT NULL,
+    -- next_attempt_at is NULL once the event has exhausted its attempts. It
+    -- will then only be retried if replayed manually.
+    next_attempt_at TIMESTAMPTZ
+);
+
+CREATE INDEX dead_letter_events_next_attempt_at_idx
+ON public.dead_letter_events (next_attempt_at, id)
+WHERE next_attempt_at IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP TABLE actor_handles;
+
+ALTER TABLE candidate_actors
+DROP COLUMN account_active,
+DROP COLUMN account_status,
+DROP COLUMN handle;
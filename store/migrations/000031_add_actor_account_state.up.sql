@@ -0,0 +1,17 @@
+-- account_active and account_status mirror the account events from the
+-- network. Posts by actors whose accounts are inactive, e.g. deactivated or
+-- taken down, are hidden from feeds.
+ALTER TABLE candidate_actors
+ADD COLUMN account_active BOOLEAN NOT NULL DEFAULT TRUE,
+ADD COLUMN account_status TEXT,
+ADD COLUMN handle TEXT;
+
+CREATE TABLE actor_handles (
+    actor_did TEXT NOT NULL REFERENCES candidate_actors (did),
+    handle TEXT NOT NULL,
+    seen_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX actor_handles_actor_did_idx ON public.actor_handles (
+    actor_did, seen_at
+);
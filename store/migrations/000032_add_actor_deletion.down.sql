@@ -0,0 +1,7 @@
+DROP INDEX candidate_follows_actor_did_idx;
+DROP INDEX candidate_likes_actor_did_idx;
+DROP INDEX candidate_posts_actor_did_idx;
+
+ALTER TABLE candidate_actors
+DROP COLUMN deleted_at,
+DROP COLUMN purged_at;
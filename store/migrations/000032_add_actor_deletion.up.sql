@@ -0,0 +1,16 @@
+-- deleted_at is set when an actor's data is soft deleted, e.g. because their
+-- account was deleted. Their data is hard deleted, and purged_at set, after a
+-- grace period. The actor row itself is kept as a tombstone for the audit log.
+ALTER TABLE candidate_actors
+ADD COLUMN deleted_at TIMESTAMPTZ,
+ADD COLUMN purged_at TIMESTAMPTZ;
+
+CREATE INDEX candidate_posts_actor_did_idx ON public.candidate_posts (
+    actor_did
+);
+CREATE INDEX candidate_likes_actor_did_idx ON public.candidate_likes (
+    actor_did
+);
+CREATE INDEX candidate_follows_actor_did_idx ON public.candidate_follows (
+    actor_did
+);
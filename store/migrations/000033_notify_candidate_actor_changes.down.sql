@@ -0,0 +1,2 @@
+DROP TRIGGER candidate_actors_notify_changed ON candidate_actors;
+DROP FUNCTION notify_candidate_actor_changed;
@@ -0,0 +1,19 @@
+-- Notifies listeners, e.g. the ingester's actor cache, whenever a candidate
+-- actor is created, updated or deleted. Notifications are delivered when the
+-- transaction commits.
+CREATE FUNCTION notify_candidate_actor_changed() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM PG_NOTIFY(
+        'candidate_actors',
+        JSON_BUILD_OBJECT(
+            'did', COALESCE(NEW.did, OLD.did),
+            'changed_at', CLOCK_TIMESTAMP()
+        )::TEXT
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER candidate_actors_notify_changed
+AFTER INSERT OR UPDATE OR DELETE ON candidate_actors
+FOR EACH ROW EXECUTE FUNCTION notify_candidate_actor_changed();
@@ -0,0 +1,2 @@
+DROP TABLE ingester_shards;
+DROP TABLE ingester_replicas;
@@ -0,0 +1,20 @@
+-- ingester_replicas tracks the running ingester replicas, so that shards can
+-- be spread evenly between them.
+CREATE TABLE ingester_replicas (
+    id TEXT PRIMARY KEY,
+    last_seen_at TIMESTAMPTZ NOT NULL
+);
+
+-- ingester_shards holds a lease and a Jetstream cursor for each shard of repo
+-- DIDs, when ingestion is sharded across replicas. Changing the shard count
+-- changes which DIDs are in each shard, so shards are keyed by the count too.
+CREATE TABLE ingester_shards (
+    shard_count INT NOT NULL,
+    shard INT NOT NULL,
+    -- owner is the replica holding the lease, or NULL if it was released.
+    owner TEXT,
+    lease_expires_at TIMESTAMPTZ,
+    -- cursor is the time_us to resume the shard from, or NULL if unknown.
+    cursor BIGINT,
+    PRIMARY KEY (shard_count, shard)
+);
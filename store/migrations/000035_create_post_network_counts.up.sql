@@ -0,0 +1,9 @@
+-- post_network_counts holds how many times candidate posts have been liked and
+-- reposted by anyone on the network, rather than only by candidate actors.
+-- Only the counts are kept, so likes and reposts which are later deleted are
+-- never subtracted.
+CREATE TABLE post_network_counts (
+    uri TEXT PRIMARY KEY REFERENCES candidate_posts (uri) ON DELETE CASCADE,
+    likes BIGINT NOT NULL DEFAULT 0,
+    reposts BIGINT NOT NULL DEFAULT 0
+);
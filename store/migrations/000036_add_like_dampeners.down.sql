@@ -0,0 +1,5 @@
+DROP FUNCTION weighted_candidate_likes;
+DROP FUNCTION reciprocal_likers;
+DROP TRIGGER candidate_actors_set_approved_at ON candidate_actors;
+DROP FUNCTION set_candidate_actor_approved_at;
+ALTER TABLE candidate_actors DROP COLUMN approved_at;
@@ -0,0 +1,128 @@
+ALTER TABLE candidate_actors ADD COLUMN approved_at TIMESTAMPTZ;
+-- We don't know when existing actors were approved, so assume they were
+-- approved when they were added.
+UPDATE candidate_actors SET approved_at = created_at WHERE status = 'approved';
+
+CREATE FUNCTION set_candidate_actor_approved_at() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status = 'approved' AND (TG_OP = 'INSERT' OR OLD.status != 'approved') THEN
+        NEW.approved_at = NOW();
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER candidate_actors_set_approved_at
+BEFORE INSERT OR UPDATE OF status ON candidate_actors
+FOR EACH ROW EXECUTE FUNCTION set_candidate_actor_approved_at();
+
+-- reciprocal_likers returns pairs of actors who have each liked at least
+-- min_likes of the other's posts since the given time, and how many likes
+-- they've given and received. Each pair is returned both ways round. A
+-- min_likes of 0 returns nothing.
+CREATE FUNCTION reciprocal_likers(since TIMESTAMPTZ, min_likes INT)
+RETURNS TABLE (
+    actor_did TEXT,
+    partner_did TEXT,
+    likes_given BIGINT,
+    likes_received BIGINT
+)
+LANGUAGE sql STABLE AS $$
+    WITH pairs AS (
+        SELECT
+            cl.actor_did AS liker_did,
+            cp.actor_did AS author_did,
+            COUNT(*) AS likes
+        FROM candidate_likes AS cl
+        INNER JOIN candidate_posts AS cp ON cl.subject_uri = cp.uri
+        WHERE
+            cl.deleted_at IS NULL
+            AND cl.created_at >= since
+            AND cl.actor_did != cp.actor_did
+        GROUP BY cl.actor_did, cp.actor_did
+    )
+
+    SELECT given.liker_did, given.author_did, given.likes, received.likes
+    FROM pairs AS given
+    INNER JOIN pairs AS received
+        ON
+            given.liker_did = received.author_did
+            AND given.author_did = received.liker_did
+    WHERE
+        min_likes > 0
+        AND given.likes >= min_likes
+        AND received.likes >= min_likes
+$$;
+
+-- weighted_candidate_likes returns the likes of candidate posts indexed since
+-- after, each weighted by the dampeners that apply to it, so that scoring
+-- algorithms can opt into them:
+--
+-- * Likes from actors approved since new_liker_since are weighted by
+--   new_liker_weight.
+-- * Only the first max_likes_per_author_per_day likes from an actor to
+--   another's posts count each day. 0 disables the cap.
+-- * Likes between reciprocal_likers(reciprocal_since, reciprocal_min_likes)
+--   are weighted by reciprocal_weight.
+CREATE FUNCTION weighted_candidate_likes(
+    after TIMESTAMPTZ,
+    new_liker_since TIMESTAMPTZ,
+    new_liker_weight FLOAT8,
+    max_likes_per_author_per_day INT,
+    reciprocal_since TIMESTAMPTZ,
+    reciprocal_min_likes INT,
+    reciprocal_weight FLOAT8
+)
+RETURNS TABLE (
+    subject_uri TEXT,
+    liker_did TEXT,
+    author_did TEXT,
+    weight FLOAT8
+)
+LANGUAGE sql STABLE AS $$
+    WITH likes AS (
+        SELECT
+            cl.subject_uri,
+            cl.actor_did AS liker_did,
+            cp.actor_did AS author_did,
+            ROW_NUMBER() OVER (
+                PARTITION BY
+                    cl.actor_did,
+                    cp.actor_did,
+                    DATE_TRUNC('day', cl.created_at)
+                ORDER BY cl.created_at ASC, cl.uri ASC
+            ) AS nth_of_day
+        FROM candidate_likes AS cl
+        INNER JOIN candidate_posts AS cp ON cl.subject_uri = cp.uri
+        WHERE
+            cl.deleted_at IS NULL
+            AND cp.deleted_at IS NULL
+            AND cp.indexed_at >= after
+    )
+
+    SELECT
+        l.subject_uri,
+        l.liker_did,
+        l.author_did,
+        (
+            CASE
+                WHEN
+                    max_likes_per_author_per_day > 0
+                    AND l.nth_of_day > max_likes_per_author_per_day
+                    THEN 0
+                ELSE 1
+            END
+            * CASE
+                WHEN ca.approved_at >= new_liker_since THEN new_liker_weight
+                ELSE 1
+            END
+            * CASE
+                WHEN rl.actor_did IS NOT NULL THEN reciprocal_weight
+                ELSE 1
+            END
+        )::FLOAT8
+    FROM likes AS l
+    LEFT JOIN candidate_actors AS ca ON l.liker_did = ca.did
+    LEFT JOIN reciprocal_likers(reciprocal_since, reciprocal_min_likes) AS rl
+        ON l.liker_did = rl.actor_did AND l.author_did = rl.partner_did
+$$;
@@ -0,0 +1,13 @@
+DROP TABLE post_score_generations;
+-- Scores carried over from earlier generations can't be told apart from the
+-- generation they were created in, so drop them.
+DELETE FROM post_scores WHERE valid_until_seq IS NULL OR valid_until_seq != generation_seq + 1;
+ALTER TABLE post_scores
+DROP COLUMN valid_until_seq,
+DROP COLUMN superseded_at,
+DROP COLUMN likes;
+DROP TRIGGER candidate_posts_init_post_like_counts ON candidate_posts;
+DROP FUNCTION init_post_like_counts;
+DROP TRIGGER candidate_likes_update_post_like_counts ON candidate_likes;
+DROP FUNCTION update_post_like_counts;
+DROP TABLE post_like_counts;
@@ -0,0 +1,97 @@
+CREATE TABLE post_like_counts (
+    uri TEXT PRIMARY KEY REFERENCES candidate_posts (uri) ON DELETE CASCADE,
+    likes BIGINT NOT NULL
+);
+
+INSERT INTO post_like_counts (uri, likes)
+SELECT cl.subject_uri, COUNT(*)
+FROM candidate_likes AS cl
+INNER JOIN candidate_posts AS cp ON cl.subject_uri = cp.uri
+WHERE cl.deleted_at IS NULL
+GROUP BY cl.subject_uri;
+
+-- Keeps post_like_counts in step with candidate_likes, however the likes are
+-- created or deleted. Likes of posts we don't have are counted when the post
+-- is created.
+CREATE FUNCTION update_post_like_counts() RETURNS TRIGGER AS $$
+DECLARE
+    delta BIGINT := 0;
+    subject TEXT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        subject := NEW.subject_uri;
+        IF NEW.deleted_at IS NULL THEN
+            delta := 1;
+        END IF;
+    ELSIF TG_OP = 'DELETE' THEN
+        subject := OLD.subject_uri;
+        IF OLD.deleted_at IS NULL THEN
+            delta := -1;
+        END IF;
+    ELSE
+        subject := NEW.subject_uri;
+        IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+            delta := -1;
+        ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+            delta := 1;
+        END IF;
+    END IF;
+
+    IF delta != 0 THEN
+        INSERT INTO post_like_counts (uri, likes)
+        SELECT cp.uri, delta
+        FROM candidate_posts AS cp
+        WHERE cp.uri = subject
+        ON CONFLICT (uri) DO UPDATE
+            SET likes = post_like_counts.likes + excluded.likes;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER candidate_likes_update_post_like_counts
+AFTER INSERT OR UPDATE OF deleted_at OR DELETE ON candidate_likes
+FOR EACH ROW EXECUTE FUNCTION update_post_like_counts();
+
+CREATE FUNCTION init_post_like_counts() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO post_like_counts (uri, likes)
+    SELECT NEW.uri, COUNT(*)
+    FROM candidate_likes AS cl
+    WHERE cl.subject_uri = NEW.uri AND cl.deleted_at IS NULL
+    HAVING COUNT(*) > 0
+    ON CONFLICT (uri) DO NOTHING;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER candidate_posts_init_post_like_counts
+AFTER INSERT ON candidate_posts
+FOR EACH ROW EXECUTE FUNCTION init_post_like_counts();
+
+-- Scores are now kept until they're superseded, rather than only belonging to
+-- the generation they were created in. A score is part of every generation
+-- from generation_seq up to, but not including, valid_until_seq. Algorithms
+-- that rescore every post set valid_until_seq to the next sequence number
+-- when inserting.
+ALTER TABLE post_scores
+ADD COLUMN valid_until_seq BIGINT,
+ADD COLUMN superseded_at TIMESTAMPTZ,
+ADD COLUMN likes BIGINT;
+UPDATE post_scores SET valid_until_seq = generation_seq + 1;
+CREATE INDEX post_scores_current_idx ON post_scores (alg, uri)
+WHERE valid_until_seq IS NULL;
+
+-- post_score_generations records every generation, as incremental algorithms
+-- don't insert scores into every generation.
+CREATE TABLE post_score_generations (
+    alg TEXT NOT NULL,
+    generation_seq BIGINT NOT NULL,
+    generated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (alg, generation_seq)
+);
+
+INSERT INTO post_score_generations (alg, generation_seq, generated_at)
+SELECT ps.alg, ps.generation_seq, MIN(ps.generated_at)
+FROM post_scores AS ps
+GROUP BY ps.alg, ps.generation_seq;
@@ -0,0 +1,10 @@
+-- leader_leases elects a single replica to run each singleton process, such
+-- as the score materializer. The holder must renew its lease before it
+-- expires, or another replica may take over.
+CREATE TABLE leader_leases (
+    name TEXT PRIMARY KEY,
+    holder TEXT NOT NULL,
+    -- acquired_at is when the current holder became leader.
+    acquired_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
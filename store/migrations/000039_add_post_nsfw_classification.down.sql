@@ -0,0 +1,5 @@
+ALTER TABLE candidate_posts DROP COLUMN is_nsfw;
+DROP FUNCTION classify_post_nsfw;
+ALTER TABLE candidate_actors DROP COLUMN nsfw_override;
+DROP TABLE nsfw_rules;
+DROP TYPE nsfw_rule_kind;
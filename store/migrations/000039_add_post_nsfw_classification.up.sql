@@ -0,0 +1,73 @@
+CREATE TYPE nsfw_rule_kind AS ENUM ('hashtag', 'post_label', 'profile_label');
+
+-- nsfw_rules mark a post as NSFW if it has one of the hashtags, one of the
+-- self labels on the post, or its author has one of the self labels on their
+-- profile.
+CREATE TABLE nsfw_rules (
+    kind NSFW_RULE_KIND NOT NULL,
+    value TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (kind, value)
+);
+
+INSERT INTO nsfw_rules (kind, value, created_at)
+SELECT 'hashtag', v, NOW()
+FROM UNNEST(ARRAY['nsfw', 'mursuit', 'murrsuit', 'nsfwfurry', 'furrynsfw']) AS v
+UNION ALL
+SELECT 'post_label', v, NOW()
+FROM UNNEST(ARRAY['porn', 'nudity', 'sexual']) AS v
+UNION ALL
+SELECT 'profile_label', v, NOW()
+FROM UNNEST(ARRAY['porn', 'nudity', 'sexual']) AS v;
+
+-- nsfw_override is set by moderators to treat all of an actor's posts as NSFW
+-- (TRUE) or not (FALSE), regardless of the rules.
+ALTER TABLE candidate_actors ADD COLUMN nsfw_override BOOLEAN;
+
+-- classify_post_nsfw returns whether a post is NSFW. The author's
+-- nsfw_override takes precedence over the nsfw_rules.
+CREATE FUNCTION classify_post_nsfw(
+    post_actor_did TEXT,
+    post_hashtags TEXT [],
+    post_self_labels TEXT []
+)
+RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+    SELECT COALESCE(
+        (
+            SELECT ca.nsfw_override
+            FROM candidate_actors AS ca
+            WHERE ca.did = post_actor_did
+        ),
+        EXISTS (
+            SELECT 1
+            FROM nsfw_rules AS r
+            WHERE
+                (r.kind = 'hashtag' AND r.value = ANY(post_hashtags))
+                OR (r.kind = 'post_label' AND r.value = ANY(post_self_labels))
+                OR (
+                    r.kind = 'profile_label'
+                    AND EXISTS (
+                        SELECT 1
+                        FROM candidate_actors AS ca
+                        INNER JOIN actor_profiles AS ap
+                            ON
+                                ca.did = ap.actor_did
+                                AND ca.current_profile_commit_cid
+                                = ap.commit_cid
+                        WHERE
+                            ca.did = post_actor_did
+                            AND r.value = ANY(ap.self_labels)
+                    )
+                )
+        )
+    )
+$$;
+
+-- is_nsfw is classified when the post is created, and reclassified when the
+-- rules, the author's override or their profile change.
+ALTER TABLE candidate_posts ADD COLUMN is_nsfw BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE candidate_posts
+SET is_nsfw = TRUE
+WHERE classify_post_nsfw(actor_did, hashtags, self_labels);
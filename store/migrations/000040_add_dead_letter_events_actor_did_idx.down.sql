@@ -0,0 +1,5 @@
+DROP INDEX dead_letter_events_actor_did_idx;
+
+CREATE INDEX dead_letter_events_next_attempt_at_idx
+ON public.dead_letter_events (next_attempt_at, id)
+WHERE next_attempt_at IS NOT NULL;
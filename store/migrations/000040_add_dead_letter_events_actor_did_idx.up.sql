@@ -0,0 +1,7 @@
+-- Dead lettered events are now retried in order per actor, rather than in
+-- order of when they are due.
+DROP INDEX dead_letter_events_next_attempt_at_idx;
+
+CREATE INDEX dead_letter_events_actor_did_idx
+ON public.dead_letter_events (actor_did, id)
+WHERE next_attempt_at IS NOT NULL;
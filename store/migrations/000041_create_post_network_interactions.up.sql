@@ -0,0 +1,14 @@
+-- post_network_interactions holds the likes and reposts recently added to
+-- post_network_counts, so that they aren't counted again when the ingester
+-- sees them more than once, e.g after rewinding its cursor on restart. Rows
+-- are only needed for as long as an event may be seen again, so are pruned
+-- after a day.
+CREATE TABLE post_network_interactions (
+    uri TEXT PRIMARY KEY,
+    subject_uri TEXT NOT NULL,
+    is_repost BOOLEAN NOT NULL,
+    counted_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX post_network_interactions_counted_at_idx
+ON public.post_network_interactions (counted_at);
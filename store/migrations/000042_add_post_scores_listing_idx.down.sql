@@ -0,0 +1,2 @@
+DROP INDEX post_scores_carried_over_idx;
+DROP INDEX post_scores_current_score_uri_idx;
@@ -0,0 +1,12 @@
+-- Supports listing a generation's scores in score order. Scores from earlier
+-- generations which are still current are read from the first index. Those
+-- which have since been superseded are found with the second, which excludes
+-- scores only part of the generation they were created in, as algorithms
+-- which rescore every post create.
+CREATE INDEX post_scores_current_score_uri_idx ON post_scores (
+    alg, score DESC, uri DESC
+)
+WHERE valid_until_seq IS NULL;
+
+CREATE INDEX post_scores_carried_over_idx ON post_scores (alg, valid_until_seq)
+WHERE valid_until_seq > generation_seq + 1;
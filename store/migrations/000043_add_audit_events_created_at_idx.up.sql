@@ -0,0 +1,2 @@
+-- Supports the labeler reading audit events in the order they were created.
+CREATE INDEX audit_events_created_at_id_idx ON audit_events (created_at, id);
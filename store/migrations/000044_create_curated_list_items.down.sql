@@ -0,0 +1 @@
+DROP TABLE curated_list_items;
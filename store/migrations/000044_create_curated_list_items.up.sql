@@ -0,0 +1,12 @@
+-- curated_list_items records the listitem records adding actors to our
+-- curated lists, so that an actor's membership can be looked up without
+-- listing every listitem in the repository.
+CREATE TABLE curated_list_items (
+    list_uri TEXT NOT NULL,
+    subject_did TEXT NOT NULL,
+    rkey TEXT NOT NULL,
+    PRIMARY KEY (list_uri, subject_did)
+);
+
+CREATE INDEX curated_list_items_subject_did_idx
+ON public.curated_list_items (subject_did);
@@ -0,0 +1,84 @@
+-- reciprocal_like_pairs are the pairs of actors flagged by reciprocal_likers,
+-- each stored both ways round. Finding them scans every like in the period,
+-- so they're refreshed periodically rather than whenever likes are weighted.
+CREATE TABLE reciprocal_like_pairs (
+    actor_did TEXT NOT NULL,
+    partner_did TEXT NOT NULL,
+    likes_given BIGINT NOT NULL,
+    likes_received BIGINT NOT NULL,
+    refreshed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (actor_did, partner_did)
+);
+
+DROP FUNCTION weighted_candidate_likes;
+
+-- weighted_candidate_likes returns the likes of candidate posts indexed since
+-- after, each weighted by the dampeners that apply to it, so that scoring
+-- algorithms can opt into them:
+--
+-- * Likes from actors approved since new_liker_since are weighted by
+--   new_liker_weight.
+-- * Only the first max_likes_per_author_per_day likes from an actor to
+--   another's posts count each day. 0 disables the cap.
+-- * Likes between the pairs in reciprocal_like_pairs are weighted by
+--   reciprocal_weight.
+CREATE FUNCTION weighted_candidate_likes(
+    after TIMESTAMPTZ,
+    new_liker_since TIMESTAMPTZ,
+    new_liker_weight FLOAT8,
+    max_likes_per_author_per_day INT,
+    reciprocal_weight FLOAT8
+)
+RETURNS TABLE (
+    subject_uri TEXT,
+    liker_did TEXT,
+    author_did TEXT,
+    weight FLOAT8
+)
+LANGUAGE sql STABLE AS $$
+    WITH likes AS (
+        SELECT
+            cl.subject_uri,
+            cl.actor_did AS liker_did,
+            cp.actor_did AS author_did,
+            ROW_NUMBER() OVER (
+                PARTITION BY
+                    cl.actor_did,
+                    cp.actor_did,
+                    DATE_TRUNC('day', cl.created_at)
+                ORDER BY cl.created_at ASC, cl.uri ASC
+            ) AS nth_of_day
+        FROM candidate_likes AS cl
+        INNER JOIN candidate_posts AS cp ON cl.subject_uri = cp.uri
+        WHERE
+            cl.deleted_at IS NULL
+            AND cp.deleted_at IS NULL
+            AND cp.indexed_at >= after
+    )
+
+    SELECT
+        l.subject_uri,
+        l.liker_did,
+        l.author_did,
+        (
+            CASE
+                WHEN
+                    max_likes_per_author_per_day > 0
+                    AND l.nth_of_day > max_likes_per_author_per_day
+                    THEN 0
+                ELSE 1
+            END
+            * CASE
+                WHEN ca.approved_at >= new_liker_since THEN new_liker_weight
+                ELSE 1
+            END
+            * CASE
+                WHEN rlp.actor_did IS NOT NULL THEN reciprocal_weight
+                ELSE 1
+            END
+        )::FLOAT8
+    FROM likes AS l
+    LEFT JOIN candidate_actors AS ca ON l.liker_did = ca.did
+    LEFT JOIN reciprocal_like_pairs AS rlp
+        ON l.liker_did = rlp.actor_did AND l.author_did = rlp.partner_did
+$$;
@@ -0,0 +1,226 @@
+package store_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+func TestPGXStore_RescoreClassicPostScores(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	const authorDID = "did:plc:author"
+	const fanDID = "did:plc:fan"
+	for _, did := range []string{authorDID, fanDID} {
+		_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+			Status: v1.ActorStatus_ACTOR_STATUS_APPROVED,
+			DID:    did,
+		})
+		require.NoError(t, err)
+	}
+
+	now := time.Now()
+	likedURI := "at://" + authorDID + "/app.bsky.feed.post/liked"
+	otherURI := "at://" + authorDID + "/app.bsky.feed.post/other"
+	for _, uri := range []string{likedURI, otherURI} {
+		require.NoError(t, harness.Store.CreatePost(ctx, store.CreatePostOpts{
+			URI:       uri,
+			ActorDID:  authorDID,
+			CreatedAt: now,
+			IndexedAt: now.Add(-time.Hour),
+		}))
+	}
+
+	after := now.Add(-24 * time.Hour)
+	rescore := func(want store.RescoreResult) int64 {
+		t.Helper()
+		res, err := harness.Store.RescoreClassicPostScores(ctx, after, 0.05)
+		require.NoError(t, err)
+		want.GenerationSeq = res.GenerationSeq
+		assert.Equal(t, want, res)
+		return res.GenerationSeq
+	}
+	listScored := func(seq int64) map[string]float32 {
+		t.Helper()
+		posts, err := harness.Store.ListScoredPosts(ctx, store.ListPostsForHotFeedOpts{
+			Limit: 100,
+			Alg:   "classic",
+			Cursor: store.ListPostsForHotFeedCursor{
+				GenerationSeq: seq,
+				AfterScore:    float32(math.Inf(1)),
+			},
+		})
+		require.NoError(t, err)
+		scores := map[string]float32{}
+		for _, p := range posts {
+			scores[p.URI] = p.Score
+		}
+		return scores
+	}
+
+	// Every post is scored the first time, and nothing has changed the
+	// second time.
+	first := rescore(store.RescoreResult{Rescored: 2})
+	second := rescore(store.RescoreResult{})
+	assert.Equal(t, map[string]float32{likedURI: 0, otherURI: 0}, listScored(second))
+
+	// Only the liked post is rescored.
+	require.NoError(t, harness.Store.CreateLike(ctx, store.CreateLikeOpts{
+		URI:        "at://" + fanDID + "/app.bsky.feed.like/1",
+		ActorDID:   fanDID,
+		SubjectURI: likedURI,
+		CreatedAt:  now,
+		IndexedAt:  now,
+	}))
+	third := rescore(store.RescoreResult{Rescored: 1, Superseded: 1})
+	scores := listScored(third)
+	assert.InDelta(t, 1/math.Pow(3, 1.85), scores[likedURI], 0.001)
+	assert.Zero(t, scores[otherURI])
+	// Earlier generations are unchanged.
+	assert.Equal(t, map[string]float32{likedURI: 0, otherURI: 0}, listScored(first))
+
+	// The incremental score matches a full recomputation.
+	db, err := pgxpool.New(ctx, harness.DatabaseURL)
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+	full, err := testenv.MaterializeFullClassicScores(ctx, db, after)
+	require.NoError(t, err)
+	assert.InDelta(t, scores[likedURI], listScored(full)[likedURI], 0.001)
+
+	// Deleted posts are dropped.
+	require.NoError(t, harness.Store.DeleteLike(ctx, store.DeleteLikeOpts{
+		URI: "at://" + fanDID + "/app.bsky.feed.like/1",
+	}))
+	require.NoError(t, harness.Store.DeletePost(ctx, store.DeletePostOpts{URI: otherURI}))
+	// The full recomputation superseded everything, so both are rescored.
+	fourth := rescore(store.RescoreResult{Rescored: 1})
+	assert.Equal(t, map[string]float32{likedURI: 0}, listScored(fourth))
+}
+
+func TestPGXStore_RescoreDiscoveryPostScores(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	const authorDID = "did:plc:author"
+	const fanDID = "did:plc:fan"
+	for _, did := range []string{authorDID, fanDID} {
+		_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+			Status: v1.ActorStatus_ACTOR_STATUS_APPROVED,
+			DID:    did,
+		})
+		require.NoError(t, err)
+	}
+
+	now := time.Now()
+	uri := func(rkey string) string {
+		return "at://" + authorDID + "/app.bsky.feed.post/" + rkey
+	}
+	for rkey, opts := range map[string]store.CreatePostOpts{
+		"liked":   {HasMedia: true, IndexedAt: now.Add(-2 * time.Hour)},
+		"unliked": {HasMedia: true, IndexedAt: now.Add(-2 * time.Hour)},
+		"text":    {IndexedAt: now.Add(-2 * time.Hour)},
+		"fresh":   {HasMedia: true, IndexedAt: now.Add(-10 * time.Minute)},
+	} {
+		opts.URI = uri(rkey)
+		opts.ActorDID = authorDID
+		opts.CreatedAt = opts.IndexedAt
+		require.NoError(t, harness.Store.CreatePost(ctx, opts))
+	}
+	require.NoError(t, harness.Store.CreateLike(ctx, store.CreateLikeOpts{
+		URI:        "at://" + fanDID + "/app.bsky.feed.like/1",
+		ActorDID:   fanDID,
+		SubjectURI: uri("liked"),
+		CreatedAt:  now,
+		IndexedAt:  now,
+	}))
+
+	after := now.Add(-24 * time.Hour)
+	rescore := func(want store.RescoreResult) int64 {
+		t.Helper()
+		res, err := harness.Store.RescoreDiscoveryPostScores(ctx, after, 0.05)
+		require.NoError(t, err)
+		want.GenerationSeq = res.GenerationSeq
+		assert.Equal(t, want, res)
+		return res.GenerationSeq
+	}
+	seq := rescore(store.RescoreResult{Rescored: 2})
+	opts := store.ListPostsForHotFeedOpts{
+		Limit: 100,
+		Alg:   "discovery",
+		Cursor: store.ListPostsForHotFeedCursor{
+			GenerationSeq: seq,
+			AfterScore:    float32(math.Inf(1)),
+		},
+	}
+
+	// Only image posts more than an hour old are scored, and the post with
+	// no likes comes first.
+	posts, err := harness.Store.ListScoredPosts(ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+	assert.Equal(t, uri("unliked"), posts[0].URI)
+	assert.InDelta(t, 1/math.Sqrt(4), posts[0].Score, 0.01)
+	assert.Equal(t, uri("liked"), posts[1].URI)
+	assert.InDelta(t, 1/((1+1.0/3)*math.Sqrt(4)), posts[1].Score, 0.01)
+
+	// Jittered scores stay within the jitter, and the same seed gives the
+	// same scores.
+	jittered := func(seed string) map[string]float32 {
+		t.Helper()
+		opts := opts
+		opts.Seed, opts.Jitter = seed, 0.3
+		posts, err := harness.Store.ListScoredPosts(ctx, opts)
+		require.NoError(t, err)
+		scores := map[string]float32{}
+		for _, p := range posts {
+			scores[p.URI] = p.Score
+		}
+		return scores
+	}
+	scores := jittered("seed")
+	require.Len(t, scores, 2)
+	for _, p := range posts {
+		assert.InDelta(t, p.Score, scores[p.URI], float64(p.Score)*0.3)
+	}
+	assert.Equal(t, scores, jittered("seed"))
+
+	// Nothing has changed, so nothing is rescored.
+	rescore(store.RescoreResult{})
+
+	// Only the newly liked post is rescored, and deleted posts are dropped.
+	require.NoError(t, harness.Store.CreateLike(ctx, store.CreateLikeOpts{
+		URI:        "at://" + fanDID + "/app.bsky.feed.like/2",
+		ActorDID:   fanDID,
+		SubjectURI: uri("unliked"),
+		CreatedAt:  now,
+		IndexedAt:  now,
+	}))
+	require.NoError(t, harness.Store.DeletePost(ctx, store.DeletePostOpts{URI: uri("liked")}))
+	opts.Cursor.GenerationSeq = rescore(store.RescoreResult{Rescored: 1, Superseded: 2})
+	posts, err = harness.Store.ListScoredPosts(ctx, opts)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, uri("unliked"), posts[0].URI)
+	assert.InDelta(t, 1/((1+1.0/3)*math.Sqrt(4)), posts[0].Score, 0.01)
+}
@@ -1,14 +1,20 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/bluesky-social/indigo/api/bsky"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/xid"
@@ -59,6 +65,12 @@ func ConnectPGXStore(ctx context.Context, log *slog.Logger, connector PoolConnec
 		return nil, fmt.Errorf("generating pool config: %w", err)
 	}
 
+	// Queries like ListScoredPosts rely on their parameters being known when
+	// planned, to fold away the filters which don't apply and read from
+	// indexes in order. Generic plans, which Postgres may switch to for
+	// prepared statements, can't do this.
+	poolCfg.ConnConfig.RuntimeParams["plan_cache_mode"] = "force_custom_plan"
+
 	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
 	if err != nil {
 		return nil, fmt.Errorf("connecting pool: %w", err)
@@ -106,7 +118,7 @@ func actorToProto(actor gen.CandidateActor) (*v1.Actor, error) {
 	if err != nil {
 		return nil, fmt.Errorf("converting status: %w", err)
 	}
-	return &v1.Actor{
+	out := &v1.Actor{
 		Did:       actor.DID,
 		IsArtist:  actor.IsArtist,
 		Comment:   actor.Comment,
@@ -114,7 +126,30 @@ func actorToProto(actor gen.CandidateActor) (*v1.Actor, error) {
 		CreatedAt: timestamppb.New(actor.CreatedAt.Time),
 		Roles:     actor.Roles,
 		HeldUntil: timestamppb.New(actor.HeldUntil.Time),
-	}, nil
+		Handle:    actor.Handle.String,
+	}
+	if actor.DeletedAt.Valid {
+		out.DeletedAt = timestamppb.New(actor.DeletedAt.Time)
+	}
+	if actor.PurgedAt.Valid {
+		out.PurgedAt = timestamppb.New(actor.PurgedAt.Time)
+	}
+	if actor.ApprovedAt.Valid {
+		out.ApprovedAt = timestamppb.New(actor.ApprovedAt.Time)
+	}
+	if actor.NSFWOverride.Valid {
+		out.NsfwOverride = v1.ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_SFW
+		if actor.NSFWOverride.Bool {
+			out.NsfwOverride = v1.ActorNSFWOverride_ACTOR_NSFW_OVERRIDE_NSFW
+		}
+	}
+	if !actor.AccountActive {
+		out.AccountStatus = actor.AccountStatus.String
+		if out.AccountStatus == "" {
+			out.AccountStatus = "inactive"
+		}
+	}
+	return out, nil
 }
 
 func endSpan(span trace.Span, err error) {
@@ -271,6 +306,278 @@ func (s *PGXStore) CreateActor(ctx context.Context, opts CreateActorOpts) (out *
 	return convertedActor, nil
 }
 
+// UpdateActorAccountStatus records the status of an actor's account on the
+// network. status is the reason an inactive account is inactive, if known.
+// ErrNotFound is returned if the actor does not exist, or their account status
+// is unchanged.
+func (s *PGXStore) UpdateActorAccountStatus(
+	ctx context.Context, did string, active bool, status string,
+) (out *v1.Actor, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.update_actor_account_status")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	actor, err := s.queries.UpdateCandidateActorAccountStatus(ctx, gen.UpdateCandidateActorAccountStatusParams{
+		DID:           did,
+		AccountActive: active,
+		AccountStatus: pgtype.Text{String: status, Valid: status != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing UpdateCandidateActorAccountStatus query: %w", convertPGXError(err))
+	}
+	return actorToProto(actor)
+}
+
+// UpdateActorHandle sets the current handle of an actor, and adds it to their
+// handle history.
+func (s *PGXStore) UpdateActorHandle(
+	ctx context.Context, did string, handle string, seenAt time.Time,
+) (out *v1.Actor, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.update_actor_handle")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	actor, err := s.queries.UpdateCandidateActorHandle(ctx, gen.UpdateCandidateActorHandleParams{
+		DID:    did,
+		Handle: handle,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing UpdateCandidateActorHandle query: %w", convertPGXError(err))
+	}
+	err = s.queries.CreateActorHandle(ctx, gen.CreateActorHandleParams{
+		ActorDID: did,
+		Handle:   handle,
+		SeenAt:   pgtype.Timestamptz{Time: seenAt, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing CreateActorHandle query: %w", convertPGXError(err))
+	}
+	return actorToProto(actor)
+}
+
+// SetActorNSFWOverride overrides whether all of an actor's posts are NSFW.
+// If override is tristate.Maybe, their posts are classified by the NSFW rules
+// again. Their existing posts are reclassified.
+//
+// This should be called within a transaction.
+func (s *PGXStore) SetActorNSFWOverride(
+	ctx context.Context, did string, override tristate.Tristate,
+) (out *v1.Actor, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.set_actor_nsfw_override")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	actor, err := s.queries.UpdateCandidateActorNSFWOverride(ctx, gen.UpdateCandidateActorNSFWOverrideParams{
+		DID:          did,
+		NSFWOverride: tristateToPgtypeBool(override),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing UpdateCandidateActorNSFWOverride query: %w", convertPGXError(err))
+	}
+	if _, err := s.queries.ReclassifyActorNSFWPosts(ctx, did); err != nil {
+		return nil, fmt.Errorf("executing ReclassifyActorNSFWPosts query: %w", convertPGXError(err))
+	}
+	return actorToProto(actor)
+}
+
+// ListActorHandles returns the handles an actor has used, most recent first.
+func (s *PGXStore) ListActorHandles(ctx context.Context, did string) (out []*v1.ActorHandle, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_actor_handles")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	handles, err := s.queries.ListActorHandles(ctx, did)
+	if err != nil {
+		return nil, fmt.Errorf("executing ListActorHandles query: %w", convertPGXError(err))
+	}
+	out = make([]*v1.ActorHandle, 0, len(handles))
+	for _, h := range handles {
+		out = append(out, &v1.ActorHandle{
+			Handle: h.Handle,
+			SeenAt: timestamppb.New(h.SeenAt.Time),
+		})
+	}
+	return out, nil
+}
+
+// ActorPurgeGracePeriod is how long an actor's data is hidden, and can be
+// restored, before it is permanently deleted.
+const ActorPurgeGracePeriod = 7 * 24 * time.Hour
+
+// PurgeActorTaskPayload is the payload of TaskTypePurgeActor tasks.
+type PurgeActorTaskPayload struct {
+	ActorDID string `json:"actor_did"`
+	// DeletedAt identifies the deletion the purge was scheduled for. If the
+	// actor is restored, and deleted again, the purge does nothing.
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// DeleteActorData hides all of an actor's posts, likes and follows, and
+// schedules them to be purged after ActorPurgeGracePeriod. ErrNotFound is
+// returned if the actor does not exist or their data is already deleted.
+//
+// This should be called within a transaction.
+func (s *PGXStore) DeleteActorData(ctx context.Context, did string) (out *v1.Actor, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.delete_actor_data")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	actor, err := s.queries.SoftDeleteCandidateActor(ctx, did)
+	if err != nil {
+		return nil, fmt.Errorf("executing SoftDeleteCandidateActor query: %w", convertPGXError(err))
+	}
+	err = s.queries.SoftDeleteCandidatePostsByActor(ctx, gen.SoftDeleteCandidatePostsByActorParams{
+		DeletedAt: actor.DeletedAt,
+		ActorDID:  did,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing SoftDeleteCandidatePostsByActor query: %w", convertPGXError(err))
+	}
+	err = s.queries.SoftDeleteCandidateLikesByActor(ctx, gen.SoftDeleteCandidateLikesByActorParams{
+		DeletedAt: actor.DeletedAt,
+		ActorDID:  did,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing SoftDeleteCandidateLikesByActor query: %w", convertPGXError(err))
+	}
+	err = s.queries.SoftDeleteCandidateFollowsByActor(ctx, gen.SoftDeleteCandidateFollowsByActorParams{
+		DeletedAt: actor.DeletedAt,
+		ActorDID:  did,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing SoftDeleteCandidateFollowsByActor query: %w", convertPGXError(err))
+	}
+
+	_, err = s.EnqueueTask(ctx, EnqueueTaskOpts{
+		Type: TaskTypePurgeActor,
+		Payload: PurgeActorTaskPayload{
+			ActorDID:  did,
+			DeletedAt: actor.DeletedAt.Time,
+		},
+		RunAt: actor.DeletedAt.Time.Add(ActorPurgeGracePeriod),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("enqueuing purge: %w", err)
+	}
+	return actorToProto(actor)
+}
+
+// RestoreActorData undoes DeleteActorData. ErrNotFound is returned if the
+// actor does not exist, their data is not deleted or it has been purged.
+//
+// This should be called within a transaction.
+func (s *PGXStore) RestoreActorData(ctx context.Context, did string) (out *v1.Actor, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.restore_actor_data")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	existing, err := s.queries.GetCandidateActorByDID(ctx, did)
+	if err != nil {
+		return nil, fmt.Errorf("executing GetCandidateActorByDID query: %w", convertPGXError(err))
+	}
+	actor, err := s.queries.RestoreCandidateActor(ctx, did)
+	if err != nil {
+		return nil, fmt.Errorf("executing RestoreCandidateActor query: %w", convertPGXError(err))
+	}
+	err = s.queries.RestoreCandidatePostsByActor(ctx, gen.RestoreCandidatePostsByActorParams{
+		ActorDID:  did,
+		DeletedAt: existing.DeletedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing RestoreCandidatePostsByActor query: %w", convertPGXError(err))
+	}
+	err = s.queries.RestoreCandidateLikesByActor(ctx, gen.RestoreCandidateLikesByActorParams{
+		ActorDID:  did,
+		DeletedAt: existing.DeletedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing RestoreCandidateLikesByActor query: %w", convertPGXError(err))
+	}
+	err = s.queries.RestoreCandidateFollowsByActor(ctx, gen.RestoreCandidateFollowsByActorParams{
+		ActorDID:  did,
+		DeletedAt: existing.DeletedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing RestoreCandidateFollowsByActor query: %w", convertPGXError(err))
+	}
+	return actorToProto(actor)
+}
+
+type PurgeActorDataResult struct {
+	Posts   int64
+	Likes   int64
+	Follows int64
+}
+
+// PurgeActorData permanently deletes the data of an actor which was deleted at
+// deletedAt, including the scores of their posts. The actor is kept as a
+// tombstone for the audit log. ErrNotFound is returned if the actor has since
+// been restored, or has already been purged.
+//
+// This should be called within a transaction.
+func (s *PGXStore) PurgeActorData(
+	ctx context.Context, did string, deletedAt time.Time,
+) (out PurgeActorDataResult, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.purge_actor_data")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	_, err = s.queries.PurgeCandidateActor(ctx, gen.PurgeCandidateActorParams{
+		DID:       did,
+		DeletedAt: pgtype.Timestamptz{Time: deletedAt, Valid: true},
+	})
+	if err != nil {
+		return out, fmt.Errorf("executing PurgeCandidateActor query: %w", convertPGXError(err))
+	}
+	if _, err := s.queries.DeletePostScoresByActor(ctx, did); err != nil {
+		return out, fmt.Errorf("executing DeletePostScoresByActor query: %w", convertPGXError(err))
+	}
+	out.Posts, err = s.queries.DeleteCandidatePostsByActor(ctx, did)
+	if err != nil {
+		return out, fmt.Errorf("executing DeleteCandidatePostsByActor query: %w", convertPGXError(err))
+	}
+	out.Likes, err = s.queries.DeleteCandidateLikesByActor(ctx, did)
+	if err != nil {
+		return out, fmt.Errorf("executing DeleteCandidateLikesByActor query: %w", convertPGXError(err))
+	}
+	out.Follows, err = s.queries.DeleteCandidateFollowsByActor(ctx, did)
+	if err != nil {
+		return out, fmt.Errorf("executing DeleteCandidateFollowsByActor query: %w", convertPGXError(err))
+	}
+	if _, err := s.queries.DeleteActorProfiles(ctx, did); err != nil {
+		return out, fmt.Errorf("executing DeleteActorProfiles query: %w", convertPGXError(err))
+	}
+	if _, err := s.queries.DeleteActorHandles(ctx, did); err != nil {
+		return out, fmt.Errorf("executing DeleteActorHandles query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// DeleteActorProfiles deletes an actor's current profile and profile history.
+//
+// This should be called within a transaction.
+func (s *PGXStore) DeleteActorProfiles(ctx context.Context, did string) (err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.delete_actor_profiles")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	if err := s.queries.ClearCandidateActorProfile(ctx, did); err != nil {
+		return fmt.Errorf("executing ClearCandidateActorProfile query: %w", convertPGXError(err))
+	}
+	if _, err := s.queries.DeleteActorProfiles(ctx, did); err != nil {
+		return fmt.Errorf("executing DeleteActorProfiles query: %w", convertPGXError(err))
+	}
+	return nil
+}
+
 type UpdateActorOpts struct {
 	// DID is the DID of the actor to update.
 	DID string
@@ -373,10 +680,32 @@ func (s *PGXStore) CreateLatestActorProfile(ctx context.Context, opts CreateLate
 		},
 		SelfLabels: opts.SelfLabels,
 	}
-	err = s.queries.CreateLatestActorProfile(ctx, queryParams)
+	queries := s.queries.WithTx(tx)
+
+	// Without a previous profile, the actor's posts were classified as though
+	// they had no self labels.
+	previousSelfLabels := []string{}
+	previous, err := queries.GetLatestActorProfile(ctx, opts.ActorDID)
+	switch {
+	case err == nil:
+		previousSelfLabels = previous.SelfLabels
+	case !errors.Is(err, pgx.ErrNoRows):
+		return fmt.Errorf("executing GetLatestActorProfile query: %w", convertPGXError(err))
+	}
+
+	err = queries.CreateLatestActorProfile(ctx, queryParams)
 	if err != nil {
 		return fmt.Errorf("executing CreateLatestActorProfile query: %w", convertPGXError(err))
 	}
+	// The actor's self labels may have changed whether their posts are NSFW.
+	// Reclassifying touches all of their posts, so it's skipped when the
+	// self labels are unchanged, as they are for most profile updates.
+	if !sameSelfLabels(previousSelfLabels, opts.SelfLabels) {
+		_, err = queries.ReclassifyActorNSFWPosts(ctx, opts.ActorDID)
+		if err != nil {
+			return fmt.Errorf("executing ReclassifyActorNSFWPosts query: %w", convertPGXError(err))
+		}
+	}
 
 	if err = tx.Commit(ctx); err != nil {
 		return fmt.Errorf("committing transaction: %w", err)
@@ -385,6 +714,15 @@ func (s *PGXStore) CreateLatestActorProfile(ctx context.Context, opts CreateLate
 	return nil
 }
 
+// sameSelfLabels reports whether a and b contain the same labels, ignoring
+// order and duplicates.
+func sameSelfLabels(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
 type CreateLikeOpts struct {
 	URI        string
 	ActorDID   string
@@ -509,6 +847,95 @@ func (s *PGXStore) DeletePost(ctx context.Context, opts DeletePostOpts) (err err
 	return nil
 }
 
+// ListNSFWRules returns the rules used to classify whether posts are NSFW.
+func (s *PGXStore) ListNSFWRules(ctx context.Context) (out []gen.NSFWRule, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_nsfw_rules")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ListNSFWRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing ListNSFWRules query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// CreateNSFWRule adds a rule used to classify whether posts are NSFW. Only new
+// posts are classified using it, until existing posts are reclassified with
+// EnqueueNSFWReclassification. ErrNotFound is returned if the rule already
+// exists.
+func (s *PGXStore) CreateNSFWRule(ctx context.Context, kind gen.NSFWRuleKind, value string) (err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.create_nsfw_rule")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	created, err := s.queries.CreateNSFWRule(ctx, gen.CreateNSFWRuleParams{
+		Kind:  kind,
+		Value: value,
+	})
+	if err != nil {
+		return fmt.Errorf("executing CreateNSFWRule query: %w", convertPGXError(err))
+	}
+	if created == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// DeleteNSFWRule removes a rule used to classify whether posts are NSFW. As
+// with CreateNSFWRule, existing posts aren't reclassified. ErrNotFound is
+// returned if the rule doesn't exist.
+func (s *PGXStore) DeleteNSFWRule(ctx context.Context, kind gen.NSFWRuleKind, value string) (err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.delete_nsfw_rule")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	deleted, err := s.queries.DeleteNSFWRule(ctx, gen.DeleteNSFWRuleParams{
+		Kind:  kind,
+		Value: value,
+	})
+	if err != nil {
+		return fmt.Errorf("executing DeleteNSFWRule query: %w", convertPGXError(err))
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ReclassifyNSFWPosts reclassifies whether up to batchSize posts are NSFW,
+// starting after afterURI. It returns the URI to carry on after, which is
+// empty once every post has been reclassified, and how many posts changed.
+func (s *PGXStore) ReclassifyNSFWPosts(
+	ctx context.Context, afterURI string, batchSize int,
+) (lastURI string, reclassified int64, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.reclassify_nsfw_posts")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err := s.queries.ReclassifyNSFWPosts(ctx, gen.ReclassifyNSFWPostsParams{
+		AfterURI:  afterURI,
+		BatchSize: int32(batchSize),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("executing ReclassifyNSFWPosts query: %w", convertPGXError(err))
+	}
+	return out.LastURI, out.Reclassified, nil
+}
+
+// EnqueueNSFWReclassification enqueues a task to reclassify whether every
+// post is NSFW, for after the NSFW rules have changed.
+func (s *PGXStore) EnqueueNSFWReclassification(ctx context.Context) (*v1.Task, error) {
+	return s.EnqueueTask(ctx, EnqueueTaskOpts{
+		Type:    TaskTypeReclassifyNSFWPosts,
+		Payload: ReclassifyNSFWPostsTaskPayload{},
+	})
+}
+
 type CreateFollowOpts struct {
 	URI        string
 	ActorDID   string
@@ -570,6 +997,10 @@ type ListPostsForNewFeedOpts struct {
 	AllowedEmbeds      []string
 	PinnedDIDs         []string
 	Limit              int
+	// IsArtist and ActorRoles filter posts by their actor. Posts by pinned
+	// actors are still filtered by these.
+	IsArtist   tristate.Tristate
+	ActorRoles []string
 }
 
 func tristateToPgtypeBool(t tristate.Tristate) pgtype.Bool {
@@ -596,6 +1027,8 @@ func (s *PGXStore) ListPostsForNewFeed(ctx context.Context, opts ListPostsForNew
 		AllowedEmbeds:      opts.AllowedEmbeds,
 		IsNSFW:             tristateToPgtypeBool(opts.IsNSFW),
 		PinnedDIDs:         opts.PinnedDIDs,
+		IsArtist:           tristateToPgtypeBool(opts.IsArtist),
+		ActorRoles:         opts.ActorRoles,
 	}
 	if opts.Limit != 0 {
 		queryParams.Limit = int32(opts.Limit)
@@ -635,6 +1068,14 @@ type ListPostsForHotFeedOpts struct {
 	IsNSFW             tristate.Tristate
 	AllowedEmbeds      []string
 	Limit              int
+	// IsArtist and ActorRoles filter posts by their actor.
+	IsArtist   tristate.Tristate
+	ActorRoles []string
+	// If Seed is set, each score is randomly jittered by up to Jitter, as a
+	// fraction of the score. The same seed always gives the same order, so
+	// should be kept between pages. Ignored by CountScoredPostsAbove.
+	Seed   string
+	Jitter float32
 }
 
 func (s *PGXStore) ListScoredPosts(ctx context.Context, opts ListPostsForHotFeedOpts) (out []gen.ListScoredPostsRow, err error) {
@@ -653,10 +1094,16 @@ func (s *PGXStore) ListScoredPosts(ctx context.Context, opts ListPostsForHotFeed
 		GenerationSeq:      opts.Cursor.GenerationSeq,
 		AfterScore:         opts.Cursor.AfterScore,
 		AfterURI:           opts.Cursor.AfterURI,
+		IsArtist:           tristateToPgtypeBool(opts.IsArtist),
+		ActorRoles:         opts.ActorRoles,
 	}
 	if opts.Limit != 0 {
 		queryParams.Limit = int32(opts.Limit)
 	}
+	if opts.Seed != "" {
+		queryParams.Seed = pgtype.Text{String: opts.Seed, Valid: true}
+		queryParams.Jitter = opts.Jitter
+	}
 
 	posts, err := s.queries.ListScoredPosts(ctx, queryParams)
 	if err != nil {
@@ -666,6 +1113,32 @@ func (s *PGXStore) ListScoredPosts(ctx context.Context, opts ListPostsForHotFeed
 	return posts, nil
 }
 
+// CountScoredPostsAbove counts the posts that ListScoredPosts would list
+// before opts.Cursor. opts.Limit is ignored.
+func (s *PGXStore) CountScoredPostsAbove(ctx context.Context, opts ListPostsForHotFeedOpts) (out int64, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.count_scored_posts_above")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.CountScoredPostsAbove(ctx, gen.CountScoredPostsAboveParams{
+		Alg:                opts.Alg,
+		GenerationSeq:      opts.Cursor.GenerationSeq,
+		Hashtags:           opts.Hashtags,
+		DisallowedHashtags: opts.DisallowedHashtags,
+		IsNSFW:             tristateToPgtypeBool(opts.IsNSFW),
+		Score:              opts.Cursor.AfterScore,
+		URI:                opts.Cursor.AfterURI,
+		AllowedEmbeds:      opts.AllowedEmbeds,
+		IsArtist:           tristateToPgtypeBool(opts.IsArtist),
+		ActorRoles:         opts.ActorRoles,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("executing CountScoredPostsAbove query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
 type ListPostsWithLikesOpts struct {
 	CursorTime time.Time
 	Limit      int
@@ -739,6 +1212,53 @@ func (s *PGXStore) ListAuditEvents(ctx context.Context, opts ListAuditEventsOpts
 	return out, nil
 }
 
+type ListAuditEventsCreatedAfterOpts struct {
+	// AfterCreatedAt and AfterID are the position of the last audit event
+	// the caller has seen.
+	AfterCreatedAt time.Time
+	AfterID        string
+
+	// Limit defaults to 100.
+	Limit int32
+}
+
+// ListAuditEventsCreatedAfter lists audit events oldest first, starting after
+// the given position.
+func (s *PGXStore) ListAuditEventsCreatedAfter(ctx context.Context, opts ListAuditEventsCreatedAfterOpts) (out []*v1.AuditEvent, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_audit_events_created_after")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	data, err := s.queries.ListAuditEventsCreatedAfter(ctx, gen.ListAuditEventsCreatedAfterParams{
+		AfterCreatedAt: pgtype.Timestamptz{
+			Time:  opts.AfterCreatedAt,
+			Valid: true,
+		},
+		AfterID: opts.AfterID,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing ListAuditEventsCreatedAfter query: %w", convertPGXError(err))
+	}
+
+	out = make([]*v1.AuditEvent, 0, len(data))
+	for _, d := range data {
+		ae, err := auditEventToProto(d)
+		if err != nil {
+			return nil, fmt.Errorf("converting audit event: %w", err)
+		}
+		out = append(out, ae)
+	}
+
+	return out, nil
+}
+
 type CreateAuditEventOpts struct {
 	ActorDID         string
 	SubjectDID       string
@@ -800,32 +1320,552 @@ func (s *PGXStore) SetJetstreamCursor(ctx context.Context, cursor int64) (err er
 	return convertPGXError(s.queries.SetJetstreamCursor(ctx, cursor))
 }
 
-func (s *PGXStore) GetPostByURI(ctx context.Context, uri string) (out gen.CandidatePost, err error) {
-	// TODO: Return a proto type rather than exposing gen.CandidatePost
-	out, err = s.queries.GetPostByURI(ctx, uri)
-	return out, convertPGXError(err)
+// GetRelayCursor returns the last subscribeRepos sequence number persisted, or
+// -1 if none has been.
+func (s *PGXStore) GetRelayCursor(ctx context.Context) (out int64, err error) {
+	out, err = s.queries.GetRelayCursor(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Special sentinel value for no cursor persisted.
+			return -1, nil
+		}
+		return 0, err
+	}
+	return out, nil
 }
 
-func (s *PGXStore) GetLatestActorProfile(ctx context.Context, did string) (out gen.ActorProfile, err error) {
-	// TODO: Return a proto type rather than exposing gen.ActorProfile
-	out, err = s.queries.GetLatestActorProfile(ctx, did)
-	return out, convertPGXError(err)
+func (s *PGXStore) SetRelayCursor(ctx context.Context, cursor int64) (err error) {
+	return convertPGXError(s.queries.SetRelayCursor(ctx, cursor))
 }
 
-func (s *PGXStore) GetActorProfileHistory(ctx context.Context, did string) (out []gen.ActorProfile, err error) {
-	// TODO: Return a proto type rather than exposing gen.ActorProfile
-	out, err = s.queries.GetActorProfileHistory(ctx, did)
-	return out, convertPGXError(err)
-}
+// HeartbeatIngesterReplica records that an ingester replica is running,
+// forgets any replicas which haven't been seen within ttl, and returns the
+// number of replicas running.
+func (s *PGXStore) HeartbeatIngesterReplica(
+	ctx context.Context, id string, ttl time.Duration,
+) (out int64, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.heartbeat_ingester_replica")
+	defer func() {
+		endSpan(span, err)
+	}()
 
-func (s *PGXStore) MaterializeClassicPostScores(ctx context.Context, after time.Time) (int64, error) {
-	return s.queries.MaterializePostScores(ctx, pgtype.Timestamptz{Time: after, Valid: true})
-}
+	now := time.Now()
+	err = s.queries.HeartbeatIngesterReplica(ctx, gen.HeartbeatIngesterReplicaParams{
+		ID:         id,
+		LastSeenAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("executing HeartbeatIngesterReplica query: %w", convertPGXError(err))
+	}
+	err = s.queries.DeleteStaleIngesterReplicas(ctx, pgtype.Timestamptz{Time: now.Add(-ttl), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("executing DeleteStaleIngesterReplicas query: %w", convertPGXError(err))
+	}
+	out, err = s.queries.CountIngesterReplicas(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("executing CountIngesterReplicas query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// DeleteIngesterReplica forgets an ingester replica which is shutting down, so
+// that the others can take over its shards straight away.
+func (s *PGXStore) DeleteIngesterReplica(ctx context.Context, id string) error {
+	return convertPGXError(s.queries.DeleteIngesterReplica(ctx, id))
+}
+
+// CreateIngesterShards creates any of the count shards which don't exist.
+func (s *PGXStore) CreateIngesterShards(ctx context.Context, count int32) error {
+	return convertPGXError(s.queries.CreateIngesterShards(ctx, count))
+}
+
+func (s *PGXStore) ListIngesterShards(
+	ctx context.Context, count int32,
+) (out []gen.IngesterShard, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_ingester_shards")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ListIngesterShards(ctx, count)
+	if err != nil {
+		return nil, fmt.Errorf("executing ListIngesterShards query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// AcquireIngesterShards takes the leases of up to limit shards which nobody
+// holds.
+func (s *PGXStore) AcquireIngesterShards(
+	ctx context.Context, count int32, owner string, limit int32, lease time.Duration,
+) (out []gen.IngesterShard, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.acquire_ingester_shards")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	now := time.Now()
+	out, err = s.queries.AcquireIngesterShards(ctx, gen.AcquireIngesterShardsParams{
+		Owner:      owner,
+		LeaseUntil: pgtype.Timestamptz{Time: now.Add(lease), Valid: true},
+		ShardCount: count,
+		Now:        pgtype.Timestamptz{Time: now, Valid: true},
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing AcquireIngesterShards query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// RenewIngesterShardLeases extends the leases held by owner, and returns the
+// shards it still holds. Leases which have expired are not renewed, as
+// another replica may have started ingesting the shard.
+func (s *PGXStore) RenewIngesterShardLeases(
+	ctx context.Context, count int32, owner string, lease time.Duration,
+) (out []int32, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.renew_ingester_shard_leases")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	now := time.Now()
+	out, err = s.queries.RenewIngesterShardLeases(ctx, gen.RenewIngesterShardLeasesParams{
+		LeaseUntil: pgtype.Timestamptz{Time: now.Add(lease), Valid: true},
+		ShardCount: count,
+		Owner:      owner,
+		Now:        pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing RenewIngesterShardLeases query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// ReleaseIngesterShard gives up the lease on a shard, if owner holds it.
+func (s *PGXStore) ReleaseIngesterShard(
+	ctx context.Context, count int32, shard int32, owner string,
+) error {
+	return convertPGXError(s.queries.ReleaseIngesterShard(ctx, gen.ReleaseIngesterShardParams{
+		ShardCount: count,
+		Shard:      shard,
+		Owner:      owner,
+	}))
+}
+
+// AcquireLeaderLease takes the named lease for holder, or renews it if
+// holder already has it. It returns false if another holder has an unexpired
+// lease.
+func (s *PGXStore) AcquireLeaderLease(
+	ctx context.Context, name string, holder string, lease time.Duration,
+) (held bool, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.acquire_leader_lease")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	now := time.Now()
+	_, err = s.queries.AcquireLeaderLease(ctx, gen.AcquireLeaderLeaseParams{
+		Name:       name,
+		Holder:     holder,
+		Now:        pgtype.Timestamptz{Time: now, Valid: true},
+		LeaseUntil: pgtype.Timestamptz{Time: now.Add(lease), Valid: true},
+	})
+	if err != nil {
+		err = convertPGXError(err)
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("executing AcquireLeaderLease query: %w", err)
+	}
+	return true, nil
+}
+
+// ReleaseLeaderLease gives up the named lease, if holder has it.
+func (s *PGXStore) ReleaseLeaderLease(ctx context.Context, name string, holder string) error {
+	return convertPGXError(s.queries.ReleaseLeaderLease(ctx, gen.ReleaseLeaderLeaseParams{
+		Name:   name,
+		Holder: holder,
+	}))
+}
+
+// SetIngesterShardCursor persists the cursor of a shard. ErrNotFound is
+// returned if owner no longer holds its lease.
+func (s *PGXStore) SetIngesterShardCursor(
+	ctx context.Context, count int32, shard int32, owner string, cursor int64,
+) error {
+	updated, err := s.queries.SetIngesterShardCursor(ctx, gen.SetIngesterShardCursorParams{
+		Cursor:     cursor,
+		ShardCount: count,
+		Shard:      shard,
+		Owner:      owner,
+	})
+	if err != nil {
+		return convertPGXError(err)
+	}
+	if updated == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *PGXStore) GetPostByURI(ctx context.Context, uri string) (out gen.CandidatePost, err error) {
+	// TODO: Return a proto type rather than exposing gen.CandidatePost
+	out, err = s.queries.GetPostByURI(ctx, uri)
+	return out, convertPGXError(err)
+}
+
+func (s *PGXStore) GetLatestActorProfile(ctx context.Context, did string) (out gen.ActorProfile, err error) {
+	// TODO: Return a proto type rather than exposing gen.ActorProfile
+	out, err = s.queries.GetLatestActorProfile(ctx, did)
+	return out, convertPGXError(err)
+}
+
+func (s *PGXStore) GetActorProfileHistory(ctx context.Context, did string) (out []gen.ActorProfile, err error) {
+	// TODO: Return a proto type rather than exposing gen.ActorProfile
+	out, err = s.queries.GetActorProfileHistory(ctx, did)
+	return out, convertPGXError(err)
+}
+
+// GetPostScore fetches the score of a post in a generation of an algorithm.
+// ErrNotFound is returned if the post wasn't scored in that generation.
+func (s *PGXStore) GetPostScore(ctx context.Context, uri string, alg string, generationSeq int64) (out gen.PostScore, err error) {
+	// TODO: Return a proto type rather than exposing gen.PostScore
+	out, err = s.queries.GetPostScore(ctx, gen.GetPostScoreParams{
+		URI:           uri,
+		Alg:           alg,
+		GenerationSeq: generationSeq,
+	})
+	return out, convertPGXError(err)
+}
+
+// RescoreResult describes a generation created by rescoring incrementally,
+// e.g. by RescoreClassicPostScores.
+type RescoreResult struct {
+	GenerationSeq int64
+	// Rescored is how many posts were given a new score.
+	Rescored int64
+	// Superseded is how many scores from the previous generation were
+	// replaced or dropped.
+	Superseded int64
+}
+
+// RescoreClassicPostScores creates a generation of the "classic" algorithm
+// incrementally. Posts indexed since after are only rescored if their like
+// count has changed, or if their score has decayed by more than maxDecay (as a
+// fraction of the score) since it was last computed.
+func (s *PGXStore) RescoreClassicPostScores(
+	ctx context.Context, after time.Time, maxDecay float64,
+) (out RescoreResult, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.rescore_classic_post_scores")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	row, err := s.queries.RescorePostScores(ctx, gen.RescorePostScoresParams{
+		After:    pgtype.Timestamptz{Time: after, Valid: true},
+		MaxDecay: maxDecay,
+	})
+	if err != nil {
+		return out, fmt.Errorf("executing RescorePostScores query: %w", convertPGXError(err))
+	}
+	return RescoreResult{
+		GenerationSeq: row.GenerationSeq,
+		Rescored:      row.Rescored,
+		Superseded:    row.Superseded,
+	}, nil
+}
+
+// RescoreNetworkPostScores creates a generation of the "network" algorithm
+// incrementally, which scores posts by their likes and reposts from anyone on
+// the network. Posts are rescored in the same way as RescoreClassicPostScores.
+func (s *PGXStore) RescoreNetworkPostScores(
+	ctx context.Context, after time.Time, maxDecay float64,
+) (out RescoreResult, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.rescore_network_post_scores")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	row, err := s.queries.RescoreNetworkPostScores(ctx, gen.RescoreNetworkPostScoresParams{
+		After:    pgtype.Timestamptz{Time: after, Valid: true},
+		MaxDecay: maxDecay,
+	})
+	if err != nil {
+		return out, fmt.Errorf("executing RescoreNetworkPostScores query: %w", convertPGXError(err))
+	}
+	return RescoreResult{
+		GenerationSeq: row.GenerationSeq,
+		Rescored:      row.Rescored,
+		Superseded:    row.Superseded,
+	}, nil
+}
+
+// RescoreDiscoveryPostScores creates a generation of the "discovery"
+// algorithm incrementally, which scores image posts by how few likes they have
+// for their age. Posts are only rescored if their like count has changed, or
+// if their score may have moved by more than maxDecay since it was last
+// computed.
+func (s *PGXStore) RescoreDiscoveryPostScores(
+	ctx context.Context, after time.Time, maxDecay float64,
+) (out RescoreResult, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.rescore_discovery_post_scores")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	row, err := s.queries.RescoreDiscoveryPostScores(ctx, gen.RescoreDiscoveryPostScoresParams{
+		After:    pgtype.Timestamptz{Time: after, Valid: true},
+		MaxDecay: maxDecay,
+	})
+	if err != nil {
+		return out, fmt.Errorf("executing RescoreDiscoveryPostScores query: %w", convertPGXError(err))
+	}
+	return RescoreResult{
+		GenerationSeq: row.GenerationSeq,
+		Rescored:      row.Rescored,
+		Superseded:    row.Superseded,
+	}, nil
+}
+
+// LikeDampeners control how much suspicious likes count for in scoring
+// algorithms that opt into them. The zero value dampens nothing.
+type LikeDampeners struct {
+	// NewLikerPeriod is how long after being approved an actor's likes are
+	// weighted by NewLikerWeight.
+	NewLikerPeriod time.Duration
+	NewLikerWeight float64
+	// MaxLikesPerAuthorPerDay is how many of an author's posts an actor's
+	// likes count for each day. 0 disables the cap.
+	MaxLikesPerAuthorPerDay int32
+	// Likes between actors who have each liked at least ReciprocalMinLikes of
+	// the other's posts in the last ReciprocalPeriod are weighted by
+	// ReciprocalWeight. 0 disables this. The pairs are only found when
+	// RefreshReciprocalLikers is called, so the period and minimum likes
+	// apply from the next refresh.
+	ReciprocalPeriod   time.Duration
+	ReciprocalMinLikes int32
+	ReciprocalWeight   float64
+}
+
+func (d LikeDampeners) newLikerWeight() float64 {
+	if d.NewLikerPeriod == 0 {
+		return 1
+	}
+	return d.NewLikerWeight
+}
+
+func (d LikeDampeners) reciprocalMinLikes() int32 {
+	if d.ReciprocalPeriod == 0 {
+		return 0
+	}
+	return d.ReciprocalMinLikes
+}
+
+func (d LikeDampeners) reciprocalWeight() float64 {
+	if d.reciprocalMinLikes() == 0 {
+		return 1
+	}
+	return d.ReciprocalWeight
+}
+
+// RefreshReciprocalLikers replaces the flagged pairs of actors whose likes of
+// each other are dampened, returning how many were flagged. Each pair is
+// counted both ways round. Finding the pairs scans every like in the
+// ReciprocalPeriod, so this is called periodically rather than whenever
+// scores are materialized.
+func (s *PGXStore) RefreshReciprocalLikers(ctx context.Context, d LikeDampeners) (n int64, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.refresh_reciprocal_likers")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			s.log.Warn("failed to roll back transaction", bfflog.Err(err))
+		}
+	}()
+	queries := s.queries.WithTx(tx)
+
+	if err := queries.DeleteReciprocalLikePairs(ctx); err != nil {
+		return 0, fmt.Errorf("executing DeleteReciprocalLikePairs query: %w", convertPGXError(err))
+	}
+	n, err = queries.InsertReciprocalLikePairs(ctx, gen.InsertReciprocalLikePairsParams{
+		Since:    pgtype.Timestamptz{Time: time.Now().Add(-d.ReciprocalPeriod), Valid: true},
+		MinLikes: d.reciprocalMinLikes(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("executing InsertReciprocalLikePairs query: %w", convertPGXError(err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("committing transaction: %w", err)
+	}
+	return n, nil
+}
+
+// MaterializeDampenedPostScores scores posts in the same way as the "classic"
+// algorithm, but with suspicious likes dampened, under the given algorithm.
+func (s *PGXStore) MaterializeDampenedPostScores(
+	ctx context.Context, alg string, after time.Time, d LikeDampeners,
+) (seq int64, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.materialize_dampened_post_scores")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	now := time.Now()
+	seq, err = s.queries.MaterializeDampenedPostScores(ctx, gen.MaterializeDampenedPostScoresParams{
+		After:                   pgtype.Timestamptz{Time: after, Valid: true},
+		NewLikerSince:           pgtype.Timestamptz{Time: now.Add(-d.NewLikerPeriod), Valid: true},
+		NewLikerWeight:          d.newLikerWeight(),
+		MaxLikesPerAuthorPerDay: d.MaxLikesPerAuthorPerDay,
+		ReciprocalWeight:        d.reciprocalWeight(),
+		Alg:                     alg,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("executing MaterializeDampenedPostScores query: %w", convertPGXError(err))
+	}
+	return seq, nil
+}
+
+// ListReciprocalLikers lists the pairs of actors flagged by the last
+// RefreshReciprocalLikers, with the most active pairs first.
+func (s *PGXStore) ListReciprocalLikers(
+	ctx context.Context, limit int32,
+) (out []*v1.ReciprocalLikers, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_reciprocal_likers")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	rows, err := s.queries.ListReciprocalLikers(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("executing ListReciprocalLikers query: %w", convertPGXError(err))
+	}
+
+	out = make([]*v1.ReciprocalLikers, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &v1.ReciprocalLikers{
+			ActorDid:      row.ActorDID,
+			PartnerDid:    row.PartnerDid,
+			LikesGiven:    row.LikesGiven,
+			LikesReceived: row.LikesReceived,
+		})
+	}
+	return out, nil
+}
+
+// ListDampenedPosts lists posts indexed since after whose likes are dampened,
+// with the posts that lost the most likes first.
+func (s *PGXStore) ListDampenedPosts(
+	ctx context.Context, after time.Time, d LikeDampeners, limit int32,
+) (out []*v1.DampenedPost, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_dampened_posts")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	now := time.Now()
+	rows, err := s.queries.ListDampenedPosts(ctx, gen.ListDampenedPostsParams{
+		After:                   pgtype.Timestamptz{Time: after, Valid: true},
+		NewLikerSince:           pgtype.Timestamptz{Time: now.Add(-d.NewLikerPeriod), Valid: true},
+		NewLikerWeight:          d.newLikerWeight(),
+		MaxLikesPerAuthorPerDay: d.MaxLikesPerAuthorPerDay,
+		ReciprocalWeight:        d.reciprocalWeight(),
+		MaxResults:              limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing ListDampenedPosts query: %w", convertPGXError(err))
+	}
+
+	out = make([]*v1.DampenedPost, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &v1.DampenedPost{
+			Uri:           row.SubjectURI,
+			ActorDid:      row.AuthorDid,
+			Likes:         row.Likes,
+			WeightedLikes: row.WeightedLikes,
+		})
+	}
+	return out, nil
+}
+
+// PostNetworkCounts are how many times a post has been liked and reposted by
+// anyone on the network.
+type PostNetworkCounts struct {
+	Likes   int64
+	Reposts int64
+}
+
+// PostNetworkInteraction is a like or repost of a post by anyone on the
+// network.
+type PostNetworkInteraction struct {
+	SubjectURI string
+	Repost     bool
+}
+
+// CountPostNetworkInteractions adds likes and reposts of candidate posts, keyed
+// by the URI of the like or repost, to the network counts of the posts. Those
+// which have already been counted, e.g because the event was seen twice, are
+// skipped, as are interactions with other posts.
+func (s *PGXStore) CountPostNetworkInteractions(
+	ctx context.Context, interactions map[string]PostNetworkInteraction,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.count_post_network_interactions")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	// Rows are locked in a consistent order, so that concurrent increments
+	// from different replicas can't deadlock.
+	params := gen.CountPostNetworkInteractionsParams{}
+	for _, uri := range slices.Sorted(maps.Keys(interactions)) {
+		params.Uris = append(params.Uris, uri)
+		params.SubjectUris = append(params.SubjectUris, interactions[uri].SubjectURI)
+		params.IsReposts = append(params.IsReposts, interactions[uri].Repost)
+	}
+	if err := s.queries.CountPostNetworkInteractions(ctx, params); err != nil {
+		return fmt.Errorf("executing CountPostNetworkInteractions query: %w", convertPGXError(err))
+	}
+	return nil
+}
+
+// DeleteOldPostNetworkInteractions forgets which likes and reposts were
+// counted before the given time.
+func (s *PGXStore) DeleteOldPostNetworkInteractions(ctx context.Context, before time.Time) (int64, error) {
+	return s.queries.DeleteOldPostNetworkInteractions(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
 
+// GetPostNetworkCounts returns the network counts of a post, which are zero if
+// it hasn't been liked or reposted.
+func (s *PGXStore) GetPostNetworkCounts(ctx context.Context, uri string) (PostNetworkCounts, error) {
+	out, err := s.queries.GetPostNetworkCounts(ctx, uri)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PostNetworkCounts{}, nil
+		}
+		return PostNetworkCounts{}, fmt.Errorf("executing GetPostNetworkCounts query: %w", convertPGXError(err))
+	}
+	return PostNetworkCounts{Likes: out.Likes, Reposts: out.Reposts}, nil
+}
+
+// DeleteOldPostScores deletes scores that stopped being current before the
+// given time.
 func (s *PGXStore) DeleteOldPostScores(ctx context.Context, before time.Time) (int64, error) {
 	return s.queries.DeleteOldPostScores(ctx, pgtype.Timestamptz{Time: before, Valid: true})
 }
 
+// DeleteOldScoreGenerations deletes generations created before the given
+// time, other than the latest generation of each algorithm.
+func (s *PGXStore) DeleteOldScoreGenerations(ctx context.Context, before time.Time) (int64, error) {
+	return s.queries.DeleteOldScoreGenerations(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
 func (s *PGXStore) HoldBackPendingActor(ctx context.Context, did string, duration time.Time) error {
 	return s.queries.HoldBackPendingActor(ctx, gen.HoldBackPendingActorParams{
 		DID:       did,
@@ -833,36 +1873,848 @@ func (s *PGXStore) HoldBackPendingActor(ctx context.Context, did string, duratio
 	})
 }
 
+const (
+	TaskTypeFollow   = "follow"
+	TaskTypeUnfollow = "unfollow"
+	// TaskTypeAddToLists and TaskTypeRemoveFromLists update an actor's
+	// membership of our curated lists after a moderation decision. Their
+	// payload is an ActorTaskPayload.
+	TaskTypeAddToLists      = "add_to_lists"
+	TaskTypeRemoveFromLists = "remove_from_lists"
+	// TaskTypePurgeActor permanently deletes an actor's data once their
+	// deletion's grace period has passed. Its payload is a
+	// PurgeActorTaskPayload.
+	TaskTypePurgeActor = "purge_actor"
+	// TaskTypeReclassifyNSFWPosts reclassifies whether every post is NSFW,
+	// after the NSFW rules have changed. Its payload is a
+	// ReclassifyNSFWPostsTaskPayload.
+	TaskTypeReclassifyNSFWPosts = "reclassify_nsfw_posts"
+)
+
+// DefaultTaskMaxTries is the number of attempts a task is given before it is
+// moved to the dead-letter state, unless otherwise specified on enqueue.
+const DefaultTaskMaxTries = 5
+
+// tasksChannel is the Postgres notification channel used to wake workers when
+// a task is enqueued.
+const tasksChannel = "tasks"
+
+// ActorTaskPayload is the payload of tasks that act upon a single actor.
+type ActorTaskPayload struct {
+	ActorDID string `json:"actor_did"`
+}
+
+// ReclassifyNSFWPostsTaskPayload is the payload of TaskTypeReclassifyNSFWPosts
+// tasks.
+type ReclassifyNSFWPostsTaskPayload struct {
+	// AfterURI is set when a task runs out of time, so that the task it
+	// enqueues carries on from where it stopped.
+	AfterURI string `json:"after_uri,omitempty"`
+}
+
+type EnqueueTaskOpts struct {
+	Type string
+	// Payload is marshalled to JSON and handed to the handler of the task type.
+	Payload any
+	// RunAt is the earliest time the task should run. If zero, the task can
+	// run immediately.
+	RunAt time.Time
+	// MaxTries defaults to DefaultTaskMaxTries if zero.
+	MaxTries int
+	// DedupeKey, if set, prevents the task being enqueued whilst another
+	// pending task has the same key. ErrTaskAlreadyPending is returned.
+	DedupeKey string
+}
+
+// EnqueueTask adds a task to the queue and notifies listening workers. When
+// called within a transaction, the notification is delivered on commit.
+func (s *PGXStore) EnqueueTask(ctx context.Context, opts EnqueueTaskOpts) (out *v1.Task, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.enqueue_task")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	payload, err := json.Marshal(opts.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling payload: %w", err)
+	}
+	runAt := opts.RunAt
+	if runAt.IsZero() {
+		runAt = time.Now()
+	}
+	maxTries := opts.MaxTries
+	if maxTries == 0 {
+		maxTries = DefaultTaskMaxTries
+	}
+
+	task, err := s.queries.EnqueueTask(ctx, gen.EnqueueTaskParams{
+		Type:      opts.Type,
+		Payload:   payload,
+		MaxTries:  int32(maxTries),
+		NextTryAt: pgtype.Timestamptz{Time: runAt, Valid: true},
+		DedupeKey: pgtype.Text{String: opts.DedupeKey, Valid: opts.DedupeKey != ""},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// ON CONFLICT DO NOTHING returns no rows.
+			return nil, ErrTaskAlreadyPending
+		}
+		return nil, fmt.Errorf("executing EnqueueTask query: %w", convertPGXError(err))
+	}
+	if err := s.queries.NotifyTaskEnqueued(ctx, opts.Type); err != nil {
+		return nil, fmt.Errorf("executing NotifyTaskEnqueued query: %w", convertPGXError(err))
+	}
+	return taskToProto(task)
+}
+
 func (s *PGXStore) EnqueueFollow(ctx context.Context, did string) error {
-	return s.queries.EnqueueFollowTask(ctx, gen.EnqueueFollowTaskParams{
-		ActorDID:       did,
-		NextTryAt:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
-		CreatedAt:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
-		ShouldUnfollow: false,
+	_, err := s.EnqueueTask(ctx, EnqueueTaskOpts{
+		Type:    TaskTypeFollow,
+		Payload: ActorTaskPayload{ActorDID: did},
 	})
+	return err
 }
 
 func (s *PGXStore) EnqueueUnfollow(ctx context.Context, did string) error {
-	return s.queries.EnqueueFollowTask(ctx, gen.EnqueueFollowTaskParams{
-		ActorDID:       did,
-		NextTryAt:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
-		CreatedAt:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
-		ShouldUnfollow: true,
+	_, err := s.EnqueueTask(ctx, EnqueueTaskOpts{
+		Type:    TaskTypeUnfollow,
+		Payload: ActorTaskPayload{ActorDID: did},
+	})
+	return err
+}
+
+func (s *PGXStore) EnqueueAddToLists(ctx context.Context, did string) error {
+	_, err := s.EnqueueTask(ctx, EnqueueTaskOpts{
+		Type:    TaskTypeAddToLists,
+		Payload: ActorTaskPayload{ActorDID: did},
+	})
+	return err
+}
+
+func (s *PGXStore) EnqueueRemoveFromLists(ctx context.Context, did string) error {
+	_, err := s.EnqueueTask(ctx, EnqueueTaskOpts{
+		Type:    TaskTypeRemoveFromLists,
+		Payload: ActorTaskPayload{ActorDID: did},
+	})
+	return err
+}
+
+// ClaimNextTask claims the next runnable task of one of the given types,
+// preventing other workers from claiming it until the lease expires. Returns
+// ErrNotFound if there are no runnable tasks.
+func (s *PGXStore) ClaimNextTask(ctx context.Context, types []string, lease time.Duration) (out gen.Task, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.claim_next_task")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ClaimNextTask(ctx, gen.ClaimNextTaskParams{
+		LeaseUntil: pgtype.Timestamptz{Time: time.Now().Add(lease), Valid: true},
+		Types:      types,
 	})
+	if err != nil {
+		return out, fmt.Errorf("executing ClaimNextTask query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// ListPendingTaskActorDIDs returns the DIDs of actors which have a pending
+// task of one of the given types.
+func (s *PGXStore) ListPendingTaskActorDIDs(ctx context.Context, types ...string) ([]string, error) {
+	return s.queries.ListPendingTaskActorDIDs(ctx, types)
 }
 
-func (s *PGXStore) GetNextFollowTask(ctx context.Context) (gen.FollowTask, error) {
-	return s.queries.GetNextFollowTask(ctx)
+func (s *PGXStore) MarkTaskAsErrored(ctx context.Context, id int64, err error, retryAt time.Time) error {
+	return s.queries.MarkTaskAsErrored(ctx, gen.MarkTaskAsErroredParams{
+		ID:        id,
+		LastError: pgtype.Text{String: err.Error(), Valid: true},
+		NextTryAt: pgtype.Timestamptz{Time: retryAt, Valid: true},
+	})
 }
 
-func (s *PGXStore) MarkFollowTaskAsErrored(ctx context.Context, id int64, err error) error {
-	return s.queries.MarkFollowTaskAsErrored(ctx, gen.MarkFollowTaskAsErroredParams{
+// MarkTaskAsDead moves a task to the dead-letter state. It will not be
+// retried.
+func (s *PGXStore) MarkTaskAsDead(ctx context.Context, id int64, err error) error {
+	return s.queries.MarkTaskAsDead(ctx, gen.MarkTaskAsDeadParams{
 		ID:        id,
 		LastError: pgtype.Text{String: err.Error(), Valid: true},
-		NextTryAt: pgtype.Timestamptz{Time: time.Now().Add(time.Minute * 15), Valid: true},
 	})
 }
 
-func (s *PGXStore) MarkFollowTaskAsDone(ctx context.Context, id int64) error {
-	return s.queries.MarkFollowTaskAsDone(ctx, id)
+func (s *PGXStore) MarkTaskAsDone(ctx context.Context, id int64) error {
+	return s.queries.MarkTaskAsDone(ctx, id)
+}
+
+func taskStateToProto(task gen.Task) (v1.TaskState, error) {
+	switch task.Status {
+	case gen.TaskStatusPending:
+		if task.ClaimedUntil.Valid && task.ClaimedUntil.Time.After(time.Now()) {
+			return v1.TaskState_TASK_STATE_RUNNING, nil
+		}
+		return v1.TaskState_TASK_STATE_PENDING, nil
+	case gen.TaskStatusDead:
+		return v1.TaskState_TASK_STATE_FAILED, nil
+	case gen.TaskStatusDone:
+		return v1.TaskState_TASK_STATE_DONE, nil
+	case gen.TaskStatusCancelled:
+		return v1.TaskState_TASK_STATE_CANCELLED, nil
+	default:
+		return v1.TaskState_TASK_STATE_UNSPECIFIED, fmt.Errorf("unsupported task status: %s", task.Status)
+	}
+}
+
+func taskToProto(task gen.Task) (*v1.Task, error) {
+	state, err := taskStateToProto(task)
+	if err != nil {
+		return nil, fmt.Errorf("converting state: %w", err)
+	}
+	payload := ActorTaskPayload{}
+	// Not all payloads refer to an actor, so we ignore any error here.
+	_ = json.Unmarshal(task.Payload, &payload)
+
+	out := &v1.Task{
+		Id:        task.ID,
+		Type:      task.Type,
+		Payload:   string(task.Payload),
+		State:     state,
+		Tries:     task.Tries,
+		MaxTries:  task.MaxTries,
+		CreatedAt: timestamppb.New(task.CreatedAt.Time),
+		LastError: task.LastError.String,
+		ActorDid:  payload.ActorDID,
+	}
+	if state == v1.TaskState_TASK_STATE_PENDING {
+		out.NextTryAt = timestamppb.New(task.NextTryAt.Time)
+	}
+	if task.FinishedAt.Valid {
+		out.FinishedAt = timestamppb.New(task.FinishedAt.Time)
+	}
+	return out, nil
+}
+
+type ListTasksOpts struct {
+	FilterState    v1.TaskState
+	FilterActorDID string
+	FilterType     string
+	// FilterBeforeID only returns tasks with an ID lower than this, for
+	// pagination.
+	FilterBeforeID int64
+
+	// Limit defaults to 100.
+	Limit int32
+}
+
+func (s *PGXStore) ListTasks(ctx context.Context, opts ListTasksOpts) (out []*v1.Task, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_tasks")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	queryParams := gen.ListTasksParams{
+		ActorDID:   pgtype.Text{String: opts.FilterActorDID, Valid: opts.FilterActorDID != ""},
+		Type:       pgtype.Text{String: opts.FilterType, Valid: opts.FilterType != ""},
+		BeforeID:   pgtype.Int8{Int64: opts.FilterBeforeID, Valid: opts.FilterBeforeID != 0},
+		MaxResults: limit,
+	}
+	switch opts.FilterState {
+	case v1.TaskState_TASK_STATE_UNSPECIFIED:
+	case v1.TaskState_TASK_STATE_PENDING:
+		queryParams.Status = gen.NullTaskStatus{TaskStatus: gen.TaskStatusPending, Valid: true}
+		queryParams.Running = pgtype.Bool{Bool: false, Valid: true}
+	case v1.TaskState_TASK_STATE_RUNNING:
+		queryParams.Status = gen.NullTaskStatus{TaskStatus: gen.TaskStatusPending, Valid: true}
+		queryParams.Running = pgtype.Bool{Bool: true, Valid: true}
+	case v1.TaskState_TASK_STATE_FAILED:
+		queryParams.Status = gen.NullTaskStatus{TaskStatus: gen.TaskStatusDead, Valid: true}
+	case v1.TaskState_TASK_STATE_DONE:
+		queryParams.Status = gen.NullTaskStatus{TaskStatus: gen.TaskStatusDone, Valid: true}
+	case v1.TaskState_TASK_STATE_CANCELLED:
+		queryParams.Status = gen.NullTaskStatus{TaskStatus: gen.TaskStatusCancelled, Valid: true}
+	default:
+		return nil, fmt.Errorf("unsupported filter_state: %s", opts.FilterState)
+	}
+
+	tasks, err := s.queries.ListTasks(ctx, queryParams)
+	if err != nil {
+		return nil, fmt.Errorf("executing ListTasks query: %w", convertPGXError(err))
+	}
+
+	out = make([]*v1.Task, 0, len(tasks))
+	for _, t := range tasks {
+		converted, err := taskToProto(t)
+		if err != nil {
+			return nil, fmt.Errorf("converting task (%d): %w", t.ID, err)
+		}
+		out = append(out, converted)
+	}
+	return out, nil
+}
+
+func (s *PGXStore) GetTask(ctx context.Context, id int64) (out *v1.Task, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.get_task")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	task, err := s.queries.GetTask(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("executing GetTask query: %w", convertPGXError(err))
+	}
+	return taskToProto(task)
+}
+
+// RetryTask resets a task so it runs again immediately with a fresh set of
+// tries. ErrNotFound is returned if the task does not exist, or is running or
+// done. ErrTaskAlreadyPending is returned if another pending task has the same
+// dedupe key.
+func (s *PGXStore) RetryTask(ctx context.Context, id int64) (out *v1.Task, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.retry_task")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	conflicting, err := s.queries.GetConflictingPendingTask(ctx, id)
+	switch {
+	case err == nil:
+		return nil, fmt.Errorf("%w: task %d", ErrTaskAlreadyPending, conflicting.ID)
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("executing GetConflictingPendingTask query: %w", convertPGXError(err))
+	}
+
+	task, err := s.queries.RetryTask(ctx, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "tasks_dedupe_key_idx" {
+			// A task with the same dedupe key was enqueued since we checked.
+			return nil, ErrTaskAlreadyPending
+		}
+		return nil, fmt.Errorf("executing RetryTask query: %w", convertPGXError(err))
+	}
+	if err := s.queries.NotifyTaskEnqueued(ctx, task.Type); err != nil {
+		return nil, fmt.Errorf("executing NotifyTaskEnqueued query: %w", convertPGXError(err))
+	}
+	return taskToProto(task)
+}
+
+// CancelTask cancels a pending task. ErrNotFound is returned if the task does
+// not exist, or is not pending.
+func (s *PGXStore) CancelTask(ctx context.Context, id int64) (out *v1.Task, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.cancel_task")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	task, err := s.queries.CancelTask(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("executing CancelTask query: %w", convertPGXError(err))
+	}
+	return taskToProto(task)
+}
+
+func (s *PGXStore) CountTasks(ctx context.Context) (out []gen.CountTasksRow, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.count_tasks")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.CountTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing CountTasks query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// ListenForTasks holds a connection listening for task notifications, and
+// sends to wake (without blocking) whenever a task is enqueued. It returns
+// when ctx is cancelled or the connection fails.
+func (s *PGXStore) ListenForTasks(ctx context.Context, wake chan<- struct{}) error {
+	return s.listen(ctx, tasksChannel, nil, func(string) {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// actorsChannel is the Postgres notification channel a trigger notifies
+// whenever a candidate actor is created, updated or deleted.
+const actorsChannel = "candidate_actors"
+
+// ActorChange is a notification that a candidate actor was created, updated
+// or deleted.
+type ActorChange struct {
+	DID string `json:"did"`
+	// ChangedAt is when the row was written. The notification is only
+	// delivered once the transaction commits, which may be some time later.
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// ListenForActorChanges holds a connection listening for changes to candidate
+// actors, and calls fn for each change. fn is called from the listening
+// goroutine, so the next notification isn't received until it returns.
+//
+// listening is called once the connection is listening. Changes made before
+// this are not notified, so callers should use it to catch up on anything
+// they may have missed. It returns when ctx is cancelled or the connection
+// fails.
+func (s *PGXStore) ListenForActorChanges(
+	ctx context.Context, listening func(), fn func(ActorChange),
+) error {
+	return s.listen(ctx, actorsChannel, listening, func(payload string) {
+		change := ActorChange{}
+		if err := json.Unmarshal([]byte(payload), &change); err != nil {
+			s.log.Warn(
+				"ignoring malformed actor change notification",
+				slog.String("payload", payload),
+				bfflog.Err(err),
+			)
+			return
+		}
+		fn(change)
+	})
+}
+
+// listen holds a connection listening on a notification channel, and calls
+// fn with the payload of each notification. listening, if set, is called
+// once the connection is listening. It returns when ctx is cancelled or the
+// connection fails.
+func (s *PGXStore) listen(
+	ctx context.Context, channel string, listening func(), fn func(payload string),
+) error {
+	conn, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquiring connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+channel); err != nil {
+		return fmt.Errorf("listening: %w", err)
+	}
+	defer func() {
+		// The connection is returned to the pool, so stop listening. We use a
+		// fresh context as ctx is likely cancelled.
+		unlistenCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := conn.Exec(unlistenCtx, "UNLISTEN "+channel); err != nil {
+			conn.Conn().Close(unlistenCtx)
+		}
+	}()
+
+	if listening != nil {
+		listening()
+	}
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("waiting for notification: %w", err)
+		}
+		fn(notification.Payload)
+	}
+}
+
+// CreateDeadLetterEvent records an event the ingester failed to handle, so
+// that it can be retried from nextAttemptAt.
+func (s *PGXStore) CreateDeadLetterEvent(
+	ctx context.Context, actorDID string, event []byte, handleErr error, nextAttemptAt time.Time,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.create_dead_letter_event")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	_, err = s.queries.CreateDeadLetterEvent(ctx, gen.CreateDeadLetterEventParams{
+		ActorDID:      actorDID,
+		Event:         event,
+		LastError:     handleErr.Error(),
+		NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("executing CreateDeadLetterEvent query: %w", convertPGXError(err))
+	}
+	return nil
+}
+
+// ListRetryingDeadLetterActors lists the actors with events awaiting a retry,
+// and when the oldest of their events is next due.
+func (s *PGXStore) ListRetryingDeadLetterActors(
+	ctx context.Context,
+) (out []gen.ListRetryingDeadLetterActorsRow, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_retrying_dead_letter_actors")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ListRetryingDeadLetterActors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing ListRetryingDeadLetterActors query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// ClaimDeadLetterEventsForActor claims up to limit of an actor's events which
+// are awaiting a retry, oldest first, if the oldest is due. Claimed events are
+// not due again until the lease expires.
+func (s *PGXStore) ClaimDeadLetterEventsForActor(
+	ctx context.Context, actorDID string, limit int32, lease time.Duration,
+) (out []gen.DeadLetterEvent, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.claim_dead_letter_events_for_actor")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ClaimDeadLetterEventsForActor(ctx, gen.ClaimDeadLetterEventsForActorParams{
+		LeaseUntil: pgtype.Timestamptz{Time: time.Now().Add(lease), Valid: true},
+		ActorDID:   actorDID,
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing ClaimDeadLetterEventsForActor query: %w", convertPGXError(err))
+	}
+	// RETURNING does not preserve the order the events were selected in.
+	slices.SortFunc(out, func(a, b gen.DeadLetterEvent) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return out, nil
+}
+
+// MarkDeadLetterEventFailed records a failed retry. If nextAttemptAt is zero,
+// the event has exhausted its attempts and is not retried again.
+func (s *PGXStore) MarkDeadLetterEventFailed(
+	ctx context.Context, id int64, handleErr error, nextAttemptAt time.Time,
+) error {
+	return s.queries.MarkDeadLetterEventFailed(ctx, gen.MarkDeadLetterEventFailedParams{
+		ID:            id,
+		LastError:     handleErr.Error(),
+		NextAttemptAt: pgtype.Timestamptz{Time: nextAttemptAt, Valid: !nextAttemptAt.IsZero()},
+	})
+}
+
+// DeleteDeadLetterEvent deletes an event once it has been handled, or to
+// discard it. ErrNotFound is returned if the event does not exist.
+func (s *PGXStore) DeleteDeadLetterEvent(ctx context.Context, id int64) (err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.delete_dead_letter_event")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	deleted, err := s.queries.DeleteDeadLetterEvent(ctx, id)
+	if err != nil {
+		return fmt.Errorf("executing DeleteDeadLetterEvent query: %w", convertPGXError(err))
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func deadLetterEventToProto(evt gen.DeadLetterEvent) *v1.DeadLetterEvent {
+	out := &v1.DeadLetterEvent{
+		Id:            evt.ID,
+		ActorDid:      evt.ActorDID,
+		Event:         string(evt.Event),
+		LastError:     evt.LastError,
+		Attempts:      evt.Attempts,
+		CreatedAt:     timestamppb.New(evt.CreatedAt.Time),
+		LastAttemptAt: timestamppb.New(evt.LastAttemptAt.Time),
+	}
+	if evt.NextAttemptAt.Valid {
+		out.NextAttemptAt = timestamppb.New(evt.NextAttemptAt.Time)
+	}
+	return out
+}
+
+type ListDeadLetterEventsOpts struct {
+	FilterActorDID string
+	// FilterBeforeID only returns events with an ID lower than this, for
+	// pagination.
+	FilterBeforeID int64
+
+	// Limit defaults to 100.
+	Limit int32
+}
+
+func (s *PGXStore) ListDeadLetterEvents(
+	ctx context.Context, opts ListDeadLetterEventsOpts,
+) (out []*v1.DeadLetterEvent, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_dead_letter_events")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	events, err := s.queries.ListDeadLetterEvents(ctx, gen.ListDeadLetterEventsParams{
+		ActorDID:   pgtype.Text{String: opts.FilterActorDID, Valid: opts.FilterActorDID != ""},
+		BeforeID:   pgtype.Int8{Int64: opts.FilterBeforeID, Valid: opts.FilterBeforeID != 0},
+		MaxResults: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing ListDeadLetterEvents query: %w", convertPGXError(err))
+	}
+
+	out = make([]*v1.DeadLetterEvent, 0, len(events))
+	for _, evt := range events {
+		out = append(out, deadLetterEventToProto(evt))
+	}
+	return out, nil
+}
+
+func (s *PGXStore) GetDeadLetterEvent(ctx context.Context, id int64) (out *v1.DeadLetterEvent, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.get_dead_letter_event")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	evt, err := s.queries.GetDeadLetterEvent(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("executing GetDeadLetterEvent query: %w", convertPGXError(err))
+	}
+	return deadLetterEventToProto(evt), nil
+}
+
+// ReplayDeadLetterEvent makes an event due a retry immediately, even if it has
+// exhausted its attempts. ErrNotFound is returned if the event does not exist.
+func (s *PGXStore) ReplayDeadLetterEvent(ctx context.Context, id int64) (out *v1.DeadLetterEvent, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.replay_dead_letter_event")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	evt, err := s.queries.ReplayDeadLetterEvent(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("executing ReplayDeadLetterEvent query: %w", convertPGXError(err))
+	}
+	return deadLetterEventToProto(evt), nil
+}
+
+func (s *PGXStore) CountDeadLetterEvents(ctx context.Context) (out gen.CountDeadLetterEventsRow, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.count_dead_letter_events")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.CountDeadLetterEvents(ctx)
+	if err != nil {
+		return out, fmt.Errorf("executing CountDeadLetterEvents query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+type CreateLabelOpts struct {
+	Src string
+	URI string
+	Val string
+	Neg bool
+	Cts time.Time
+	Sig []byte
+}
+
+func (s *PGXStore) CreateLabel(ctx context.Context, opts CreateLabelOpts) (out gen.Label, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.create_label")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.CreateLabel(ctx, gen.CreateLabelParams{
+		Src: opts.Src,
+		URI: opts.URI,
+		Val: opts.Val,
+		Neg: opts.Neg,
+		Cts: pgtype.Timestamptz{Time: opts.Cts, Valid: true},
+		Sig: opts.Sig,
+	})
+	if err != nil {
+		return out, fmt.Errorf("executing CreateLabel query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+type ListLabelsOpts struct {
+	AfterSeq int64
+	// URIPatterns follows the semantics of com.atproto.label.queryLabels:
+	// a pattern ending in `*` matches by prefix, otherwise it must match
+	// exactly. When empty, labels for all URIs are returned.
+	URIPatterns []string
+	Sources     []string
+	Limit       int
+}
+
+func uriPatternToLike(pattern string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(
+		strings.TrimSuffix(pattern, "*"),
+	)
+	if strings.HasSuffix(pattern, "*") {
+		return escaped + "%"
+	}
+	return escaped
+}
+
+func (s *PGXStore) ListLabels(ctx context.Context, opts ListLabelsOpts) (out []gen.Label, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_labels")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	patterns := make([]string, 0, len(opts.URIPatterns))
+	for _, p := range opts.URIPatterns {
+		patterns = append(patterns, uriPatternToLike(p))
+	}
+	sources := opts.Sources
+	if sources == nil {
+		sources = []string{}
+	}
+
+	out, err = s.queries.ListLabels(ctx, gen.ListLabelsParams{
+		AfterSeq:    opts.AfterSeq,
+		UriPatterns: patterns,
+		Sources:     sources,
+		MaxResults:  int32(opts.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing ListLabels query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+func (s *PGXStore) GetLatestLabelSeq(ctx context.Context) (out int64, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.get_latest_label_seq")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.GetLatestLabelSeq(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("executing GetLatestLabelSeq query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+type ListActiveLabelsOpts struct {
+	Src string
+	// FilterURIs restricts the results to labels on these subjects, if set.
+	FilterURIs []string
+}
+
+func (s *PGXStore) ListActiveLabels(ctx context.Context, opts ListActiveLabelsOpts) (out []gen.ListActiveLabelsRow, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_active_labels")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ListActiveLabels(ctx, gen.ListActiveLabelsParams{
+		Src:  opts.Src,
+		Uris: opts.FilterURIs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("executing ListActiveLabels query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+// ReserveCuratedListItem records rkey as the record key of the listitem
+// adding subjectDID to the list, unless one is already recorded. It returns
+// the recorded record key, which the caller should write the listitem with.
+func (s *PGXStore) ReserveCuratedListItem(
+	ctx context.Context, listURI string, subjectDID string, rkey string,
+) (out string, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.reserve_curated_list_item")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ReserveCuratedListItem(ctx, gen.ReserveCuratedListItemParams{
+		ListUri:    listURI,
+		SubjectDid: subjectDID,
+		Rkey:       rkey,
+	})
+	if err != nil {
+		return "", fmt.Errorf("executing ReserveCuratedListItem query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+func (s *PGXStore) ListCuratedListItems(ctx context.Context, listURI string) (out []gen.CuratedListItem, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_curated_list_items")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ListCuratedListItems(ctx, listURI)
+	if err != nil {
+		return nil, fmt.Errorf("executing ListCuratedListItems query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+func (s *PGXStore) ListCuratedListItemsBySubject(ctx context.Context, subjectDID string) (out []gen.CuratedListItem, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_curated_list_items_by_subject")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ListCuratedListItemsBySubject(ctx, subjectDID)
+	if err != nil {
+		return nil, fmt.Errorf("executing ListCuratedListItemsBySubject query: %w", convertPGXError(err))
+	}
+	return out, nil
+}
+
+func (s *PGXStore) DeleteCuratedListItem(ctx context.Context, listURI string, subjectDID string) (err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.delete_curated_list_item")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	err = s.queries.DeleteCuratedListItem(ctx, gen.DeleteCuratedListItemParams{
+		ListUri:    listURI,
+		SubjectDid: subjectDID,
+	})
+	if err != nil {
+		return fmt.Errorf("executing DeleteCuratedListItem query: %w", convertPGXError(err))
+	}
+	return nil
+}
+
+// ReplaceCuratedListItems replaces the recorded listitems of a list with
+// items, which maps each subject DID to the record key of its listitem.
+func (s *PGXStore) ReplaceCuratedListItems(ctx context.Context, listURI string, items map[string]string) (err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.replace_curated_list_items")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	params := gen.ReplaceCuratedListItemsParams{
+		ListUri:     listURI,
+		SubjectDids: make([]string, 0, len(items)),
+		Rkeys:       make([]string, 0, len(items)),
+	}
+	for did, rkey := range items {
+		params.SubjectDids = append(params.SubjectDids, did)
+		params.Rkeys = append(params.Rkeys, rkey)
+	}
+	if err := s.queries.ReplaceCuratedListItems(ctx, params); err != nil {
+		return fmt.Errorf("executing ReplaceCuratedListItems query: %w", convertPGXError(err))
+	}
+	return nil
+}
+
+func (s *PGXStore) ListHiddenPostURIs(ctx context.Context) (out []string, err error) {
+	ctx, span := tracer.Start(ctx, "pgx_store.list_hidden_post_uris")
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	out, err = s.queries.ListHiddenCandidatePostURIs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing ListHiddenCandidatePostURIs query: %w", convertPGXError(err))
+	}
+	return out, nil
 }
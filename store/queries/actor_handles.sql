@@ -0,0 +1,13 @@
+-- name: CreateActorHandle :exec
+INSERT INTO actor_handles (actor_did, handle, seen_at)
+VALUES ($1, $2, $3);
+
+-- name: ListActorHandles :many
+SELECT *
+FROM actor_handles
+WHERE actor_did = $1
+ORDER BY seen_at DESC;
+
+-- name: DeleteActorHandles :execrows
+DELETE FROM actor_handles
+WHERE actor_did = $1;
@@ -56,11 +56,45 @@ WHERE
             'ASSIGNED_ROLES' = any(sqlc.arg(types))
             AND payload ->> '@type' = 'type.googleapis.com/bff.v1.AssignRolesAuditPayload'
         )
+        OR (
+            'ACCOUNT_STATUS_CHANGED' = any(sqlc.arg(types))
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.AccountStatusChangedAuditPayload'
+        )
+        OR (
+            'HANDLE_CHANGED' = any(sqlc.arg(types))
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.HandleChangedAuditPayload'
+        )
+        OR (
+            'ACTOR_DATA_DELETED' = any(sqlc.arg(types))
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.ActorDataDeletedAuditPayload'
+        )
+        OR (
+            'ACTOR_DATA_RESTORED' = any(sqlc.arg(types))
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.ActorDataRestoredAuditPayload'
+        )
+        OR (
+            'ACTOR_DATA_PURGED' = any(sqlc.arg(types))
+            AND payload ->> '@type' = 'type.googleapis.com/bff.v1.ActorDataPurgedAuditPayload'
+        )
     )
 ORDER BY
     ae.created_at DESC
 LIMIT sqlc.arg(_limit);
 
+-- name: ListAuditEventsCreatedAfter :many
+-- Lists audit events oldest first, starting after the given position, so
+-- that consumers can process them in order.
+SELECT *
+FROM
+    audit_events AS ae
+WHERE
+    (ae.created_at, ae.id) > (
+        sqlc.arg(after_created_at)::timestamptz, sqlc.arg(after_id)::text
+    )
+ORDER BY
+    ae.created_at ASC, ae.id ASC
+LIMIT sqlc.arg(_limit);
+
 -- name: CreateAuditEvent :one
 INSERT INTO
 audit_events (
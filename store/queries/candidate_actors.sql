@@ -104,3 +104,74 @@ SET
 WHERE
     ca.status = 'pending'
     AND ca.did = sqlc.arg(did);
+
+-- name: UpdateCandidateActorAccountStatus :one
+-- Only updates, and returns, the actor if their account status has changed.
+UPDATE candidate_actors ca
+SET
+    account_active = sqlc.arg(account_active),
+    account_status = sqlc.narg(account_status)
+WHERE
+    ca.did = sqlc.arg(did)
+    AND (
+        ca.account_active != sqlc.arg(account_active)
+        OR ca.account_status IS DISTINCT FROM sqlc.narg(account_status)
+    )
+RETURNING *;
+
+-- name: UpdateCandidateActorHandle :one
+UPDATE candidate_actors ca
+SET handle = sqlc.arg(handle)::TEXT
+WHERE ca.did = sqlc.arg(did)
+RETURNING *;
+
+-- name: UpdateCandidateActorNSFWOverride :one
+UPDATE candidate_actors ca
+SET nsfw_override = sqlc.narg(nsfw_override)::BOOLEAN
+WHERE ca.did = sqlc.arg(did)
+RETURNING *;
+
+-- name: SoftDeleteCandidateActor :one
+-- Only updates, and returns, the actor if their data isn't already deleted.
+UPDATE candidate_actors ca
+SET deleted_at = NOW()
+WHERE ca.did = sqlc.arg(did) AND ca.deleted_at IS NULL
+RETURNING *;
+
+-- name: RestoreCandidateActor :one
+-- Only updates, and returns, the actor if their data is deleted but not yet
+-- purged.
+UPDATE candidate_actors ca
+SET deleted_at = NULL
+WHERE
+    ca.did = sqlc.arg(did)
+    AND ca.deleted_at IS NOT NULL
+    AND ca.purged_at IS NULL
+RETURNING *;
+
+-- name: PurgeCandidateActor :one
+-- Marks the actor as purged and clears what identifies them, leaving a
+-- tombstone. Only updates, and returns, the actor if their data was deleted at
+-- deleted_at and has not been purged, so a purge scheduled before the actor
+-- was restored does nothing.
+UPDATE candidate_actors ca
+SET
+    purged_at = NOW(),
+    current_profile_commit_cid = NULL,
+    handle = NULL
+WHERE
+    ca.did = sqlc.arg(did)
+    AND ca.deleted_at = sqlc.arg(deleted_at)
+    AND ca.purged_at IS NULL
+RETURNING *;
+
+-- name: ClearCandidateActorProfile :exec
+UPDATE candidate_actors ca
+SET current_profile_commit_cid = NULL
+WHERE ca.did = $1;
+
+-- name: DeleteActorProfiles :execrows
+-- The actor's current profile must be cleared first, as it references one of
+-- these.
+DELETE FROM actor_profiles
+WHERE actor_did = $1;
@@ -5,7 +5,8 @@ candidate_likes (
     indexed_at
 )
 VALUES
-($1, $2, $3, $4, $5);
+($1, $2, $3, $4, $5)
+ON CONFLICT (uri) DO NOTHING;
 
 -- name: SoftDeleteCandidateLike :exec
 UPDATE
@@ -14,3 +15,19 @@ SET
     deleted_at = NOW()
 WHERE
     uri = $1;
+
+-- name: SoftDeleteCandidateLikesByActor :exec
+UPDATE candidate_likes
+SET deleted_at = $1
+WHERE actor_did = $2 AND deleted_at IS NULL;
+
+-- name: RestoreCandidateLikesByActor :exec
+-- Undoes SoftDeleteCandidateLikesByActor. Rows deleted at other times, e.g.
+-- by the actor, are left alone.
+UPDATE candidate_likes
+SET deleted_at = NULL
+WHERE actor_did = $1 AND deleted_at = $2;
+
+-- name: DeleteCandidateLikesByActor :execrows
+DELETE FROM candidate_likes
+WHERE actor_did = $1;
@@ -9,10 +9,12 @@ candidate_posts (
     has_media,
     has_video,
     raw,
-    self_labels
+    self_labels,
+    is_nsfw
 )
 VALUES
-($1, $2, $3, $4, $5, $6, $7, $8, $9);
+($1, $2, $3, $4, $5, $6, $7, $8, $9, classify_post_nsfw($2, $5, $9))
+ON CONFLICT (uri) DO NOTHING;
 
 -- name: SoftDeleteCandidatePost :exec
 UPDATE
@@ -35,6 +37,19 @@ NATURAL JOIN args
 WHERE
     -- Only include posts by approved actors
     ca.status = 'approved'
+    -- Remove posts by actors whose accounts are deactivated or taken down
+    AND ca.account_active
+    -- Filter by whether the actor is an artist. If unspecified, do not filter.
+    AND (
+        sqlc.narg(is_artist)::BOOLEAN IS NULL
+        OR ca.is_artist = sqlc.narg(is_artist)
+    )
+    -- Match actors with at least one of the queried roles.
+    -- If unspecified, do not filter.
+    AND (
+        COALESCE(sqlc.narg(actor_roles)::TEXT [], '{}') = '{}'
+        OR sqlc.narg(actor_roles)::TEXT [] && ca.roles
+    )
     -- Remove posts hidden by our moderators
     AND cp.is_hidden = FALSE
     -- Remove posts deleted by the actors
@@ -72,10 +87,7 @@ WHERE
             -- Filter by NSFW status. If unspecified, do not filter.
             AND (
                 sqlc.narg(is_nsfw)::BOOLEAN IS NULL
-                OR (
-                    (ARRAY['nsfw', 'mursuit', 'murrsuit', 'nsfwfurry', 'furrynsfw'] && cp.hashtags)
-                    OR (ARRAY['porn', 'nudity', 'sexual'] && cp.self_labels)
-                ) = sqlc.narg(is_nsfw)
+                OR cp.is_nsfw = sqlc.narg(is_nsfw)
             )
         )
         -- Pinned DID criteria.
@@ -98,8 +110,73 @@ WHERE
 LIMIT 1;
 
 -- name: ListScoredPosts :many
+-- Lists posts in score order. If a seed is given, each score is multiplied by
+-- a random factor between 1 - jitter and 1 + jitter. The factor is derived
+-- from the seed and the URI, so the same seed always gives the same order.
 WITH args AS (
     SELECT sqlc.narg(allowed_embeds)::TEXT [] AS allowed_embeds
+),
+
+-- A score is part of every generation from generation_seq up to, but not
+-- including, valid_until_seq. The scores in the generation are selected in
+-- three parts, so that each can be read from an index in score order: those
+-- scored in the generation, those scored earlier which are still current, and
+-- those scored earlier which have since been superseded. The planner folds
+-- away the jitter when there's no seed, so the index order is kept.
+scores AS (
+    SELECT
+        ps.uri,
+        CASE
+            WHEN sqlc.narg(seed)::TEXT IS NULL THEN ps.score
+            ELSE (
+                ps.score * (
+                    1 + sqlc.arg(jitter)::REAL
+                    * ('x' || SUBSTR(MD5(sqlc.narg(seed)::TEXT || ps.uri), 1, 8))::BIT(32)::INT
+                    / 2147483648.0
+                )
+            )::REAL
+        END AS score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = sqlc.arg(alg)
+        AND ps.generation_seq = sqlc.arg(generation_seq)
+    UNION ALL
+    SELECT
+        ps.uri,
+        CASE
+            WHEN sqlc.narg(seed)::TEXT IS NULL THEN ps.score
+            ELSE (
+                ps.score * (
+                    1 + sqlc.arg(jitter)::REAL
+                    * ('x' || SUBSTR(MD5(sqlc.narg(seed)::TEXT || ps.uri), 1, 8))::BIT(32)::INT
+                    / 2147483648.0
+                )
+            )::REAL
+        END AS score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = sqlc.arg(alg)
+        AND ps.generation_seq < sqlc.arg(generation_seq)
+        AND ps.valid_until_seq IS NULL
+    UNION ALL
+    SELECT
+        ps.uri,
+        CASE
+            WHEN sqlc.narg(seed)::TEXT IS NULL THEN ps.score
+            ELSE (
+                ps.score * (
+                    1 + sqlc.arg(jitter)::REAL
+                    * ('x' || SUBSTR(MD5(sqlc.narg(seed)::TEXT || ps.uri), 1, 8))::BIT(32)::INT
+                    / 2147483648.0
+                )
+            )::REAL
+        END AS score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = sqlc.arg(alg)
+        AND ps.generation_seq < sqlc.arg(generation_seq)
+        AND ps.valid_until_seq > sqlc.arg(generation_seq)
+        AND ps.valid_until_seq > ps.generation_seq + 1
 )
 
 SELECT
@@ -108,14 +185,23 @@ SELECT
 FROM
     candidate_posts AS cp
 INNER JOIN candidate_actors AS ca ON cp.actor_did = ca.did
-INNER JOIN post_scores AS ph
-    ON
-        cp.uri = ph.uri AND ph.alg = sqlc.arg(alg)
-        AND ph.generation_seq = sqlc.arg(generation_seq)
+INNER JOIN scores AS ph ON cp.uri = ph.uri
 NATURAL JOIN args
 WHERE
     cp.is_hidden = FALSE
     AND ca.status = 'approved'
+    AND ca.account_active
+    -- Filter by whether the actor is an artist. If unspecified, do not filter.
+    AND (
+        sqlc.narg(is_artist)::BOOLEAN IS NULL
+        OR ca.is_artist = sqlc.narg(is_artist)
+    )
+    -- Match actors with at least one of the queried roles.
+    -- If unspecified, do not filter.
+    AND (
+        COALESCE(sqlc.narg(actor_roles)::TEXT [], '{}') = '{}'
+        OR sqlc.narg(actor_roles)::TEXT [] && ca.roles
+    )
     -- Match at least one of the queried hashtags.
     -- If unspecified, do not filter.
     AND (
@@ -146,10 +232,7 @@ WHERE
     -- Filter by NSFW status. If unspecified, do not filter.
     AND (
         sqlc.narg(is_nsfw)::BOOLEAN IS NULL
-        OR (
-            (ARRAY['nsfw', 'mursuit', 'murrsuit', 'nsfwfurry', 'furrynsfw'] && cp.hashtags)
-            OR (ARRAY['porn', 'nudity', 'sexual'] && cp.self_labels)
-        ) = sqlc.narg(is_nsfw)
+        OR cp.is_nsfw = sqlc.narg(is_nsfw)
     )
     AND cp.deleted_at IS NULL
     AND (
@@ -161,3 +244,156 @@ WHERE
 ORDER BY
     ph.score DESC, ph.uri DESC
 LIMIT sqlc.arg(_limit);
+
+-- name: CountScoredPostsAbove :one
+-- Counts the posts which ListScoredPosts would list before the post with the
+-- given score and URI.
+WITH args AS (
+    SELECT sqlc.narg(allowed_embeds)::TEXT [] AS allowed_embeds
+),
+
+-- A score is part of every generation from generation_seq up to, but not
+-- including, valid_until_seq. The scores in the generation are selected in
+-- three parts, so that each can be read from an index in score order: those
+-- scored in the generation, those scored earlier which are still current, and
+-- those scored earlier which have since been superseded.
+scores AS (
+    SELECT ps.uri, ps.score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = sqlc.arg(alg)
+        AND ps.generation_seq = sqlc.arg(generation_seq)
+    UNION ALL
+    SELECT ps.uri, ps.score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = sqlc.arg(alg)
+        AND ps.generation_seq < sqlc.arg(generation_seq)
+        AND ps.valid_until_seq IS NULL
+    UNION ALL
+    SELECT ps.uri, ps.score
+    FROM post_scores AS ps
+    WHERE
+        ps.alg = sqlc.arg(alg)
+        AND ps.generation_seq < sqlc.arg(generation_seq)
+        AND ps.valid_until_seq > sqlc.arg(generation_seq)
+        AND ps.valid_until_seq > ps.generation_seq + 1
+)
+
+SELECT COUNT(*)
+FROM
+    candidate_posts AS cp
+INNER JOIN candidate_actors AS ca ON cp.actor_did = ca.did
+INNER JOIN scores AS ph ON cp.uri = ph.uri
+NATURAL JOIN args
+WHERE
+    cp.is_hidden = FALSE
+    AND ca.status = 'approved'
+    AND ca.account_active
+    -- Filter by whether the actor is an artist. If unspecified, do not filter.
+    AND (
+        sqlc.narg(is_artist)::BOOLEAN IS NULL
+        OR ca.is_artist = sqlc.narg(is_artist)
+    )
+    -- Match actors with at least one of the queried roles.
+    -- If unspecified, do not filter.
+    AND (
+        COALESCE(sqlc.narg(actor_roles)::TEXT [], '{}') = '{}'
+        OR sqlc.narg(actor_roles)::TEXT [] && ca.roles
+    )
+    AND (
+        COALESCE(sqlc.narg(hashtags)::TEXT [], '{}') = '{}'
+        OR sqlc.narg(hashtags)::TEXT [] && cp.hashtags
+    )
+    AND (
+        COALESCE(sqlc.narg(disallowed_hashtags)::TEXT [], '{}') = '{}'
+        OR NOT sqlc.narg(disallowed_hashtags)::TEXT [] && cp.hashtags
+    )
+    AND (
+        CARDINALITY(args.allowed_embeds) = 0
+        OR (
+            'none' = ANY(args.allowed_embeds)
+            AND COALESCE(cp.has_media, FALSE) = FALSE
+            AND COALESCE(cp.has_video, FALSE) = FALSE
+        )
+        OR (
+            'image' = ANY(args.allowed_embeds)
+            AND COALESCE(cp.has_media, FALSE) = TRUE
+        )
+        OR (
+            'video' = ANY(args.allowed_embeds)
+            AND COALESCE(cp.has_video, FALSE) = TRUE
+        )
+    )
+    AND (
+        sqlc.narg(is_nsfw)::BOOLEAN IS NULL
+        OR cp.is_nsfw = sqlc.narg(is_nsfw)
+    )
+    AND cp.deleted_at IS NULL
+    AND (
+        ROW(ph.score, ph.uri)
+        > ROW((sqlc.arg(score))::REAL, (sqlc.arg(uri))::TEXT)
+    )
+    AND cp.indexed_at > NOW() - INTERVAL '7 day'
+    AND cp.created_at > NOW() - INTERVAL '7 day';
+
+-- name: ListHiddenCandidatePostURIs :many
+SELECT uri
+FROM candidate_posts
+WHERE
+    is_hidden = TRUE
+    AND deleted_at IS NULL;
+
+-- name: SoftDeleteCandidatePostsByActor :exec
+UPDATE candidate_posts
+SET deleted_at = $1
+WHERE actor_did = $2 AND deleted_at IS NULL;
+
+-- name: RestoreCandidatePostsByActor :exec
+-- Undoes SoftDeleteCandidatePostsByActor. Rows deleted at other times, e.g.
+-- by the actor, are left alone.
+UPDATE candidate_posts
+SET deleted_at = NULL
+WHERE actor_did = $1 AND deleted_at = $2;
+
+-- name: DeleteCandidatePostsByActor :execrows
+DELETE FROM candidate_posts
+WHERE actor_did = $1;
+
+-- name: ReclassifyNSFWPosts :one
+-- Reclassifies whether a batch of posts are NSFW, after the NSFW rules have
+-- changed. Posts are visited in URI order, starting after after_uri, and the
+-- last URI visited is returned so the next batch can carry on from it. Once
+-- every post has been visited, the last URI is empty.
+WITH batch AS (
+    SELECT
+        uri,
+        classify_post_nsfw(actor_did, hashtags, self_labels) AS is_nsfw
+    FROM candidate_posts
+    WHERE uri > sqlc.arg(after_uri)::TEXT
+    ORDER BY uri ASC
+    LIMIT sqlc.arg(batch_size)
+),
+
+reclassified AS (
+    UPDATE candidate_posts AS cp
+    SET is_nsfw = batch.is_nsfw
+    FROM batch
+    WHERE
+        cp.uri = batch.uri
+        AND cp.is_nsfw != batch.is_nsfw
+    RETURNING cp.uri
+)
+
+SELECT
+    COALESCE((SELECT MAX(batch.uri) FROM batch), '')::TEXT AS last_uri,
+    (SELECT COUNT(*) FROM reclassified) AS reclassified;
+
+-- name: ReclassifyActorNSFWPosts :execrows
+-- Reclassifies whether an actor's posts are NSFW, after their override or
+-- profile has changed.
+UPDATE candidate_posts
+SET is_nsfw = classify_post_nsfw(actor_did, hashtags, self_labels)
+WHERE
+    actor_did = $1
+    AND is_nsfw != classify_post_nsfw(actor_did, hashtags, self_labels);
@@ -0,0 +1,52 @@
+-- name: ReserveCuratedListItem :one
+-- Records the record key of the listitem adding the subject to the list,
+-- unless one is already recorded, and returns the recorded record key. This
+-- ensures concurrent additions write the same record.
+INSERT INTO curated_list_items (list_uri, subject_did, rkey)
+VALUES (
+    sqlc.arg(list_uri)::TEXT,
+    sqlc.arg(subject_did)::TEXT,
+    sqlc.arg(rkey)::TEXT
+)
+ON CONFLICT (list_uri, subject_did) DO UPDATE
+SET rkey = curated_list_items.rkey
+RETURNING rkey;
+
+-- name: ListCuratedListItems :many
+SELECT *
+FROM curated_list_items
+WHERE list_uri = $1;
+
+-- name: ListCuratedListItemsBySubject :many
+SELECT *
+FROM curated_list_items
+WHERE subject_did = $1;
+
+-- name: DeleteCuratedListItem :exec
+DELETE FROM curated_list_items
+WHERE
+    list_uri = sqlc.arg(list_uri)::TEXT
+    AND subject_did = sqlc.arg(subject_did)::TEXT;
+
+-- name: ReplaceCuratedListItems :exec
+-- Replaces the recorded listitems of a list with those given. The subjects
+-- and record keys are matched up by position.
+WITH
+items AS (
+    SELECT
+        UNNEST(sqlc.arg(subject_dids)::TEXT []) AS subject_did,
+        UNNEST(sqlc.arg(rkeys)::TEXT []) AS rkey
+),
+deleted AS (
+    DELETE FROM curated_list_items AS cli
+    WHERE
+        cli.list_uri = sqlc.arg(list_uri)::TEXT
+        AND cli.subject_did NOT IN (SELECT i.subject_did FROM items AS i)
+)
+
+INSERT INTO curated_list_items (list_uri, subject_did, rkey)
+SELECT sqlc.arg(list_uri)::TEXT, i.subject_did, i.rkey
+FROM items AS i
+ON CONFLICT (list_uri, subject_did) DO UPDATE
+SET rkey = excluded.rkey
+WHERE curated_list_items.rkey != excluded.rkey;
@@ -0,0 +1,96 @@
+-- name: CreateDeadLetterEvent :one
+INSERT INTO dead_letter_events (
+    actor_did,
+    event,
+    last_error,
+    attempts,
+    created_at,
+    last_attempt_at,
+    next_attempt_at
+) VALUES ($1, $2, $3, 1, NOW(), NOW(), $4)
+RETURNING *;
+
+-- name: ListRetryingDeadLetterActors :many
+-- Lists the actors with events awaiting a retry, and when the oldest of them is
+-- next due. An actor's events are retried in order, so the later events wait
+-- for the oldest.
+SELECT DISTINCT ON (actor_did)
+    actor_did,
+    next_attempt_at
+FROM dead_letter_events
+WHERE next_attempt_at IS NOT NULL
+ORDER BY actor_did ASC, id ASC;
+
+-- name: ClaimDeadLetterEventsForActor :many
+-- Claims an actor's events awaiting a retry, if the oldest of them is due, by
+-- bumping their next_attempt_at to the end of a lease. If the claiming
+-- ingester dies, the events become due again once the lease expires.
+UPDATE dead_letter_events
+SET next_attempt_at = sqlc.arg(lease_until)::TIMESTAMPTZ
+WHERE id IN (
+    SELECT d.id
+    FROM dead_letter_events AS d
+    WHERE
+        d.actor_did = sqlc.arg(actor_did)
+        AND d.next_attempt_at IS NOT NULL
+        AND (
+            SELECT oldest.next_attempt_at
+            FROM dead_letter_events AS oldest
+            WHERE
+                oldest.actor_did = sqlc.arg(actor_did)
+                AND oldest.next_attempt_at IS NOT NULL
+            ORDER BY oldest.id ASC
+            LIMIT 1
+        ) <= NOW()
+    ORDER BY d.id ASC
+    LIMIT sqlc.arg(max_results)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkDeadLetterEventFailed :exec
+UPDATE dead_letter_events
+SET
+    attempts = attempts + 1,
+    last_error = $2,
+    last_attempt_at = NOW(),
+    next_attempt_at = $3
+WHERE id = $1;
+
+-- name: DeleteDeadLetterEvent :execrows
+DELETE FROM dead_letter_events
+WHERE id = $1;
+
+-- name: GetDeadLetterEvent :one
+SELECT *
+FROM dead_letter_events
+WHERE id = $1;
+
+-- name: ListDeadLetterEvents :many
+SELECT *
+FROM dead_letter_events
+WHERE
+    (
+        sqlc.narg(actor_did)::TEXT IS NULL
+        OR actor_did = sqlc.narg(actor_did)
+    )
+    AND (
+        sqlc.narg(before_id)::BIGINT IS NULL
+        OR id < sqlc.narg(before_id)
+    )
+ORDER BY id DESC
+LIMIT sqlc.arg(max_results);
+
+-- name: ReplayDeadLetterEvent :one
+-- Makes an event due a retry immediately, including those which have
+-- exhausted their attempts.
+UPDATE dead_letter_events
+SET next_attempt_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: CountDeadLetterEvents :one
+SELECT
+    COUNT(*) FILTER (WHERE next_attempt_at IS NOT NULL) AS retrying,
+    COUNT(*) FILTER (WHERE next_attempt_at IS NULL) AS exhausted
+FROM dead_letter_events;
@@ -0,0 +1,93 @@
+-- name: HeartbeatIngesterReplica :exec
+INSERT INTO ingester_replicas (id, last_seen_at)
+VALUES ($1, $2)
+ON CONFLICT (id) DO
+UPDATE SET last_seen_at = excluded.last_seen_at;
+
+-- name: DeleteIngesterReplica :exec
+DELETE FROM ingester_replicas
+WHERE id = $1;
+
+-- name: DeleteStaleIngesterReplicas :exec
+DELETE FROM ingester_replicas
+WHERE last_seen_at <= $1;
+
+-- name: CountIngesterReplicas :one
+SELECT COUNT(*)
+FROM ingester_replicas;
+
+-- name: CreateIngesterShards :exec
+-- Creates any missing shards for a shard count. New shards resume from the
+-- oldest cursor of any other shard count, or the unsharded cursor, so that no
+-- events are skipped when the shard count changes.
+INSERT INTO ingester_shards (shard_count, shard, cursor)
+SELECT
+    sqlc.arg(shard_count)::INT,
+    s.shard,
+    COALESCE(
+        (
+            SELECT MIN(o.cursor)
+            FROM ingester_shards AS o
+            WHERE o.shard_count != sqlc.arg(shard_count)::INT
+        ),
+        (SELECT j.cursor FROM jetstream_cursor AS j)
+    )
+FROM GENERATE_SERIES(0, sqlc.arg(shard_count)::INT - 1) AS s (shard)
+ON CONFLICT (shard_count, shard) DO NOTHING;
+
+-- name: ListIngesterShards :many
+SELECT *
+FROM ingester_shards
+WHERE shard_count = $1
+ORDER BY shard ASC;
+
+-- name: AcquireIngesterShards :many
+-- Takes the leases of up to max_results shards which have been released or
+-- whose lease has expired.
+UPDATE ingester_shards
+SET
+    owner = sqlc.arg(owner)::TEXT,
+    lease_expires_at = sqlc.arg(lease_until)::TIMESTAMPTZ
+WHERE (shard_count, shard) IN (
+    SELECT s.shard_count, s.shard
+    FROM ingester_shards AS s
+    WHERE
+        s.shard_count = sqlc.arg(shard_count)::INT
+        AND (
+            s.lease_expires_at IS NULL
+            OR s.lease_expires_at <= sqlc.arg(now)::TIMESTAMPTZ
+        )
+    ORDER BY s.shard ASC
+    LIMIT sqlc.arg(max_results)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RenewIngesterShardLeases :many
+-- Extends the unexpired leases held by a replica, returning the shards it
+-- still holds.
+UPDATE ingester_shards
+SET lease_expires_at = sqlc.arg(lease_until)::TIMESTAMPTZ
+WHERE
+    shard_count = sqlc.arg(shard_count)::INT
+    AND owner = sqlc.arg(owner)::TEXT
+    AND lease_expires_at > sqlc.arg(now)::TIMESTAMPTZ
+RETURNING shard;
+
+-- name: ReleaseIngesterShard :exec
+UPDATE ingester_shards
+SET owner = NULL, lease_expires_at = NULL
+WHERE
+    shard_count = sqlc.arg(shard_count)::INT
+    AND shard = sqlc.arg(shard)::INT
+    AND owner = sqlc.arg(owner)::TEXT;
+
+-- name: SetIngesterShardCursor :execrows
+-- Only updates the cursor if the replica still holds the lease, so that a
+-- replica which has lost it can't rewind the new owner.
+UPDATE ingester_shards
+SET cursor = sqlc.arg(cursor)::BIGINT
+WHERE
+    shard_count = sqlc.arg(shard_count)::INT
+    AND shard = sqlc.arg(shard)::INT
+    AND owner = sqlc.arg(owner)::TEXT;
@@ -0,0 +1,47 @@
+-- name: CreateLabel :one
+INSERT INTO labels (src, uri, val, neg, cts, sig)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetLatestLabelSeq :one
+SELECT COALESCE(MAX(seq), 0)::BIGINT AS seq
+FROM labels;
+
+-- name: ListLabels :many
+SELECT *
+FROM labels
+WHERE
+    seq > sqlc.arg(after_seq)
+    -- If no patterns are specified, do not filter by URI.
+    AND (
+        CARDINALITY(sqlc.arg(uri_patterns)::TEXT []) = 0
+        OR uri LIKE ANY(sqlc.arg(uri_patterns)::TEXT [])
+    )
+    -- If no sources are specified, do not filter by source.
+    AND (
+        CARDINALITY(sqlc.arg(sources)::TEXT []) = 0
+        OR src = ANY(sqlc.arg(sources)::TEXT [])
+    )
+ORDER BY seq ASC
+LIMIT sqlc.arg(max_results);
+
+-- name: ListActiveLabels :many
+-- Returns the (uri, val) pairs where the most recent label emitted by the
+-- given source has not been negated. If uris is non-empty, only labels on
+-- those subjects are returned.
+SELECT l.uri, l.val
+FROM (
+    SELECT DISTINCT ON (uri, val)
+        uri,
+        val,
+        neg
+    FROM labels
+    WHERE
+        src = sqlc.arg(src)
+        AND (
+            CARDINALITY(sqlc.arg(uris)::TEXT []) = 0
+            OR uri = ANY(sqlc.arg(uris)::TEXT [])
+        )
+    ORDER BY uri ASC, val ASC, seq DESC
+) AS l
+WHERE l.neg = FALSE;
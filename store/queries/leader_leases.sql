@@ -0,0 +1,29 @@
+-- name: AcquireLeaderLease :one
+-- Takes or renews a lease, if it's free, has expired or is already held by
+-- the holder. No rows are returned if another holder has the lease.
+INSERT INTO leader_leases (name, holder, acquired_at, expires_at)
+VALUES (
+    sqlc.arg(name)::TEXT,
+    sqlc.arg(holder)::TEXT,
+    sqlc.arg(now)::TIMESTAMPTZ,
+    sqlc.arg(lease_until)::TIMESTAMPTZ
+)
+ON CONFLICT (name) DO UPDATE
+SET
+    holder = excluded.holder,
+    acquired_at = CASE
+        WHEN leader_leases.holder = excluded.holder
+            THEN leader_leases.acquired_at
+        ELSE excluded.acquired_at
+    END,
+    expires_at = excluded.expires_at
+WHERE
+    leader_leases.holder = excluded.holder
+    OR leader_leases.expires_at <= excluded.acquired_at
+RETURNING *;
+
+-- name: ReleaseLeaderLease :exec
+DELETE FROM leader_leases
+WHERE
+    name = sqlc.arg(name)::TEXT
+    AND holder = sqlc.arg(holder)::TEXT;
@@ -0,0 +1,13 @@
+-- name: ListNSFWRules :many
+SELECT *
+FROM nsfw_rules
+ORDER BY kind ASC, value ASC;
+
+-- name: CreateNSFWRule :execrows
+INSERT INTO nsfw_rules (kind, value, created_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (kind, value) DO NOTHING;
+
+-- name: DeleteNSFWRule :execrows
+DELETE FROM nsfw_rules
+WHERE kind = $1 AND value = $2;
@@ -0,0 +1,38 @@
+-- name: CountPostNetworkInteractions :exec
+-- Records likes and reposts of candidate posts, and adds those which haven't
+-- been recorded before to the counts of the posts they're of. Interactions
+-- with posts which aren't candidate posts are discarded.
+WITH counted AS (
+    INSERT INTO post_network_interactions (
+        uri, subject_uri, is_repost, counted_at
+    )
+    SELECT c.uri, c.subject_uri, c.is_repost, NOW()
+    FROM UNNEST(
+        sqlc.arg(uris)::TEXT [],
+        sqlc.arg(subject_uris)::TEXT [],
+        sqlc.arg(is_reposts)::BOOLEAN []
+    ) AS c (uri, subject_uri, is_repost)
+    INNER JOIN candidate_posts AS cp ON c.subject_uri = cp.uri
+    ON CONFLICT (uri) DO NOTHING
+    RETURNING subject_uri, is_repost
+)
+INSERT INTO post_network_counts (uri, likes, reposts)
+SELECT
+    counted.subject_uri,
+    COUNT(*) FILTER (WHERE NOT counted.is_repost),
+    COUNT(*) FILTER (WHERE counted.is_repost)
+FROM counted
+GROUP BY counted.subject_uri
+ORDER BY counted.subject_uri
+ON CONFLICT (uri) DO UPDATE SET
+likes = post_network_counts.likes + excluded.likes,
+reposts = post_network_counts.reposts + excluded.reposts;
+
+-- name: DeleteOldPostNetworkInteractions :execrows
+DELETE FROM post_network_interactions
+WHERE counted_at < sqlc.arg(before);
+
+-- name: GetPostNetworkCounts :one
+SELECT *
+FROM post_network_counts
+WHERE uri = $1;
@@ -1,31 +1,393 @@
 -- name: DeleteOldPostScores :execrows
+-- Deletes scores that stopped being current before the given time. Current
+-- scores are kept however old they are.
 DELETE FROM post_scores
-WHERE generated_at < sqlc.arg(before)::TIMESTAMPTZ;
+WHERE
+    valid_until_seq IS NOT NULL
+    AND COALESCE(superseded_at, generated_at) < sqlc.arg(before)::TIMESTAMPTZ;
+
+-- name: DeleteOldScoreGenerations :execrows
+-- Deletes generations created before the given time, other than the latest
+-- generation of each algorithm.
+DELETE FROM post_score_generations AS psg
+WHERE
+    psg.generated_at < sqlc.arg(before)::TIMESTAMPTZ
+    AND psg.generation_seq < (
+        SELECT MAX(latest.generation_seq)
+        FROM post_score_generations AS latest
+        WHERE latest.alg = psg.alg
+    );
 
--- name: MaterializePostScores :one
-WITH seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq)
+-- name: GetLatestScoreGeneration :one
+SELECT psg.generation_seq
+FROM post_score_generations AS psg
+WHERE psg.alg = sqlc.arg(alg)
+ORDER BY psg.generation_seq DESC
+LIMIT 1;
+
+-- name: GetPostScore :one
+SELECT *
+FROM post_scores AS ps
+WHERE
+    ps.uri = sqlc.arg(uri)
+    AND ps.alg = sqlc.arg(alg)
+    AND ps.generation_seq <= sqlc.arg(generation_seq)
+    AND (
+        ps.valid_until_seq IS NULL
+        OR ps.valid_until_seq > sqlc.arg(generation_seq)
+    );
 
-INSERT INTO post_scores (uri, alg, score, generation_seq)
+-- name: DeletePostScoresByActor :execrows
+-- Deletes the scores of an actor's posts. This must be done before the posts
+-- are deleted.
+DELETE FROM post_scores AS ps
+USING candidate_posts AS cp
+WHERE ps.uri = cp.uri AND cp.actor_did = $1;
+
+-- name: MaterializeDampenedPostScores :one
+-- Scores posts in the same way as the "classic" algorithm, but with each like
+-- weighted by the dampeners in weighted_candidate_likes.
+WITH
+seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq),
+generation AS (
+    INSERT INTO post_score_generations (alg, generation_seq)
+    SELECT sqlc.arg(alg)::TEXT, seq.seq FROM seq
+),
+superseded AS (
+    UPDATE post_scores AS ps
+    SET
+        valid_until_seq = (SELECT seq FROM seq),
+        superseded_at = NOW()
+    WHERE ps.alg = sqlc.arg(alg)::TEXT AND ps.valid_until_seq IS NULL
+),
+weighted AS (
+    SELECT
+        wcl.subject_uri,
+        SUM(wcl.weight) AS likes
+    FROM weighted_candidate_likes(
+        sqlc.arg(after)::TIMESTAMPTZ,
+        sqlc.arg(new_liker_since)::TIMESTAMPTZ,
+        sqlc.arg(new_liker_weight)::FLOAT8,
+        sqlc.arg(max_likes_per_author_per_day)::INT,
+        sqlc.arg(reciprocal_weight)::FLOAT8
+    ) AS wcl
+    GROUP BY wcl.subject_uri
+)
+
+INSERT INTO post_scores (uri, alg, score, generation_seq, valid_until_seq)
 SELECT
     cp.uri AS uri,
-    'classic' AS alg,
-    (
-        SELECT COUNT(*)
-        FROM candidate_likes AS cl
-        WHERE cl.subject_uri = cp.uri AND cl.deleted_at IS NULL
-    )
+    sqlc.arg(alg)::TEXT AS alg,
+    COALESCE(w.likes, 0)
     / (EXTRACT(EPOCH FROM NOW() - cp.indexed_at) / (60 * 60) + 2)
     ^ 1.85 AS score,
-    (SELECT seq FROM seq) AS generation_seq
+    (SELECT seq FROM seq) AS generation_seq,
+    (SELECT seq FROM seq) + 1 AS valid_until_seq
 FROM candidate_posts AS cp
+LEFT JOIN weighted AS w ON cp.uri = w.subject_uri
 WHERE
     cp.deleted_at IS NULL
     AND cp.indexed_at >= sqlc.arg(after)::TIMESTAMPTZ
 RETURNING (SELECT seq FROM seq);
 
--- name: GetLatestScoreGeneration :one
-SELECT ph.generation_seq
-FROM post_scores AS ph
-WHERE ph.alg = sqlc.arg(alg)
-ORDER BY ph.generation_seq DESC
-LIMIT 1;
+-- name: DeleteReciprocalLikePairs :exec
+DELETE FROM reciprocal_like_pairs;
+
+-- name: InsertReciprocalLikePairs :execrows
+-- Flags the pairs of actors who have each liked at least min_likes of the
+-- other's posts since the given time, for weighted_candidate_likes to dampen.
+INSERT INTO reciprocal_like_pairs (
+    actor_did, partner_did, likes_given, likes_received
+)
+SELECT
+    rl.actor_did,
+    rl.partner_did,
+    rl.likes_given,
+    rl.likes_received
+FROM reciprocal_likers(
+    sqlc.arg(since)::TIMESTAMPTZ, sqlc.arg(min_likes)::INT
+) AS rl;
+
+-- name: ListReciprocalLikers :many
+-- Lists the flagged pairs of actors who like each other's posts, with the
+-- most active pairs first. Each pair is only listed once.
+SELECT
+    rlp.actor_did,
+    rlp.partner_did,
+    rlp.likes_given,
+    rlp.likes_received
+FROM reciprocal_like_pairs AS rlp
+WHERE rlp.actor_did < rlp.partner_did
+ORDER BY
+    LEAST(rlp.likes_given, rlp.likes_received) DESC,
+    rlp.actor_did ASC,
+    rlp.partner_did ASC
+LIMIT sqlc.arg(max_results);
+
+-- name: ListDampenedPosts :many
+-- Lists posts whose likes have been dampened, with the posts that lost the
+-- most likes first.
+SELECT
+    wcl.subject_uri,
+    wcl.author_did,
+    COUNT(*) AS likes,
+    SUM(wcl.weight)::FLOAT8 AS weighted_likes
+FROM weighted_candidate_likes(
+    sqlc.arg(after)::TIMESTAMPTZ,
+    sqlc.arg(new_liker_since)::TIMESTAMPTZ,
+    sqlc.arg(new_liker_weight)::FLOAT8,
+    sqlc.arg(max_likes_per_author_per_day)::INT,
+    sqlc.arg(reciprocal_weight)::FLOAT8
+) AS wcl
+GROUP BY wcl.subject_uri, wcl.author_did
+HAVING SUM(wcl.weight) < COUNT(*)
+ORDER BY COUNT(*) - SUM(wcl.weight) DESC, wcl.subject_uri ASC
+LIMIT sqlc.arg(max_results);
+
+-- name: RescorePostScores :one
+-- Creates a generation of the "classic" algorithm, carrying over the scores
+-- of posts whose like count hasn't changed from the previous generation. As
+-- scores decay with age, they're also recomputed once they've decayed by more
+-- than max_decay, as a fraction of the score. Scores of posts that are no
+-- longer in the lookback window are superseded without a replacement.
+WITH
+seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq),
+generation AS (
+    INSERT INTO post_score_generations (alg, generation_seq)
+    SELECT 'classic', seq.seq FROM seq
+),
+current_scores AS (
+    SELECT
+        ps.uri,
+        ps.likes,
+        ps.generated_at
+    FROM post_scores AS ps
+    WHERE ps.alg = 'classic' AND ps.valid_until_seq IS NULL
+),
+window_posts AS (
+    SELECT
+        cp.uri,
+        EXTRACT(EPOCH FROM NOW() - cp.indexed_at) / (60 * 60) AS age_hours,
+        COALESCE(plc.likes, 0) AS likes
+    FROM candidate_posts AS cp
+    LEFT JOIN post_like_counts AS plc ON cp.uri = plc.uri
+    WHERE
+        cp.deleted_at IS NULL
+        AND cp.indexed_at >= sqlc.arg(after)::TIMESTAMPTZ
+),
+rescored AS (
+    SELECT
+        wp.uri,
+        wp.likes,
+        wp.likes / (wp.age_hours + 2) ^ 1.85 AS score
+    FROM window_posts AS wp
+    LEFT JOIN current_scores AS cs ON wp.uri = cs.uri
+    WHERE
+        cs.uri IS NULL
+        OR cs.likes IS DISTINCT FROM wp.likes
+        -- Scores fall by roughly 1.85 / (age_hours + 2) of themselves an
+        -- hour, and scores of posts without likes never change.
+        OR (
+            wp.likes > 0
+            AND 1.85 * EXTRACT(EPOCH FROM NOW() - cs.generated_at) / (60 * 60)
+            / (wp.age_hours + 2) > sqlc.arg(max_decay)::FLOAT8
+        )
+),
+superseded AS (
+    UPDATE post_scores AS ps
+    SET
+        valid_until_seq = (SELECT seq FROM seq),
+        superseded_at = NOW()
+    WHERE
+        ps.alg = 'classic'
+        AND ps.valid_until_seq IS NULL
+        AND (
+            ps.uri IN (SELECT r.uri FROM rescored AS r)
+            OR NOT EXISTS (
+                SELECT 1 FROM window_posts AS wp WHERE wp.uri = ps.uri
+            )
+        )
+    RETURNING ps.uri
+),
+inserted AS (
+    INSERT INTO post_scores (uri, alg, score, generation_seq, likes)
+    SELECT
+        r.uri,
+        'classic',
+        r.score,
+        (SELECT seq FROM seq),
+        r.likes
+    FROM rescored AS r
+    RETURNING uri
+)
+
+SELECT
+    (SELECT seq FROM seq)::BIGINT AS generation_seq,
+    (SELECT COUNT(*) FROM inserted) AS rescored,
+    (SELECT COUNT(*) FROM superseded) AS superseded;
+
+-- name: RescoreDiscoveryPostScores :one
+-- Creates a generation of the "discovery" algorithm, which favours image
+-- posts with few likes for their age. Posts less than an hour old aren't
+-- scored, as they're still near the top of the chronological feeds. As with
+-- RescorePostScores, only posts whose like count has changed, or whose score
+-- may have moved by more than max_decay, are rescored. Scores of posts that are
+-- no longer eligible are superseded without a replacement.
+WITH
+seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq),
+generation AS (
+    INSERT INTO post_score_generations (alg, generation_seq)
+    SELECT 'discovery', seq.seq FROM seq
+),
+current_scores AS (
+    SELECT
+        ps.uri,
+        ps.likes,
+        ps.generated_at
+    FROM post_scores AS ps
+    WHERE ps.alg = 'discovery' AND ps.valid_until_seq IS NULL
+),
+eligible_posts AS (
+    SELECT
+        cp.uri,
+        EXTRACT(EPOCH FROM NOW() - cp.indexed_at) / (60 * 60) AS age_hours,
+        COALESCE(plc.likes, 0) AS likes
+    FROM candidate_posts AS cp
+    LEFT JOIN post_like_counts AS plc ON cp.uri = plc.uri
+    WHERE
+        cp.deleted_at IS NULL
+        AND cp.is_hidden = FALSE
+        AND COALESCE(cp.has_media, FALSE) = TRUE
+        AND cp.indexed_at >= sqlc.arg(after)::TIMESTAMPTZ
+        AND cp.indexed_at < NOW() - INTERVAL '1 hour'
+),
+rescored AS (
+    SELECT
+        ep.uri,
+        ep.likes,
+        1 / (
+            (1 + ep.likes / (ep.age_hours + 1))
+            * SQRT(ep.age_hours + 2)
+        ) AS score
+    FROM eligible_posts AS ep
+    LEFT JOIN current_scores AS cs ON ep.uri = cs.uri
+    WHERE
+        cs.uri IS NULL
+        OR cs.likes IS DISTINCT FROM ep.likes
+        -- Scores move by less than 1 / (age_hours + 1) of themselves an
+        -- hour, whether they're falling with age or rising as the post's
+        -- likes age.
+        OR EXTRACT(EPOCH FROM NOW() - cs.generated_at) / (60 * 60)
+        / (ep.age_hours + 1) > sqlc.arg(max_decay)::FLOAT8
+),
+superseded AS (
+    UPDATE post_scores AS ps
+    SET
+        valid_until_seq = (SELECT seq FROM seq),
+        superseded_at = NOW()
+    WHERE
+        ps.alg = 'discovery'
+        AND ps.valid_until_seq IS NULL
+        AND (
+            ps.uri IN (SELECT r.uri FROM rescored AS r)
+            OR NOT EXISTS (
+                SELECT 1 FROM eligible_posts AS ep WHERE ep.uri = ps.uri
+            )
+        )
+    RETURNING ps.uri
+),
+inserted AS (
+    INSERT INTO post_scores (uri, alg, score, generation_seq, likes)
+    SELECT
+        r.uri,
+        'discovery',
+        r.score,
+        (SELECT seq FROM seq),
+        r.likes
+    FROM rescored AS r
+    RETURNING uri
+)
+
+SELECT
+    (SELECT seq FROM seq)::BIGINT AS generation_seq,
+    (SELECT COUNT(*) FROM inserted) AS rescored,
+    (SELECT COUNT(*) FROM superseded) AS superseded;
+
+-- name: RescoreNetworkPostScores :one
+-- Creates a generation of the "network" algorithm, which scores posts in the
+-- same way as the "classic" algorithm, but by their likes and reposts from
+-- anyone on the network. As with RescorePostScores, only posts whose count has
+-- changed, or whose score has decayed by more than max_decay, are rescored.
+WITH
+seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq),
+generation AS (
+    INSERT INTO post_score_generations (alg, generation_seq)
+    SELECT 'network', seq.seq FROM seq
+),
+current_scores AS (
+    SELECT
+        ps.uri,
+        ps.likes,
+        ps.generated_at
+    FROM post_scores AS ps
+    WHERE ps.alg = 'network' AND ps.valid_until_seq IS NULL
+),
+window_posts AS (
+    SELECT
+        cp.uri,
+        EXTRACT(EPOCH FROM NOW() - cp.indexed_at) / (60 * 60) AS age_hours,
+        COALESCE(pnc.likes, 0) + COALESCE(pnc.reposts, 0) AS interactions
+    FROM candidate_posts AS cp
+    LEFT JOIN post_network_counts AS pnc ON cp.uri = pnc.uri
+    WHERE
+        cp.deleted_at IS NULL
+        AND cp.indexed_at >= sqlc.arg(after)::TIMESTAMPTZ
+),
+rescored AS (
+    SELECT
+        wp.uri,
+        wp.interactions,
+        wp.interactions / (wp.age_hours + 2) ^ 1.85 AS score
+    FROM window_posts AS wp
+    LEFT JOIN current_scores AS cs ON wp.uri = cs.uri
+    WHERE
+        cs.uri IS NULL
+        OR cs.likes IS DISTINCT FROM wp.interactions
+        OR (
+            wp.interactions > 0
+            AND 1.85 * EXTRACT(EPOCH FROM NOW() - cs.generated_at) / (60 * 60)
+            / (wp.age_hours + 2) > sqlc.arg(max_decay)::FLOAT8
+        )
+),
+superseded AS (
+    UPDATE post_scores AS ps
+    SET
+        valid_until_seq = (SELECT seq FROM seq),
+        superseded_at = NOW()
+    WHERE
+        ps.alg = 'network'
+        AND ps.valid_until_seq IS NULL
+        AND (
+            ps.uri IN (SELECT r.uri FROM rescored AS r)
+            OR NOT EXISTS (
+                SELECT 1 FROM window_posts AS wp WHERE wp.uri = ps.uri
+            )
+        )
+    RETURNING ps.uri
+),
+inserted AS (
+    INSERT INTO post_scores (uri, alg, score, generation_seq, likes)
+    SELECT
+        r.uri,
+        'network',
+        r.score,
+        (SELECT seq FROM seq),
+        r.interactions
+    FROM rescored AS r
+    RETURNING uri
+)
+
+SELECT
+    (SELECT seq FROM seq)::BIGINT AS generation_seq,
+    (SELECT COUNT(*) FROM inserted) AS rescored,
+    (SELECT COUNT(*) FROM superseded) AS superseded;
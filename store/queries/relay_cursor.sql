@@ -0,0 +1,8 @@
+-- name: SetRelayCursor :exec
+INSERT INTO relay_cursor (cursor)
+VALUES ($1)
+ON CONFLICT ((0)) DO
+UPDATE SET cursor = excluded.cursor;
+
+-- name: GetRelayCursor :one
+SELECT cursor FROM relay_cursor;
@@ -0,0 +1,150 @@
+-- name: EnqueueTask :one
+INSERT INTO tasks (
+    type,
+    payload,
+    max_tries,
+    next_try_at,
+    created_at,
+    dedupe_key
+) VALUES ($1, $2, $3, $4, NOW(), $5)
+ON CONFLICT (dedupe_key) WHERE status = 'pending' DO NOTHING
+RETURNING *;
+
+-- name: NotifyTaskEnqueued :exec
+SELECT PG_NOTIFY('tasks', sqlc.arg(type)::TEXT);
+
+-- name: ClaimNextTask :one
+-- Claims the next runnable task by bumping its next_try_at to the end of a
+-- lease. If the claiming worker dies, the task becomes runnable again once
+-- the lease expires. SKIP LOCKED allows several workers to claim concurrently.
+UPDATE tasks
+SET
+    tries = tries + 1,
+    next_try_at = sqlc.arg(lease_until)::TIMESTAMPTZ,
+    claimed_until = sqlc.arg(lease_until)::TIMESTAMPTZ
+WHERE id = (
+    SELECT t.id
+    FROM tasks AS t
+    WHERE
+        t.status = 'pending'
+        AND t.next_try_at <= NOW()
+        AND t.type = ANY(sqlc.arg(types)::TEXT [])
+    ORDER BY t.next_try_at ASC, t.id ASC
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkTaskAsDone :exec
+UPDATE tasks
+SET
+    status = 'done',
+    finished_at = NOW(),
+    claimed_until = NULL
+WHERE id = $1;
+
+-- name: MarkTaskAsErrored :exec
+UPDATE tasks
+SET
+    next_try_at = $2,
+    last_error = $3,
+    claimed_until = NULL
+WHERE id = $1;
+
+-- name: MarkTaskAsDead :exec
+UPDATE tasks
+SET
+    status = 'dead',
+    finished_at = NOW(),
+    last_error = $2,
+    claimed_until = NULL
+WHERE id = $1;
+
+-- name: ListPendingTaskActorDIDs :many
+SELECT DISTINCT (payload ->> 'actor_did')::TEXT AS actor_did
+FROM tasks
+WHERE
+    status = 'pending'
+    AND type = ANY(sqlc.arg(types)::TEXT []);
+
+-- name: GetTask :one
+SELECT *
+FROM tasks
+WHERE id = $1;
+
+-- name: GetConflictingPendingTask :one
+-- Gets the pending task, other than the given task, which has the same dedupe
+-- key. Only one pending task may have each dedupe key.
+SELECT other.*
+FROM tasks AS t
+INNER JOIN tasks AS other ON t.dedupe_key = other.dedupe_key
+WHERE
+    t.id = $1
+    AND other.id != t.id
+    AND other.status = 'pending';
+
+-- name: ListTasks :many
+SELECT *
+FROM tasks
+WHERE
+    (
+        sqlc.narg(status)::TASK_STATUS IS NULL
+        OR status = sqlc.narg(status)
+    )
+    -- Running tasks are pending tasks which have been claimed by a worker.
+    AND (
+        sqlc.narg(running)::BOOLEAN IS NULL
+        OR COALESCE(claimed_until > NOW(), FALSE) = sqlc.narg(running)
+    )
+    AND (
+        sqlc.narg(actor_did)::TEXT IS NULL
+        OR payload ->> 'actor_did' = sqlc.narg(actor_did)
+    )
+    AND (
+        sqlc.narg(type)::TEXT IS NULL
+        OR type = sqlc.narg(type)
+    )
+    AND (
+        sqlc.narg(before_id)::BIGINT IS NULL
+        OR id < sqlc.narg(before_id)
+    )
+ORDER BY id DESC
+LIMIT sqlc.arg(max_results);
+
+-- name: RetryTask :one
+-- Resets a task so it runs again immediately with a fresh set of tries. Only
+-- tasks which are not running or done can be retried.
+UPDATE tasks
+SET
+    status = 'pending',
+    tries = 0,
+    next_try_at = NOW(),
+    finished_at = NULL
+WHERE
+    id = $1
+    AND status IN ('pending', 'dead', 'cancelled')
+    AND COALESCE(claimed_until > NOW(), FALSE) = FALSE
+RETURNING *;
+
+-- name: CancelTask :one
+UPDATE tasks
+SET
+    status = 'cancelled',
+    finished_at = NOW()
+WHERE
+    id = $1
+    AND status = 'pending'
+    AND COALESCE(claimed_until > NOW(), FALSE) = FALSE
+RETURNING *;
+
+-- name: CountTasks :many
+-- Counts the tasks which are pending (including running) or failed, for
+-- monitoring. Done and cancelled tasks are excluded as they only accumulate.
+SELECT
+    type,
+    status,
+    COALESCE(claimed_until > NOW(), FALSE)::BOOLEAN AS running,
+    COUNT(*) AS count
+FROM tasks
+WHERE status IN ('pending', 'dead')
+GROUP BY type, status, running;
@@ -11,6 +11,9 @@ import (
 var (
 	// ErrNotFound indicates that no resource was found during a store call.
 	ErrNotFound = fmt.Errorf("not found")
+	// ErrTaskAlreadyPending indicates that a task was not enqueued, because a
+	// pending task with the same dedupe key already exists.
+	ErrTaskAlreadyPending = fmt.Errorf("task with dedupe key already pending")
 )
 
 type DirectConnector struct {
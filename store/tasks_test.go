@@ -0,0 +1,257 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestPGXStore_RetryTask(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	enqueue := func() *v1.Task {
+		task, err := harness.Store.EnqueueTask(ctx, store.EnqueueTaskOpts{
+			Type:      "test",
+			Payload:   map[string]string{},
+			DedupeKey: "test",
+		})
+		require.NoError(t, err)
+		return task
+	}
+	cancelled := enqueue()
+	_, err := harness.Store.CancelTask(ctx, cancelled.Id)
+	require.NoError(t, err)
+	pending := enqueue()
+
+	// Retrying the cancelled task would give two pending tasks the same
+	// dedupe key.
+	_, err = harness.Store.RetryTask(ctx, cancelled.Id)
+	require.ErrorIs(t, err, store.ErrTaskAlreadyPending)
+
+	// Once the other task is no longer pending, it can be retried.
+	_, err = harness.Store.CancelTask(ctx, pending.Id)
+	require.NoError(t, err)
+	retried, err := harness.Store.RetryTask(ctx, cancelled.Id)
+	require.NoError(t, err)
+	assert.Equal(t, v1.TaskState_TASK_STATE_PENDING, retried.State)
+}
+
+func TestPGXStore_ClaimNextTask(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	const tasks = 20
+	for i := 0; i < tasks; i++ {
+		_, err := harness.Store.EnqueueTask(ctx, store.EnqueueTaskOpts{
+			Type:    "test",
+			Payload: map[string]string{},
+		})
+		require.NoError(t, err)
+	}
+
+	// Several workers claiming at once should never be handed the same task.
+	mu := sync.Mutex{}
+	claimed := map[int64]int{}
+	eg, egCtx := errgroup.WithContext(ctx)
+	for i := 0; i < 4; i++ {
+		eg.Go(func() error {
+			for {
+				task, err := harness.Store.ClaimNextTask(egCtx, []string{"test"}, time.Minute)
+				if errors.Is(err, store.ErrNotFound) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				claimed[task.ID]++
+				mu.Unlock()
+			}
+		})
+	}
+	require.NoError(t, eg.Wait())
+	assert.Len(t, claimed, tasks)
+	for id, count := range claimed {
+		assert.Equal(t, 1, count, "task %d claimed more than once", id)
+	}
+}
+
+func TestPGXStore_ClaimNextTask_LeaseExpiry(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	enqueued, err := harness.Store.EnqueueTask(ctx, store.EnqueueTaskOpts{
+		Type:    "test",
+		Payload: map[string]string{},
+	})
+	require.NoError(t, err)
+
+	task, err := harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, enqueued.Id, task.ID)
+	assert.EqualValues(t, 1, task.Tries)
+
+	// Whilst the lease is held, the task can't be claimed again.
+	_, err = harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Second)
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	// If the worker holding the lease dies, the task is reclaimed once the
+	// lease expires.
+	var reclaimed gen.Task
+	require.Eventually(t, func() bool {
+		reclaimed, err = harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Minute)
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.Equal(t, enqueued.Id, reclaimed.ID)
+	assert.EqualValues(t, 2, reclaimed.Tries)
+}
+
+func TestPGXStore_MarkTaskAsErrored(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	_, err := harness.Store.EnqueueTask(ctx, store.EnqueueTaskOpts{
+		Type:    "test",
+		Payload: map[string]string{},
+	})
+	require.NoError(t, err)
+	task, err := harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Minute)
+	require.NoError(t, err)
+
+	// An errored task is released and rescheduled for after its backoff.
+	retryAt := time.Now().Add(time.Hour)
+	require.NoError(t, harness.Store.MarkTaskAsErrored(ctx, task.ID, fmt.Errorf("boom"), retryAt))
+	got, err := harness.Store.GetTask(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, v1.TaskState_TASK_STATE_PENDING, got.State)
+	assert.Equal(t, "boom", got.LastError)
+	assert.WithinDuration(t, retryAt, got.NextTryAt.AsTime(), time.Millisecond)
+	_, err = harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Minute)
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	// Once the backoff has passed, the task is claimed again.
+	require.NoError(t, harness.Store.MarkTaskAsErrored(ctx, task.ID, fmt.Errorf("boom"), time.Now()))
+	retried, err := harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, task.ID, retried.ID)
+	assert.EqualValues(t, 2, retried.Tries)
+}
+
+func TestPGXStore_MarkTaskAsDead(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	opts := store.EnqueueTaskOpts{
+		Type:      "test",
+		Payload:   map[string]string{},
+		MaxTries:  2,
+		DedupeKey: "test",
+	}
+	_, err := harness.Store.EnqueueTask(ctx, opts)
+	require.NoError(t, err)
+
+	// Use up the tries, as the worker would.
+	task, err := harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, harness.Store.MarkTaskAsErrored(ctx, task.ID, fmt.Errorf("first"), time.Now()))
+	task, err = harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, task.MaxTries, task.Tries)
+	require.NoError(t, harness.Store.MarkTaskAsDead(ctx, task.ID, fmt.Errorf("second")))
+
+	got, err := harness.Store.GetTask(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, v1.TaskState_TASK_STATE_FAILED, got.State)
+	assert.Equal(t, "second", got.LastError)
+	assert.NotNil(t, got.FinishedAt)
+
+	// Dead tasks are not claimed, and no longer hold their dedupe key.
+	_, err = harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Minute)
+	require.ErrorIs(t, err, store.ErrNotFound)
+	_, err = harness.Store.EnqueueTask(ctx, opts)
+	require.NoError(t, err)
+}
+
+func TestPGXStore_EnqueueTask_DedupeKey(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+
+	enqueue := func(key string) (*v1.Task, error) {
+		return harness.Store.EnqueueTask(ctx, store.EnqueueTaskOpts{
+			Type:      "test",
+			Payload:   map[string]string{},
+			DedupeKey: key,
+		})
+	}
+	first, err := enqueue("a")
+	require.NoError(t, err)
+	_, err = enqueue("a")
+	require.ErrorIs(t, err, store.ErrTaskAlreadyPending)
+	// Other keys, and tasks without a key, aren't affected.
+	_, err = enqueue("b")
+	require.NoError(t, err)
+	_, err = enqueue("")
+	require.NoError(t, err)
+	_, err = enqueue("")
+	require.NoError(t, err)
+
+	// A running task is still pending, so holds its key.
+	task, err := harness.Store.ClaimNextTask(ctx, []string{"test"}, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, first.Id, task.ID)
+	_, err = enqueue("a")
+	require.ErrorIs(t, err, store.ErrTaskAlreadyPending)
+
+	// Once it is done, the key can be used again.
+	require.NoError(t, harness.Store.MarkTaskAsDone(ctx, task.ID))
+	_, err = enqueue("a")
+	require.NoError(t, err)
+}
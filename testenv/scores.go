@@ -0,0 +1,58 @@
+package testenv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// fullClassicScoresQuery rescores every post for the "classic" algorithm,
+// counting each post's likes. The materializer rescores incrementally with
+// store.PGXStore.RescoreClassicPostScores instead, so this is only kept to
+// compare against.
+const fullClassicScoresQuery = `
+WITH
+seq AS (SELECT NEXTVAL('post_scores_generation_seq') AS seq),
+generation AS (
+    INSERT INTO post_score_generations (alg, generation_seq)
+    SELECT 'classic', seq.seq FROM seq
+),
+superseded AS (
+    UPDATE post_scores AS ps
+    SET
+        valid_until_seq = (SELECT seq FROM seq),
+        superseded_at = NOW()
+    WHERE ps.alg = 'classic' AND ps.valid_until_seq IS NULL
+)
+
+INSERT INTO post_scores (uri, alg, score, generation_seq, valid_until_seq)
+SELECT
+    cp.uri AS uri,
+    'classic' AS alg,
+    (
+        SELECT COUNT(*)
+        FROM candidate_likes AS cl
+        WHERE cl.subject_uri = cp.uri AND cl.deleted_at IS NULL
+    )
+    / (EXTRACT(EPOCH FROM NOW() - cp.indexed_at) / (60 * 60) + 2)
+    ^ 1.85 AS score,
+    (SELECT seq FROM seq) AS generation_seq,
+    (SELECT seq FROM seq) + 1 AS valid_until_seq
+FROM candidate_posts AS cp
+WHERE
+    cp.deleted_at IS NULL
+    AND cp.indexed_at >= $1::TIMESTAMPTZ
+RETURNING (SELECT seq FROM seq)`
+
+// MaterializeFullClassicScores creates a generation of the "classic"
+// algorithm by rescoring every post indexed since after, and returns its
+// sequence number. At least one post must have been indexed since after.
+func MaterializeFullClassicScores(ctx context.Context, db *pgxpool.Pool, after time.Time) (int64, error) {
+	var seq int64
+	if err := db.QueryRow(ctx, fullClassicScoresQuery, after).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("materializing full classic scores: %w", err)
+	}
+	return seq, nil
+}
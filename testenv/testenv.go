@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/strideynet/bsky-furry-feed/store"
 
+	"github.com/bluesky-social/indigo/plc"
 	indigoTest "github.com/bluesky-social/indigo/testing"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -49,7 +50,7 @@ func ExtractClientFromTestUser(user *indigoTest.TestUser) *xrpc.Client {
 	return iface.(*xrpc.Client)
 }
 
-func StartDatabase(ctx context.Context, t *testing.T) (url string) {
+func StartDatabase(ctx context.Context, t testing.TB) (url string) {
 	t.Helper()
 
 	waitStrategy := wait.ForSQL("5432/tcp", "postgres", func(host string, port nat.Port) string {
@@ -84,7 +85,11 @@ func StartDatabase(ctx context.Context, t *testing.T) (url string) {
 type Harness struct {
 	PDS   *indigoTest.TestPDS
 	Relay *indigoTest.TestRelay
+	PLC   *plc.FakeDid
 	Store *store.PGXStore
+	// DatabaseURL is the URL of the database behind Store, for tests which
+	// need to query it directly.
+	DatabaseURL string
 }
 
 func StartHarness(ctx context.Context, t *testing.T) *Harness {
@@ -111,6 +116,9 @@ func StartHarness(ctx context.Context, t *testing.T) *Harness {
 	return &Harness{
 		Relay: relay,
 		PDS:   pds,
+		PLC:   didr,
 		Store: pgxStore,
+
+		DatabaseURL: dbURL,
 	}
 }
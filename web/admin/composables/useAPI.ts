@@ -4,11 +4,16 @@ import { ModerationService } from "../../proto/bff/v1/moderation_service_connect
 import { UserService } from "../../proto/bff/v1/user_service_connectweb";
 import { createRegistry } from "@bufbuild/protobuf";
 import {
+  AccountStatusChangedAuditPayload,
+  ActorDataDeletedAuditPayload,
+  ActorDataPurgedAuditPayload,
+  ActorDataRestoredAuditPayload,
   AssignRolesAuditPayload,
   BanActorAuditPayload,
   CommentAuditPayload,
   CreateActorAuditPayload,
   ForceApproveActorAuditPayload,
+  HandleChangedAuditPayload,
   HoldBackPendingActorAuditPayload,
   ProcessApprovalQueueAuditPayload,
   UnapproveActorAuditPayload,
@@ -38,7 +43,12 @@ export async function useAPITransport(): Promise<Transport> {
         UnapproveActorAuditPayload,
         ForceApproveActorAuditPayload,
         HoldBackPendingActorAuditPayload,
-        AssignRolesAuditPayload
+        AssignRolesAuditPayload,
+        AccountStatusChangedAuditPayload,
+        HandleChangedAuditPayload,
+        ActorDataDeletedAuditPayload,
+        ActorDataRestoredAuditPayload,
+        ActorDataPurgedAuditPayload
       ),
     },
   });
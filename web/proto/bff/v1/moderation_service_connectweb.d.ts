@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AssignRolesRequest, AssignRolesResponse, BanActorRequest, BanActorResponse, CreateActorRequest, CreateActorResponse, CreateCommentAuditEventRequest, CreateCommentAuditEventResponse, ForceApproveActorRequest, ForceApproveActorResponse, GetActorRequest, GetActorResponse, HoldBackPendingActorRequest, HoldBackPendingActorResponse, ListActorsRequest, ListActorsResponse, ListAuditEventsRequest, ListAuditEventsResponse, ListRolesRequest, ListRolesResponse, PingRequest, PingResponse, ProcessApprovalQueueRequest, ProcessApprovalQueueResponse, UnapproveActorRequest, UnapproveActorResponse } from "./moderation_service_pb.js";
+import { AssignRolesRequest, AssignRolesResponse, BanActorRequest, BanActorResponse, CancelTaskRequest, CancelTaskResponse, CreateActorRequest, CreateActorResponse, CreateCommentAuditEventRequest, CreateCommentAuditEventResponse, DiscardDeadLetterEventRequest, DiscardDeadLetterEventResponse, ExplainPostRequest, ExplainPostResponse, ForceApproveActorRequest, ForceApproveActorResponse, GetActorRequest, GetActorResponse, GetDeadLetterEventRequest, GetDeadLetterEventResponse, GetFollowReconciliationReportRequest, GetFollowReconciliationReportResponse, GetTaskRequest, GetTaskResponse, HoldBackPendingActorRequest, HoldBackPendingActorResponse, ListActorsRequest, ListActorsResponse, ListAuditEventsRequest, ListAuditEventsResponse, ListDeadLetterEventsRequest, ListDeadLetterEventsResponse, ListRolesRequest, ListRolesResponse, ListScoringFlagsRequest, ListScoringFlagsResponse, ListTasksRequest, ListTasksResponse, PingRequest, PingResponse, ProcessApprovalQueueRequest, ProcessApprovalQueueResponse, PurgeActorRequest, PurgeActorResponse, RefreshActorProfileRequest, RefreshActorProfileResponse, ReplayDeadLetterEventRequest, ReplayDeadLetterEventResponse, RestoreActorRequest, RestoreActorResponse, RetryTaskRequest, RetryTaskResponse, SetActorNSFWOverrideRequest, SetActorNSFWOverrideResponse, UnapproveActorRequest, UnapproveActorResponse } from "./moderation_service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -116,6 +116,30 @@ export declare const ModerationService: {
       readonly O: typeof CreateActorResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * PurgeActor deletes an actor's posts, likes, follows, profiles and handle
+     * history. Their data is hidden immediately, and permanently deleted after a
+     * grace period. Until then, it can be restored with RestoreActor.
+     *
+     * @generated from rpc bff.v1.ModerationService.PurgeActor
+     */
+    readonly purgeActor: {
+      readonly name: "PurgeActor",
+      readonly I: typeof PurgeActorRequest,
+      readonly O: typeof PurgeActorResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RestoreActor restores the data of an actor who is pending a purge.
+     *
+     * @generated from rpc bff.v1.ModerationService.RestoreActor
+     */
+    readonly restoreActor: {
+      readonly name: "RestoreActor",
+      readonly I: typeof RestoreActorRequest,
+      readonly O: typeof RestoreActorResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc bff.v1.ModerationService.ListAuditEvents
      */
@@ -152,6 +176,160 @@ export declare const ModerationService: {
       readonly O: typeof AssignRolesResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * SetActorNSFWOverride overrides whether an actor's posts are NSFW,
+     * regardless of the NSFW rules. Their existing posts are reclassified.
+     *
+     * @generated from rpc bff.v1.ModerationService.SetActorNSFWOverride
+     */
+    readonly setActorNSFWOverride: {
+      readonly name: "SetActorNSFWOverride",
+      readonly I: typeof SetActorNSFWOverrideRequest,
+      readonly O: typeof SetActorNSFWOverrideResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetFollowReconciliationReport compares the accounts followed by the feed
+     * account against the approved actors, and reports the corrections that
+     * the background worker would make. No changes are made.
+     *
+     * @generated from rpc bff.v1.ModerationService.GetFollowReconciliationReport
+     */
+    readonly getFollowReconciliationReport: {
+      readonly name: "GetFollowReconciliationReport",
+      readonly I: typeof GetFollowReconciliationReportRequest,
+      readonly O: typeof GetFollowReconciliationReportResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListTasks lists background worker tasks, most recently created first. It
+     * can be filtered by state, type and the actor the task acts upon.
+     *
+     * @generated from rpc bff.v1.ModerationService.ListTasks
+     */
+    readonly listTasks: {
+      readonly name: "ListTasks",
+      readonly I: typeof ListTasksRequest,
+      readonly O: typeof ListTasksResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetTask fetches a single background worker task.
+     *
+     * @generated from rpc bff.v1.ModerationService.GetTask
+     */
+    readonly getTask: {
+      readonly name: "GetTask",
+      readonly I: typeof GetTaskRequest,
+      readonly O: typeof GetTaskResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RetryTask resets a failed, cancelled or pending task so that it runs again
+     * immediately with a fresh set of tries. Running and done tasks cannot be
+     * retried.
+     *
+     * @generated from rpc bff.v1.ModerationService.RetryTask
+     */
+    readonly retryTask: {
+      readonly name: "RetryTask",
+      readonly I: typeof RetryTaskRequest,
+      readonly O: typeof RetryTaskResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * CancelTask cancels a pending task. Running tasks cannot be cancelled.
+     *
+     * @generated from rpc bff.v1.ModerationService.CancelTask
+     */
+    readonly cancelTask: {
+      readonly name: "CancelTask",
+      readonly I: typeof CancelTaskRequest,
+      readonly O: typeof CancelTaskResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RefreshActorProfile enqueues a task to refresh the stored profile of an
+     * actor from the network.
+     *
+     * @generated from rpc bff.v1.ModerationService.RefreshActorProfile
+     */
+    readonly refreshActorProfile: {
+      readonly name: "RefreshActorProfile",
+      readonly I: typeof RefreshActorProfileRequest,
+      readonly O: typeof RefreshActorProfileResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListDeadLetterEvents lists Jetstream events that the ingester failed to
+     * handle, most recently failed first.
+     *
+     * @generated from rpc bff.v1.ModerationService.ListDeadLetterEvents
+     */
+    readonly listDeadLetterEvents: {
+      readonly name: "ListDeadLetterEvents",
+      readonly I: typeof ListDeadLetterEventsRequest,
+      readonly O: typeof ListDeadLetterEventsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetDeadLetterEvent fetches a single dead-lettered event.
+     *
+     * @generated from rpc bff.v1.ModerationService.GetDeadLetterEvent
+     */
+    readonly getDeadLetterEvent: {
+      readonly name: "GetDeadLetterEvent",
+      readonly I: typeof GetDeadLetterEventRequest,
+      readonly O: typeof GetDeadLetterEventResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ReplayDeadLetterEvent makes the ingester retry an event immediately,
+     * including one which has exhausted its attempts.
+     *
+     * @generated from rpc bff.v1.ModerationService.ReplayDeadLetterEvent
+     */
+    readonly replayDeadLetterEvent: {
+      readonly name: "ReplayDeadLetterEvent",
+      readonly I: typeof ReplayDeadLetterEventRequest,
+      readonly O: typeof ReplayDeadLetterEventResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * DiscardDeadLetterEvent deletes an event without handling it.
+     *
+     * @generated from rpc bff.v1.ModerationService.DiscardDeadLetterEvent
+     */
+    readonly discardDeadLetterEvent: {
+      readonly name: "DiscardDeadLetterEvent",
+      readonly I: typeof DiscardDeadLetterEventRequest,
+      readonly O: typeof DiscardDeadLetterEventResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListScoringFlags lists the actors and posts whose likes are being
+     * dampened by the anti-gaming safeguards in post scoring.
+     *
+     * @generated from rpc bff.v1.ModerationService.ListScoringFlags
+     */
+    readonly listScoringFlags: {
+      readonly name: "ListScoringFlags",
+      readonly I: typeof ListScoringFlagsRequest,
+      readonly O: typeof ListScoringFlagsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ExplainPost reports whether a post passes each of a feed's filters, and
+     * for scored feeds, how it was scored and where it ranks.
+     *
+     * @generated from rpc bff.v1.ModerationService.ExplainPost
+     */
+    readonly explainPost: {
+      readonly name: "ExplainPost",
+      readonly I: typeof ExplainPostRequest,
+      readonly O: typeof ExplainPostResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AssignRolesRequest, AssignRolesResponse, BanActorRequest, BanActorResponse, CreateActorRequest, CreateActorResponse, CreateCommentAuditEventRequest, CreateCommentAuditEventResponse, ForceApproveActorRequest, ForceApproveActorResponse, GetActorRequest, GetActorResponse, HoldBackPendingActorRequest, HoldBackPendingActorResponse, ListActorsRequest, ListActorsResponse, ListAuditEventsRequest, ListAuditEventsResponse, ListRolesRequest, ListRolesResponse, PingRequest, PingResponse, ProcessApprovalQueueRequest, ProcessApprovalQueueResponse, UnapproveActorRequest, UnapproveActorResponse } from "./moderation_service_pb.js";
+import { AssignRolesRequest, AssignRolesResponse, BanActorRequest, BanActorResponse, CancelTaskRequest, CancelTaskResponse, CreateActorRequest, CreateActorResponse, CreateCommentAuditEventRequest, CreateCommentAuditEventResponse, DiscardDeadLetterEventRequest, DiscardDeadLetterEventResponse, ExplainPostRequest, ExplainPostResponse, ForceApproveActorRequest, ForceApproveActorResponse, GetActorRequest, GetActorResponse, GetDeadLetterEventRequest, GetDeadLetterEventResponse, GetFollowReconciliationReportRequest, GetFollowReconciliationReportResponse, GetTaskRequest, GetTaskResponse, HoldBackPendingActorRequest, HoldBackPendingActorResponse, ListActorsRequest, ListActorsResponse, ListAuditEventsRequest, ListAuditEventsResponse, ListDeadLetterEventsRequest, ListDeadLetterEventsResponse, ListRolesRequest, ListRolesResponse, ListScoringFlagsRequest, ListScoringFlagsResponse, ListTasksRequest, ListTasksResponse, PingRequest, PingResponse, ProcessApprovalQueueRequest, ProcessApprovalQueueResponse, PurgeActorRequest, PurgeActorResponse, RefreshActorProfileRequest, RefreshActorProfileResponse, ReplayDeadLetterEventRequest, ReplayDeadLetterEventResponse, RestoreActorRequest, RestoreActorResponse, RetryTaskRequest, RetryTaskResponse, SetActorNSFWOverrideRequest, SetActorNSFWOverrideResponse, UnapproveActorRequest, UnapproveActorResponse } from "./moderation_service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -116,6 +116,30 @@ export const ModerationService = {
       O: CreateActorResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * PurgeActor deletes an actor's posts, likes, follows, profiles and handle
+     * history. Their data is hidden immediately, and permanently deleted after a
+     * grace period. Until then, it can be restored with RestoreActor.
+     *
+     * @generated from rpc bff.v1.ModerationService.PurgeActor
+     */
+    purgeActor: {
+      name: "PurgeActor",
+      I: PurgeActorRequest,
+      O: PurgeActorResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RestoreActor restores the data of an actor who is pending a purge.
+     *
+     * @generated from rpc bff.v1.ModerationService.RestoreActor
+     */
+    restoreActor: {
+      name: "RestoreActor",
+      I: RestoreActorRequest,
+      O: RestoreActorResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc bff.v1.ModerationService.ListAuditEvents
      */
@@ -152,6 +176,160 @@ export const ModerationService = {
       O: AssignRolesResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * SetActorNSFWOverride overrides whether an actor's posts are NSFW,
+     * regardless of the NSFW rules. Their existing posts are reclassified.
+     *
+     * @generated from rpc bff.v1.ModerationService.SetActorNSFWOverride
+     */
+    setActorNSFWOverride: {
+      name: "SetActorNSFWOverride",
+      I: SetActorNSFWOverrideRequest,
+      O: SetActorNSFWOverrideResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetFollowReconciliationReport compares the accounts followed by the feed
+     * account against the approved actors, and reports the corrections that
+     * the background worker would make. No changes are made.
+     *
+     * @generated from rpc bff.v1.ModerationService.GetFollowReconciliationReport
+     */
+    getFollowReconciliationReport: {
+      name: "GetFollowReconciliationReport",
+      I: GetFollowReconciliationReportRequest,
+      O: GetFollowReconciliationReportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListTasks lists background worker tasks, most recently created first. It
+     * can be filtered by state, type and the actor the task acts upon.
+     *
+     * @generated from rpc bff.v1.ModerationService.ListTasks
+     */
+    listTasks: {
+      name: "ListTasks",
+      I: ListTasksRequest,
+      O: ListTasksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetTask fetches a single background worker task.
+     *
+     * @generated from rpc bff.v1.ModerationService.GetTask
+     */
+    getTask: {
+      name: "GetTask",
+      I: GetTaskRequest,
+      O: GetTaskResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RetryTask resets a failed, cancelled or pending task so that it runs again
+     * immediately with a fresh set of tries. Running and done tasks cannot be
+     * retried.
+     *
+     * @generated from rpc bff.v1.ModerationService.RetryTask
+     */
+    retryTask: {
+      name: "RetryTask",
+      I: RetryTaskRequest,
+      O: RetryTaskResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CancelTask cancels a pending task. Running tasks cannot be cancelled.
+     *
+     * @generated from rpc bff.v1.ModerationService.CancelTask
+     */
+    cancelTask: {
+      name: "CancelTask",
+      I: CancelTaskRequest,
+      O: CancelTaskResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RefreshActorProfile enqueues a task to refresh the stored profile of an
+     * actor from the network.
+     *
+     * @generated from rpc bff.v1.ModerationService.RefreshActorProfile
+     */
+    refreshActorProfile: {
+      name: "RefreshActorProfile",
+      I: RefreshActorProfileRequest,
+      O: RefreshActorProfileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListDeadLetterEvents lists Jetstream events that the ingester failed to
+     * handle, most recently failed first.
+     *
+     * @generated from rpc bff.v1.ModerationService.ListDeadLetterEvents
+     */
+    listDeadLetterEvents: {
+      name: "ListDeadLetterEvents",
+      I: ListDeadLetterEventsRequest,
+      O: ListDeadLetterEventsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetDeadLetterEvent fetches a single dead-lettered event.
+     *
+     * @generated from rpc bff.v1.ModerationService.GetDeadLetterEvent
+     */
+    getDeadLetterEvent: {
+      name: "GetDeadLetterEvent",
+      I: GetDeadLetterEventRequest,
+      O: GetDeadLetterEventResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ReplayDeadLetterEvent makes the ingester retry an event immediately,
+     * including one which has exhausted its attempts.
+     *
+     * @generated from rpc bff.v1.ModerationService.ReplayDeadLetterEvent
+     */
+    replayDeadLetterEvent: {
+      name: "ReplayDeadLetterEvent",
+      I: ReplayDeadLetterEventRequest,
+      O: ReplayDeadLetterEventResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DiscardDeadLetterEvent deletes an event without handling it.
+     *
+     * @generated from rpc bff.v1.ModerationService.DiscardDeadLetterEvent
+     */
+    discardDeadLetterEvent: {
+      name: "DiscardDeadLetterEvent",
+      I: DiscardDeadLetterEventRequest,
+      O: DiscardDeadLetterEventResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListScoringFlags lists the actors and posts whose likes are being
+     * dampened by the anti-gaming safeguards in post scoring.
+     *
+     * @generated from rpc bff.v1.ModerationService.ListScoringFlags
+     */
+    listScoringFlags: {
+      name: "ListScoringFlags",
+      I: ListScoringFlagsRequest,
+      O: ListScoringFlagsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ExplainPost reports whether a post passes each of a feed's filters, and
+     * for scored feeds, how it was scored and where it ranks.
+     *
+     * @generated from rpc bff.v1.ModerationService.ExplainPost
+     */
+    explainPost: {
+      name: "ExplainPost",
+      I: ExplainPostRequest,
+      O: ExplainPostResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
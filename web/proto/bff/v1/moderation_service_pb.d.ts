@@ -5,7 +5,7 @@
 
 import type { Any, BinaryReadOptions, Duration, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import type { Actor, ActorStatus } from "./types_pb.js";
+import type { Actor, ActorNSFWOverride, ActorStatus } from "./types_pb.js";
 
 /**
  * @generated from enum bff.v1.ApprovalQueueAction
@@ -75,6 +75,73 @@ export declare enum AuditEventType {
    * @generated from enum value: ASSIGNED_ROLES = 8;
    */
   ASSIGNED_ROLES = 8,
+
+  /**
+   * @generated from enum value: ACCOUNT_STATUS_CHANGED = 9;
+   */
+  ACCOUNT_STATUS_CHANGED = 9,
+
+  /**
+   * @generated from enum value: HANDLE_CHANGED = 10;
+   */
+  HANDLE_CHANGED = 10,
+
+  /**
+   * @generated from enum value: ACTOR_DATA_DELETED = 11;
+   */
+  ACTOR_DATA_DELETED = 11,
+
+  /**
+   * @generated from enum value: ACTOR_DATA_RESTORED = 12;
+   */
+  ACTOR_DATA_RESTORED = 12,
+
+  /**
+   * @generated from enum value: ACTOR_DATA_PURGED = 13;
+   */
+  ACTOR_DATA_PURGED = 13,
+}
+
+/**
+ * @generated from enum bff.v1.TaskState
+ */
+export declare enum TaskState {
+  /**
+   * @generated from enum value: TASK_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * TASK_STATE_PENDING tasks are waiting to run, or to be retried.
+   *
+   * @generated from enum value: TASK_STATE_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * TASK_STATE_RUNNING tasks have been claimed by a worker.
+   *
+   * @generated from enum value: TASK_STATE_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * TASK_STATE_FAILED tasks have exhausted their tries and will not be
+   * retried unless done so manually.
+   *
+   * @generated from enum value: TASK_STATE_FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * @generated from enum value: TASK_STATE_DONE = 4;
+   */
+  DONE = 4,
+
+  /**
+   * @generated from enum value: TASK_STATE_CANCELLED = 5;
+   */
+  CANCELLED = 5,
 }
 
 /**
@@ -144,6 +211,13 @@ export declare class GetActorResponse extends Message<GetActorResponse> {
    */
   actor?: Actor;
 
+  /**
+   * handle_history lists the handles the actor has used, most recent first.
+   *
+   * @generated from field: repeated bff.v1.ActorHandle handle_history = 2;
+   */
+  handleHistory: ActorHandle[];
+
   constructor(data?: PartialMessage<GetActorResponse>);
 
   static readonly runtime: typeof proto3;
@@ -159,6 +233,37 @@ export declare class GetActorResponse extends Message<GetActorResponse> {
   static equals(a: GetActorResponse | PlainMessage<GetActorResponse> | undefined, b: GetActorResponse | PlainMessage<GetActorResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message bff.v1.ActorHandle
+ */
+export declare class ActorHandle extends Message<ActorHandle> {
+  /**
+   * @generated from field: string handle = 1;
+   */
+  handle: string;
+
+  /**
+   * seen_at is when the actor changed to this handle.
+   *
+   * @generated from field: google.protobuf.Timestamp seen_at = 2;
+   */
+  seenAt?: Timestamp;
+
+  constructor(data?: PartialMessage<ActorHandle>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ActorHandle";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ActorHandle;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ActorHandle;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ActorHandle;
+
+  static equals(a: ActorHandle | PlainMessage<ActorHandle> | undefined, b: ActorHandle | PlainMessage<ActorHandle> | undefined): boolean;
+}
+
 /**
  * @generated from message bff.v1.ListActorsRequest
  */
@@ -899,6 +1004,208 @@ export declare class BanActorAuditPayload extends Message<BanActorAuditPayload>
   static equals(a: BanActorAuditPayload | PlainMessage<BanActorAuditPayload> | undefined, b: BanActorAuditPayload | PlainMessage<BanActorAuditPayload> | undefined): boolean;
 }
 
+/**
+ * @generated from message bff.v1.PurgeActorRequest
+ */
+export declare class PurgeActorRequest extends Message<PurgeActorRequest> {
+  /**
+   * @generated from field: string actor_did = 1;
+   */
+  actorDid: string;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  constructor(data?: PartialMessage<PurgeActorRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.PurgeActorRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeActorRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeActorRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeActorRequest;
+
+  static equals(a: PurgeActorRequest | PlainMessage<PurgeActorRequest> | undefined, b: PurgeActorRequest | PlainMessage<PurgeActorRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.PurgeActorResponse
+ */
+export declare class PurgeActorResponse extends Message<PurgeActorResponse> {
+  /**
+   * @generated from field: bff.v1.Actor actor = 1;
+   */
+  actor?: Actor;
+
+  constructor(data?: PartialMessage<PurgeActorResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.PurgeActorResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeActorResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeActorResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeActorResponse;
+
+  static equals(a: PurgeActorResponse | PlainMessage<PurgeActorResponse> | undefined, b: PurgeActorResponse | PlainMessage<PurgeActorResponse> | undefined): boolean;
+}
+
+/**
+ * ActorDataDeletedAuditPayload is emitted when an actor's data is deleted,
+ * either by PurgeActor or by the ingester when their account is deleted.
+ *
+ * @generated from message bff.v1.ActorDataDeletedAuditPayload
+ */
+export declare class ActorDataDeletedAuditPayload extends Message<ActorDataDeletedAuditPayload> {
+  /**
+   * @generated from field: string reason = 1;
+   */
+  reason: string;
+
+  /**
+   * purge_at is when the data will be permanently deleted.
+   *
+   * @generated from field: google.protobuf.Timestamp purge_at = 2;
+   */
+  purgeAt?: Timestamp;
+
+  constructor(data?: PartialMessage<ActorDataDeletedAuditPayload>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ActorDataDeletedAuditPayload";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ActorDataDeletedAuditPayload;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ActorDataDeletedAuditPayload;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ActorDataDeletedAuditPayload;
+
+  static equals(a: ActorDataDeletedAuditPayload | PlainMessage<ActorDataDeletedAuditPayload> | undefined, b: ActorDataDeletedAuditPayload | PlainMessage<ActorDataDeletedAuditPayload> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.RestoreActorRequest
+ */
+export declare class RestoreActorRequest extends Message<RestoreActorRequest> {
+  /**
+   * @generated from field: string actor_did = 1;
+   */
+  actorDid: string;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  constructor(data?: PartialMessage<RestoreActorRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.RestoreActorRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreActorRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreActorRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreActorRequest;
+
+  static equals(a: RestoreActorRequest | PlainMessage<RestoreActorRequest> | undefined, b: RestoreActorRequest | PlainMessage<RestoreActorRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.RestoreActorResponse
+ */
+export declare class RestoreActorResponse extends Message<RestoreActorResponse> {
+  /**
+   * @generated from field: bff.v1.Actor actor = 1;
+   */
+  actor?: Actor;
+
+  constructor(data?: PartialMessage<RestoreActorResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.RestoreActorResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreActorResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreActorResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreActorResponse;
+
+  static equals(a: RestoreActorResponse | PlainMessage<RestoreActorResponse> | undefined, b: RestoreActorResponse | PlainMessage<RestoreActorResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ActorDataRestoredAuditPayload
+ */
+export declare class ActorDataRestoredAuditPayload extends Message<ActorDataRestoredAuditPayload> {
+  /**
+   * @generated from field: string reason = 1;
+   */
+  reason: string;
+
+  constructor(data?: PartialMessage<ActorDataRestoredAuditPayload>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ActorDataRestoredAuditPayload";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ActorDataRestoredAuditPayload;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ActorDataRestoredAuditPayload;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ActorDataRestoredAuditPayload;
+
+  static equals(a: ActorDataRestoredAuditPayload | PlainMessage<ActorDataRestoredAuditPayload> | undefined, b: ActorDataRestoredAuditPayload | PlainMessage<ActorDataRestoredAuditPayload> | undefined): boolean;
+}
+
+/**
+ * ActorDataPurgedAuditPayload is emitted by the worker when an actor's data
+ * is permanently deleted. The actor and subject of the audit event are both
+ * the purged actor.
+ *
+ * @generated from message bff.v1.ActorDataPurgedAuditPayload
+ */
+export declare class ActorDataPurgedAuditPayload extends Message<ActorDataPurgedAuditPayload> {
+  /**
+   * @generated from field: int64 posts = 1;
+   */
+  posts: bigint;
+
+  /**
+   * @generated from field: int64 likes = 2;
+   */
+  likes: bigint;
+
+  /**
+   * @generated from field: int64 follows = 3;
+   */
+  follows: bigint;
+
+  constructor(data?: PartialMessage<ActorDataPurgedAuditPayload>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ActorDataPurgedAuditPayload";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ActorDataPurgedAuditPayload;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ActorDataPurgedAuditPayload;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ActorDataPurgedAuditPayload;
+
+  static equals(a: ActorDataPurgedAuditPayload | PlainMessage<ActorDataPurgedAuditPayload> | undefined, b: ActorDataPurgedAuditPayload | PlainMessage<ActorDataPurgedAuditPayload> | undefined): boolean;
+}
+
 /**
  * @generated from message bff.v1.AuditEvent
  */
@@ -1102,3 +1409,1181 @@ export declare class AssignRolesAuditPayload extends Message<AssignRolesAuditPay
   static equals(a: AssignRolesAuditPayload | PlainMessage<AssignRolesAuditPayload> | undefined, b: AssignRolesAuditPayload | PlainMessage<AssignRolesAuditPayload> | undefined): boolean;
 }
 
+/**
+ * @generated from message bff.v1.SetActorNSFWOverrideRequest
+ */
+export declare class SetActorNSFWOverrideRequest extends Message<SetActorNSFWOverrideRequest> {
+  /**
+   * @generated from field: string actor_did = 1;
+   */
+  actorDid: string;
+
+  /**
+   * nsfw_override is the new override. UNSPECIFIED removes any override.
+   *
+   * @generated from field: bff.v1.ActorNSFWOverride nsfw_override = 2;
+   */
+  nsfwOverride: ActorNSFWOverride;
+
+  constructor(data?: PartialMessage<SetActorNSFWOverrideRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.SetActorNSFWOverrideRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetActorNSFWOverrideRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetActorNSFWOverrideRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetActorNSFWOverrideRequest;
+
+  static equals(a: SetActorNSFWOverrideRequest | PlainMessage<SetActorNSFWOverrideRequest> | undefined, b: SetActorNSFWOverrideRequest | PlainMessage<SetActorNSFWOverrideRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.SetActorNSFWOverrideResponse
+ */
+export declare class SetActorNSFWOverrideResponse extends Message<SetActorNSFWOverrideResponse> {
+  /**
+   * @generated from field: bff.v1.Actor actor = 1;
+   */
+  actor?: Actor;
+
+  constructor(data?: PartialMessage<SetActorNSFWOverrideResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.SetActorNSFWOverrideResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetActorNSFWOverrideResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetActorNSFWOverrideResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetActorNSFWOverrideResponse;
+
+  static equals(a: SetActorNSFWOverrideResponse | PlainMessage<SetActorNSFWOverrideResponse> | undefined, b: SetActorNSFWOverrideResponse | PlainMessage<SetActorNSFWOverrideResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.SetActorNSFWOverrideAuditPayload
+ */
+export declare class SetActorNSFWOverrideAuditPayload extends Message<SetActorNSFWOverrideAuditPayload> {
+  /**
+   * @generated from field: bff.v1.ActorNSFWOverride nsfw_override_before = 1;
+   */
+  nsfwOverrideBefore: ActorNSFWOverride;
+
+  /**
+   * @generated from field: bff.v1.ActorNSFWOverride nsfw_override_after = 2;
+   */
+  nsfwOverrideAfter: ActorNSFWOverride;
+
+  constructor(data?: PartialMessage<SetActorNSFWOverrideAuditPayload>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.SetActorNSFWOverrideAuditPayload";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetActorNSFWOverrideAuditPayload;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetActorNSFWOverrideAuditPayload;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetActorNSFWOverrideAuditPayload;
+
+  static equals(a: SetActorNSFWOverrideAuditPayload | PlainMessage<SetActorNSFWOverrideAuditPayload> | undefined, b: SetActorNSFWOverrideAuditPayload | PlainMessage<SetActorNSFWOverrideAuditPayload> | undefined): boolean;
+}
+
+/**
+ * AccountStatusChangedAuditPayload is emitted by the ingester when an actor's
+ * account is deactivated, taken down or restored on the network. The actor
+ * and subject of the audit event are both the affected actor.
+ *
+ * @generated from message bff.v1.AccountStatusChangedAuditPayload
+ */
+export declare class AccountStatusChangedAuditPayload extends Message<AccountStatusChangedAuditPayload> {
+  /**
+   * @generated from field: bool active = 1;
+   */
+  active: boolean;
+
+  /**
+   * status is the reason the account is inactive, e.g. "deactivated".
+   *
+   * @generated from field: string status = 2;
+   */
+  status: string;
+
+  constructor(data?: PartialMessage<AccountStatusChangedAuditPayload>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.AccountStatusChangedAuditPayload";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountStatusChangedAuditPayload;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountStatusChangedAuditPayload;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountStatusChangedAuditPayload;
+
+  static equals(a: AccountStatusChangedAuditPayload | PlainMessage<AccountStatusChangedAuditPayload> | undefined, b: AccountStatusChangedAuditPayload | PlainMessage<AccountStatusChangedAuditPayload> | undefined): boolean;
+}
+
+/**
+ * HandleChangedAuditPayload is emitted by the ingester when an actor changes
+ * their handle. The actor and subject of the audit event are both the
+ * affected actor.
+ *
+ * @generated from message bff.v1.HandleChangedAuditPayload
+ */
+export declare class HandleChangedAuditPayload extends Message<HandleChangedAuditPayload> {
+  /**
+   * @generated from field: string handle_before = 1;
+   */
+  handleBefore: string;
+
+  /**
+   * @generated from field: string handle_after = 2;
+   */
+  handleAfter: string;
+
+  constructor(data?: PartialMessage<HandleChangedAuditPayload>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.HandleChangedAuditPayload";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HandleChangedAuditPayload;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HandleChangedAuditPayload;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HandleChangedAuditPayload;
+
+  static equals(a: HandleChangedAuditPayload | PlainMessage<HandleChangedAuditPayload> | undefined, b: HandleChangedAuditPayload | PlainMessage<HandleChangedAuditPayload> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.GetFollowReconciliationReportRequest
+ */
+export declare class GetFollowReconciliationReportRequest extends Message<GetFollowReconciliationReportRequest> {
+  constructor(data?: PartialMessage<GetFollowReconciliationReportRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.GetFollowReconciliationReportRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFollowReconciliationReportRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFollowReconciliationReportRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFollowReconciliationReportRequest;
+
+  static equals(a: GetFollowReconciliationReportRequest | PlainMessage<GetFollowReconciliationReportRequest> | undefined, b: GetFollowReconciliationReportRequest | PlainMessage<GetFollowReconciliationReportRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.GetFollowReconciliationReportResponse
+ */
+export declare class GetFollowReconciliationReportResponse extends Message<GetFollowReconciliationReportResponse> {
+  /**
+   * dids_to_follow are approved actors who are not currently followed.
+   *
+   * @generated from field: repeated string dids_to_follow = 1;
+   */
+  didsToFollow: string[];
+
+  /**
+   * dids_to_unfollow are followed actors who are no longer approved.
+   *
+   * @generated from field: repeated string dids_to_unfollow = 2;
+   */
+  didsToUnfollow: string[];
+
+  /**
+   * untracked_dids are followed accounts which are not known actors. These
+   * are left alone by reconciliation.
+   *
+   * @generated from field: repeated string untracked_dids = 3;
+   */
+  untrackedDids: string[];
+
+  constructor(data?: PartialMessage<GetFollowReconciliationReportResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.GetFollowReconciliationReportResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFollowReconciliationReportResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFollowReconciliationReportResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFollowReconciliationReportResponse;
+
+  static equals(a: GetFollowReconciliationReportResponse | PlainMessage<GetFollowReconciliationReportResponse> | undefined, b: GetFollowReconciliationReportResponse | PlainMessage<GetFollowReconciliationReportResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.Task
+ */
+export declare class Task extends Message<Task> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * type determines which handler processes the task, e.g. "follow".
+   *
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * payload is the JSON encoded input to the task handler.
+   *
+   * @generated from field: string payload = 3;
+   */
+  payload: string;
+
+  /**
+   * @generated from field: bff.v1.TaskState state = 4;
+   */
+  state: TaskState;
+
+  /**
+   * @generated from field: int32 tries = 5;
+   */
+  tries: number;
+
+  /**
+   * @generated from field: int32 max_tries = 6;
+   */
+  maxTries: number;
+
+  /**
+   * next_try_at is the earliest time the task will next be run, if pending.
+   *
+   * @generated from field: google.protobuf.Timestamp next_try_at = 7;
+   */
+  nextTryAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp finished_at = 9;
+   */
+  finishedAt?: Timestamp;
+
+  /**
+   * last_error is the error returned by the most recent failed try.
+   *
+   * @generated from field: string last_error = 10;
+   */
+  lastError: string;
+
+  /**
+   * actor_did is the actor the task acts upon, if any.
+   *
+   * @generated from field: string actor_did = 11;
+   */
+  actorDid: string;
+
+  constructor(data?: PartialMessage<Task>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.Task";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Task;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Task;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Task;
+
+  static equals(a: Task | PlainMessage<Task> | undefined, b: Task | PlainMessage<Task> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ListTasksRequest
+ */
+export declare class ListTasksRequest extends Message<ListTasksRequest> {
+  /**
+   * @generated from field: bff.v1.TaskState filter_state = 1;
+   */
+  filterState: TaskState;
+
+  /**
+   * @generated from field: string filter_actor_did = 2;
+   */
+  filterActorDid: string;
+
+  /**
+   * @generated from field: string filter_type = 3;
+   */
+  filterType: string;
+
+  /**
+   * limit specifies how many tasks to return. If unspecified, this defaults
+   * to 100.
+   *
+   * @generated from field: uint32 limit = 4;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: string cursor = 5;
+   */
+  cursor: string;
+
+  constructor(data?: PartialMessage<ListTasksRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ListTasksRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTasksRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTasksRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTasksRequest;
+
+  static equals(a: ListTasksRequest | PlainMessage<ListTasksRequest> | undefined, b: ListTasksRequest | PlainMessage<ListTasksRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ListTasksResponse
+ */
+export declare class ListTasksResponse extends Message<ListTasksResponse> {
+  /**
+   * @generated from field: repeated bff.v1.Task tasks = 1;
+   */
+  tasks: Task[];
+
+  /**
+   * @generated from field: string cursor = 2;
+   */
+  cursor: string;
+
+  constructor(data?: PartialMessage<ListTasksResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ListTasksResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTasksResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTasksResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTasksResponse;
+
+  static equals(a: ListTasksResponse | PlainMessage<ListTasksResponse> | undefined, b: ListTasksResponse | PlainMessage<ListTasksResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.GetTaskRequest
+ */
+export declare class GetTaskRequest extends Message<GetTaskRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  constructor(data?: PartialMessage<GetTaskRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.GetTaskRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTaskRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTaskRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTaskRequest;
+
+  static equals(a: GetTaskRequest | PlainMessage<GetTaskRequest> | undefined, b: GetTaskRequest | PlainMessage<GetTaskRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.GetTaskResponse
+ */
+export declare class GetTaskResponse extends Message<GetTaskResponse> {
+  /**
+   * @generated from field: bff.v1.Task task = 1;
+   */
+  task?: Task;
+
+  constructor(data?: PartialMessage<GetTaskResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.GetTaskResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTaskResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTaskResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTaskResponse;
+
+  static equals(a: GetTaskResponse | PlainMessage<GetTaskResponse> | undefined, b: GetTaskResponse | PlainMessage<GetTaskResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.RetryTaskRequest
+ */
+export declare class RetryTaskRequest extends Message<RetryTaskRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  constructor(data?: PartialMessage<RetryTaskRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.RetryTaskRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryTaskRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryTaskRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryTaskRequest;
+
+  static equals(a: RetryTaskRequest | PlainMessage<RetryTaskRequest> | undefined, b: RetryTaskRequest | PlainMessage<RetryTaskRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.RetryTaskResponse
+ */
+export declare class RetryTaskResponse extends Message<RetryTaskResponse> {
+  /**
+   * @generated from field: bff.v1.Task task = 1;
+   */
+  task?: Task;
+
+  constructor(data?: PartialMessage<RetryTaskResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.RetryTaskResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryTaskResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryTaskResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryTaskResponse;
+
+  static equals(a: RetryTaskResponse | PlainMessage<RetryTaskResponse> | undefined, b: RetryTaskResponse | PlainMessage<RetryTaskResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.CancelTaskRequest
+ */
+export declare class CancelTaskRequest extends Message<CancelTaskRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  constructor(data?: PartialMessage<CancelTaskRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.CancelTaskRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelTaskRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelTaskRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelTaskRequest;
+
+  static equals(a: CancelTaskRequest | PlainMessage<CancelTaskRequest> | undefined, b: CancelTaskRequest | PlainMessage<CancelTaskRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.CancelTaskResponse
+ */
+export declare class CancelTaskResponse extends Message<CancelTaskResponse> {
+  /**
+   * @generated from field: bff.v1.Task task = 1;
+   */
+  task?: Task;
+
+  constructor(data?: PartialMessage<CancelTaskResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.CancelTaskResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelTaskResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelTaskResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelTaskResponse;
+
+  static equals(a: CancelTaskResponse | PlainMessage<CancelTaskResponse> | undefined, b: CancelTaskResponse | PlainMessage<CancelTaskResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.RefreshActorProfileRequest
+ */
+export declare class RefreshActorProfileRequest extends Message<RefreshActorProfileRequest> {
+  /**
+   * @generated from field: string actor_did = 1;
+   */
+  actorDid: string;
+
+  constructor(data?: PartialMessage<RefreshActorProfileRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.RefreshActorProfileRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshActorProfileRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshActorProfileRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshActorProfileRequest;
+
+  static equals(a: RefreshActorProfileRequest | PlainMessage<RefreshActorProfileRequest> | undefined, b: RefreshActorProfileRequest | PlainMessage<RefreshActorProfileRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.RefreshActorProfileResponse
+ */
+export declare class RefreshActorProfileResponse extends Message<RefreshActorProfileResponse> {
+  /**
+   * @generated from field: bff.v1.Task task = 1;
+   */
+  task?: Task;
+
+  constructor(data?: PartialMessage<RefreshActorProfileResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.RefreshActorProfileResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshActorProfileResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshActorProfileResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshActorProfileResponse;
+
+  static equals(a: RefreshActorProfileResponse | PlainMessage<RefreshActorProfileResponse> | undefined, b: RefreshActorProfileResponse | PlainMessage<RefreshActorProfileResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.DeadLetterEvent
+ */
+export declare class DeadLetterEvent extends Message<DeadLetterEvent> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string actor_did = 2;
+   */
+  actorDid: string;
+
+  /**
+   * event is the JSON encoded Jetstream event.
+   *
+   * @generated from field: string event = 3;
+   */
+  event: string;
+
+  /**
+   * last_error is the error returned by the most recent failed attempt.
+   *
+   * @generated from field: string last_error = 4;
+   */
+  lastError: string;
+
+  /**
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_attempt_at = 7;
+   */
+  lastAttemptAt?: Timestamp;
+
+  /**
+   * next_attempt_at is when the event will next be retried. It is unset once
+   * the event has exhausted its attempts.
+   *
+   * @generated from field: google.protobuf.Timestamp next_attempt_at = 8;
+   */
+  nextAttemptAt?: Timestamp;
+
+  constructor(data?: PartialMessage<DeadLetterEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.DeadLetterEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeadLetterEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeadLetterEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeadLetterEvent;
+
+  static equals(a: DeadLetterEvent | PlainMessage<DeadLetterEvent> | undefined, b: DeadLetterEvent | PlainMessage<DeadLetterEvent> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ListDeadLetterEventsRequest
+ */
+export declare class ListDeadLetterEventsRequest extends Message<ListDeadLetterEventsRequest> {
+  /**
+   * @generated from field: string filter_actor_did = 1;
+   */
+  filterActorDid: string;
+
+  /**
+   * limit specifies how many events to return. If unspecified, this defaults
+   * to 100.
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: string cursor = 3;
+   */
+  cursor: string;
+
+  constructor(data?: PartialMessage<ListDeadLetterEventsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ListDeadLetterEventsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeadLetterEventsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeadLetterEventsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeadLetterEventsRequest;
+
+  static equals(a: ListDeadLetterEventsRequest | PlainMessage<ListDeadLetterEventsRequest> | undefined, b: ListDeadLetterEventsRequest | PlainMessage<ListDeadLetterEventsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ListDeadLetterEventsResponse
+ */
+export declare class ListDeadLetterEventsResponse extends Message<ListDeadLetterEventsResponse> {
+  /**
+   * @generated from field: repeated bff.v1.DeadLetterEvent events = 1;
+   */
+  events: DeadLetterEvent[];
+
+  /**
+   * @generated from field: string cursor = 2;
+   */
+  cursor: string;
+
+  constructor(data?: PartialMessage<ListDeadLetterEventsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ListDeadLetterEventsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeadLetterEventsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeadLetterEventsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeadLetterEventsResponse;
+
+  static equals(a: ListDeadLetterEventsResponse | PlainMessage<ListDeadLetterEventsResponse> | undefined, b: ListDeadLetterEventsResponse | PlainMessage<ListDeadLetterEventsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.GetDeadLetterEventRequest
+ */
+export declare class GetDeadLetterEventRequest extends Message<GetDeadLetterEventRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  constructor(data?: PartialMessage<GetDeadLetterEventRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.GetDeadLetterEventRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeadLetterEventRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeadLetterEventRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeadLetterEventRequest;
+
+  static equals(a: GetDeadLetterEventRequest | PlainMessage<GetDeadLetterEventRequest> | undefined, b: GetDeadLetterEventRequest | PlainMessage<GetDeadLetterEventRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.GetDeadLetterEventResponse
+ */
+export declare class GetDeadLetterEventResponse extends Message<GetDeadLetterEventResponse> {
+  /**
+   * @generated from field: bff.v1.DeadLetterEvent event = 1;
+   */
+  event?: DeadLetterEvent;
+
+  constructor(data?: PartialMessage<GetDeadLetterEventResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.GetDeadLetterEventResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeadLetterEventResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeadLetterEventResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeadLetterEventResponse;
+
+  static equals(a: GetDeadLetterEventResponse | PlainMessage<GetDeadLetterEventResponse> | undefined, b: GetDeadLetterEventResponse | PlainMessage<GetDeadLetterEventResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ReplayDeadLetterEventRequest
+ */
+export declare class ReplayDeadLetterEventRequest extends Message<ReplayDeadLetterEventRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  constructor(data?: PartialMessage<ReplayDeadLetterEventRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ReplayDeadLetterEventRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplayDeadLetterEventRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReplayDeadLetterEventRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReplayDeadLetterEventRequest;
+
+  static equals(a: ReplayDeadLetterEventRequest | PlainMessage<ReplayDeadLetterEventRequest> | undefined, b: ReplayDeadLetterEventRequest | PlainMessage<ReplayDeadLetterEventRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ReplayDeadLetterEventResponse
+ */
+export declare class ReplayDeadLetterEventResponse extends Message<ReplayDeadLetterEventResponse> {
+  /**
+   * @generated from field: bff.v1.DeadLetterEvent event = 1;
+   */
+  event?: DeadLetterEvent;
+
+  constructor(data?: PartialMessage<ReplayDeadLetterEventResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ReplayDeadLetterEventResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplayDeadLetterEventResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReplayDeadLetterEventResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReplayDeadLetterEventResponse;
+
+  static equals(a: ReplayDeadLetterEventResponse | PlainMessage<ReplayDeadLetterEventResponse> | undefined, b: ReplayDeadLetterEventResponse | PlainMessage<ReplayDeadLetterEventResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.DiscardDeadLetterEventRequest
+ */
+export declare class DiscardDeadLetterEventRequest extends Message<DiscardDeadLetterEventRequest> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  constructor(data?: PartialMessage<DiscardDeadLetterEventRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.DiscardDeadLetterEventRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiscardDeadLetterEventRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiscardDeadLetterEventRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiscardDeadLetterEventRequest;
+
+  static equals(a: DiscardDeadLetterEventRequest | PlainMessage<DiscardDeadLetterEventRequest> | undefined, b: DiscardDeadLetterEventRequest | PlainMessage<DiscardDeadLetterEventRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.DiscardDeadLetterEventResponse
+ */
+export declare class DiscardDeadLetterEventResponse extends Message<DiscardDeadLetterEventResponse> {
+  constructor(data?: PartialMessage<DiscardDeadLetterEventResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.DiscardDeadLetterEventResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiscardDeadLetterEventResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiscardDeadLetterEventResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiscardDeadLetterEventResponse;
+
+  static equals(a: DiscardDeadLetterEventResponse | PlainMessage<DiscardDeadLetterEventResponse> | undefined, b: DiscardDeadLetterEventResponse | PlainMessage<DiscardDeadLetterEventResponse> | undefined): boolean;
+}
+
+/**
+ * ReciprocalLikers is a pair of actors who have liked many of each other's
+ * posts recently.
+ *
+ * @generated from message bff.v1.ReciprocalLikers
+ */
+export declare class ReciprocalLikers extends Message<ReciprocalLikers> {
+  /**
+   * @generated from field: string actor_did = 1;
+   */
+  actorDid: string;
+
+  /**
+   * @generated from field: string partner_did = 2;
+   */
+  partnerDid: string;
+
+  /**
+   * likes_given is how many of partner_did's posts actor_did has liked.
+   *
+   * @generated from field: int64 likes_given = 3;
+   */
+  likesGiven: bigint;
+
+  /**
+   * likes_received is how many of actor_did's posts partner_did has liked.
+   *
+   * @generated from field: int64 likes_received = 4;
+   */
+  likesReceived: bigint;
+
+  constructor(data?: PartialMessage<ReciprocalLikers>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ReciprocalLikers";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReciprocalLikers;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReciprocalLikers;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReciprocalLikers;
+
+  static equals(a: ReciprocalLikers | PlainMessage<ReciprocalLikers> | undefined, b: ReciprocalLikers | PlainMessage<ReciprocalLikers> | undefined): boolean;
+}
+
+/**
+ * DampenedPost is a recent post whose likes count for less in scoring.
+ *
+ * @generated from message bff.v1.DampenedPost
+ */
+export declare class DampenedPost extends Message<DampenedPost> {
+  /**
+   * @generated from field: string uri = 1;
+   */
+  uri: string;
+
+  /**
+   * @generated from field: string actor_did = 2;
+   */
+  actorDid: string;
+
+  /**
+   * @generated from field: int64 likes = 3;
+   */
+  likes: bigint;
+
+  /**
+   * weighted_likes is what the likes count for once dampened.
+   *
+   * @generated from field: double weighted_likes = 4;
+   */
+  weightedLikes: number;
+
+  constructor(data?: PartialMessage<DampenedPost>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.DampenedPost";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DampenedPost;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DampenedPost;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DampenedPost;
+
+  static equals(a: DampenedPost | PlainMessage<DampenedPost> | undefined, b: DampenedPost | PlainMessage<DampenedPost> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ListScoringFlagsRequest
+ */
+export declare class ListScoringFlagsRequest extends Message<ListScoringFlagsRequest> {
+  /**
+   * limit specifies how many of each kind of flag to return. If unspecified,
+   * this defaults to 100.
+   *
+   * @generated from field: uint32 limit = 1;
+   */
+  limit: number;
+
+  constructor(data?: PartialMessage<ListScoringFlagsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ListScoringFlagsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScoringFlagsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScoringFlagsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScoringFlagsRequest;
+
+  static equals(a: ListScoringFlagsRequest | PlainMessage<ListScoringFlagsRequest> | undefined, b: ListScoringFlagsRequest | PlainMessage<ListScoringFlagsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ListScoringFlagsResponse
+ */
+export declare class ListScoringFlagsResponse extends Message<ListScoringFlagsResponse> {
+  /**
+   * @generated from field: repeated bff.v1.ReciprocalLikers reciprocal_likers = 1;
+   */
+  reciprocalLikers: ReciprocalLikers[];
+
+  /**
+   * @generated from field: repeated bff.v1.DampenedPost dampened_posts = 2;
+   */
+  dampenedPosts: DampenedPost[];
+
+  constructor(data?: PartialMessage<ListScoringFlagsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ListScoringFlagsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScoringFlagsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScoringFlagsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScoringFlagsResponse;
+
+  static equals(a: ListScoringFlagsResponse | PlainMessage<ListScoringFlagsResponse> | undefined, b: ListScoringFlagsResponse | PlainMessage<ListScoringFlagsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ExplainPostRequest
+ */
+export declare class ExplainPostRequest extends Message<ExplainPostRequest> {
+  /**
+   * @generated from field: string uri = 1;
+   */
+  uri: string;
+
+  /**
+   * @generated from field: string feed_id = 2;
+   */
+  feedId: string;
+
+  constructor(data?: PartialMessage<ExplainPostRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ExplainPostRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainPostRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainPostRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainPostRequest;
+
+  static equals(a: ExplainPostRequest | PlainMessage<ExplainPostRequest> | undefined, b: ExplainPostRequest | PlainMessage<ExplainPostRequest> | undefined): boolean;
+}
+
+/**
+ * PostFilterResult is whether a post passes one of a feed's filters.
+ *
+ * @generated from message bff.v1.PostFilterResult
+ */
+export declare class PostFilterResult extends Message<PostFilterResult> {
+  /**
+   * name identifies the filter, e.g "hashtags" or "nsfw".
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool passed = 2;
+   */
+  passed: boolean;
+
+  /**
+   * detail describes what the filter checked, in a way that can be shown to
+   * moderators.
+   *
+   * @generated from field: string detail = 3;
+   */
+  detail: string;
+
+  constructor(data?: PartialMessage<PostFilterResult>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.PostFilterResult";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostFilterResult;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PostFilterResult;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PostFilterResult;
+
+  static equals(a: PostFilterResult | PlainMessage<PostFilterResult> | undefined, b: PostFilterResult | PlainMessage<PostFilterResult> | undefined): boolean;
+}
+
+/**
+ * PostScoreExplanation breaks down a post's score in the latest generation of
+ * a scored feed's algorithm.
+ *
+ * @generated from message bff.v1.PostScoreExplanation
+ */
+export declare class PostScoreExplanation extends Message<PostScoreExplanation> {
+  /**
+   * @generated from field: string alg = 1;
+   */
+  alg: string;
+
+  /**
+   * @generated from field: int64 generation_seq = 2;
+   */
+  generationSeq: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp generated_at = 3;
+   */
+  generatedAt?: Timestamp;
+
+  /**
+   * @generated from field: double score = 4;
+   */
+  score: number;
+
+  /**
+   * likes is the like count the score was calculated from. Depending on the
+   * algorithm this may include reposts, or be weighted. likes, age_hours,
+   * age_penalty and formula are unset for algorithms we can't break down.
+   *
+   * @generated from field: double likes = 5;
+   */
+  likes: number;
+
+  /**
+   * age_hours is how old the post was when it was scored.
+   *
+   * @generated from field: double age_hours = 6;
+   */
+  ageHours: number;
+
+  /**
+   * age_penalty is what the like count was divided by to give the score. It's
+   * unset for algorithms which don't divide by an age penalty.
+   *
+   * @generated from field: double age_penalty = 7;
+   */
+  agePenalty: number;
+
+  /**
+   * rank is the 1-indexed position the post would have in the feed, if it
+   * passed every filter.
+   *
+   * @generated from field: int64 rank = 8;
+   */
+  rank: bigint;
+
+  /**
+   * formula is how the score is calculated from likes and age_hours.
+   *
+   * @generated from field: string formula = 9;
+   */
+  formula: string;
+
+  /**
+   * rank_approximate is true for feeds which jitter scores or limit posts per
+   * author, where rank is the position by score alone.
+   *
+   * @generated from field: bool rank_approximate = 10;
+   */
+  rankApproximate: boolean;
+
+  constructor(data?: PartialMessage<PostScoreExplanation>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.PostScoreExplanation";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostScoreExplanation;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PostScoreExplanation;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PostScoreExplanation;
+
+  static equals(a: PostScoreExplanation | PlainMessage<PostScoreExplanation> | undefined, b: PostScoreExplanation | PlainMessage<PostScoreExplanation> | undefined): boolean;
+}
+
+/**
+ * @generated from message bff.v1.ExplainPostResponse
+ */
+export declare class ExplainPostResponse extends Message<ExplainPostResponse> {
+  /**
+   * included is true if the post passes every filter.
+   *
+   * @generated from field: bool included = 1;
+   */
+  included: boolean;
+
+  /**
+   * @generated from field: repeated bff.v1.PostFilterResult filters = 2;
+   */
+  filters: PostFilterResult[];
+
+  /**
+   * score is unset for chronological feeds, and for posts that weren't scored
+   * in the latest generation.
+   *
+   * @generated from field: bff.v1.PostScoreExplanation score = 3;
+   */
+  score?: PostScoreExplanation;
+
+  constructor(data?: PartialMessage<ExplainPostResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "bff.v1.ExplainPostResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainPostResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainPostResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainPostResponse;
+
+  static equals(a: ExplainPostResponse | PlainMessage<ExplainPostResponse> | undefined, b: ExplainPostResponse | PlainMessage<ExplainPostResponse> | undefined): boolean;
+}
+
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Any, Duration, proto3, Timestamp } from "@bufbuild/protobuf";
-import { Actor, ActorStatus } from "./types_pb.js";
+import { Actor, ActorNSFWOverride, ActorStatus } from "./types_pb.js";
 
 /**
  * @generated from enum bff.v1.ApprovalQueueAction
@@ -33,6 +33,26 @@ export const AuditEventType = proto3.makeEnum(
     {no: 6, name: "TRACKED"},
     {no: 7, name: "BANNED"},
     {no: 8, name: "ASSIGNED_ROLES"},
+    {no: 9, name: "ACCOUNT_STATUS_CHANGED"},
+    {no: 10, name: "HANDLE_CHANGED"},
+    {no: 11, name: "ACTOR_DATA_DELETED"},
+    {no: 12, name: "ACTOR_DATA_RESTORED"},
+    {no: 13, name: "ACTOR_DATA_PURGED"},
+  ],
+);
+
+/**
+ * @generated from enum bff.v1.TaskState
+ */
+export const TaskState = proto3.makeEnum(
+  "bff.v1.TaskState",
+  [
+    {no: 0, name: "TASK_STATE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "TASK_STATE_PENDING", localName: "PENDING"},
+    {no: 2, name: "TASK_STATE_RUNNING", localName: "RUNNING"},
+    {no: 3, name: "TASK_STATE_FAILED", localName: "FAILED"},
+    {no: 4, name: "TASK_STATE_DONE", localName: "DONE"},
+    {no: 5, name: "TASK_STATE_CANCELLED", localName: "CANCELLED"},
   ],
 );
 
@@ -65,6 +85,18 @@ export const GetActorResponse = proto3.makeMessageType(
   "bff.v1.GetActorResponse",
   () => [
     { no: 1, name: "actor", kind: "message", T: Actor },
+    { no: 2, name: "handle_history", kind: "message", T: ActorHandle, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ActorHandle
+ */
+export const ActorHandle = proto3.makeMessageType(
+  "bff.v1.ActorHandle",
+  () => [
+    { no: 1, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "seen_at", kind: "message", T: Timestamp },
   ],
 );
 
@@ -356,6 +388,88 @@ export const BanActorAuditPayload = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message bff.v1.PurgeActorRequest
+ */
+export const PurgeActorRequest = proto3.makeMessageType(
+  "bff.v1.PurgeActorRequest",
+  () => [
+    { no: 1, name: "actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.PurgeActorResponse
+ */
+export const PurgeActorResponse = proto3.makeMessageType(
+  "bff.v1.PurgeActorResponse",
+  () => [
+    { no: 1, name: "actor", kind: "message", T: Actor },
+  ],
+);
+
+/**
+ * ActorDataDeletedAuditPayload is emitted when an actor's data is deleted,
+ * either by PurgeActor or by the ingester when their account is deleted.
+ *
+ * @generated from message bff.v1.ActorDataDeletedAuditPayload
+ */
+export const ActorDataDeletedAuditPayload = proto3.makeMessageType(
+  "bff.v1.ActorDataDeletedAuditPayload",
+  () => [
+    { no: 1, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "purge_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.RestoreActorRequest
+ */
+export const RestoreActorRequest = proto3.makeMessageType(
+  "bff.v1.RestoreActorRequest",
+  () => [
+    { no: 1, name: "actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.RestoreActorResponse
+ */
+export const RestoreActorResponse = proto3.makeMessageType(
+  "bff.v1.RestoreActorResponse",
+  () => [
+    { no: 1, name: "actor", kind: "message", T: Actor },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ActorDataRestoredAuditPayload
+ */
+export const ActorDataRestoredAuditPayload = proto3.makeMessageType(
+  "bff.v1.ActorDataRestoredAuditPayload",
+  () => [
+    { no: 1, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ActorDataPurgedAuditPayload is emitted by the worker when an actor's data
+ * is permanently deleted. The actor and subject of the audit event are both
+ * the purged actor.
+ *
+ * @generated from message bff.v1.ActorDataPurgedAuditPayload
+ */
+export const ActorDataPurgedAuditPayload = proto3.makeMessageType(
+  "bff.v1.ActorDataPurgedAuditPayload",
+  () => [
+    { no: 1, name: "posts", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "likes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "follows", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
 /**
  * @generated from message bff.v1.AuditEvent
  */
@@ -429,3 +543,419 @@ export const AssignRolesAuditPayload = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message bff.v1.SetActorNSFWOverrideRequest
+ */
+export const SetActorNSFWOverrideRequest = proto3.makeMessageType(
+  "bff.v1.SetActorNSFWOverrideRequest",
+  () => [
+    { no: 1, name: "actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "nsfw_override", kind: "enum", T: proto3.getEnumType(ActorNSFWOverride) },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.SetActorNSFWOverrideResponse
+ */
+export const SetActorNSFWOverrideResponse = proto3.makeMessageType(
+  "bff.v1.SetActorNSFWOverrideResponse",
+  () => [
+    { no: 1, name: "actor", kind: "message", T: Actor },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.SetActorNSFWOverrideAuditPayload
+ */
+export const SetActorNSFWOverrideAuditPayload = proto3.makeMessageType(
+  "bff.v1.SetActorNSFWOverrideAuditPayload",
+  () => [
+    { no: 1, name: "nsfw_override_before", kind: "enum", T: proto3.getEnumType(ActorNSFWOverride) },
+    { no: 2, name: "nsfw_override_after", kind: "enum", T: proto3.getEnumType(ActorNSFWOverride) },
+  ],
+);
+
+/**
+ * AccountStatusChangedAuditPayload is emitted by the ingester when an actor's
+ * account is deactivated, taken down or restored on the network. The actor
+ * and subject of the audit event are both the affected actor.
+ *
+ * @generated from message bff.v1.AccountStatusChangedAuditPayload
+ */
+export const AccountStatusChangedAuditPayload = proto3.makeMessageType(
+  "bff.v1.AccountStatusChangedAuditPayload",
+  () => [
+    { no: 1, name: "active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * HandleChangedAuditPayload is emitted by the ingester when an actor changes
+ * their handle. The actor and subject of the audit event are both the
+ * affected actor.
+ *
+ * @generated from message bff.v1.HandleChangedAuditPayload
+ */
+export const HandleChangedAuditPayload = proto3.makeMessageType(
+  "bff.v1.HandleChangedAuditPayload",
+  () => [
+    { no: 1, name: "handle_before", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "handle_after", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.GetFollowReconciliationReportRequest
+ */
+export const GetFollowReconciliationReportRequest = proto3.makeMessageType(
+  "bff.v1.GetFollowReconciliationReportRequest",
+  [],
+);
+
+/**
+ * @generated from message bff.v1.GetFollowReconciliationReportResponse
+ */
+export const GetFollowReconciliationReportResponse = proto3.makeMessageType(
+  "bff.v1.GetFollowReconciliationReportResponse",
+  () => [
+    { no: 1, name: "dids_to_follow", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "dids_to_unfollow", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "untracked_dids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.Task
+ */
+export const Task = proto3.makeMessageType(
+  "bff.v1.Task",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(TaskState) },
+    { no: 5, name: "tries", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "max_tries", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "next_try_at", kind: "message", T: Timestamp },
+    { no: 8, name: "created_at", kind: "message", T: Timestamp },
+    { no: 9, name: "finished_at", kind: "message", T: Timestamp },
+    { no: 10, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ListTasksRequest
+ */
+export const ListTasksRequest = proto3.makeMessageType(
+  "bff.v1.ListTasksRequest",
+  () => [
+    { no: 1, name: "filter_state", kind: "enum", T: proto3.getEnumType(TaskState) },
+    { no: 2, name: "filter_actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "filter_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ListTasksResponse
+ */
+export const ListTasksResponse = proto3.makeMessageType(
+  "bff.v1.ListTasksResponse",
+  () => [
+    { no: 1, name: "tasks", kind: "message", T: Task, repeated: true },
+    { no: 2, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.GetTaskRequest
+ */
+export const GetTaskRequest = proto3.makeMessageType(
+  "bff.v1.GetTaskRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.GetTaskResponse
+ */
+export const GetTaskResponse = proto3.makeMessageType(
+  "bff.v1.GetTaskResponse",
+  () => [
+    { no: 1, name: "task", kind: "message", T: Task },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.RetryTaskRequest
+ */
+export const RetryTaskRequest = proto3.makeMessageType(
+  "bff.v1.RetryTaskRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.RetryTaskResponse
+ */
+export const RetryTaskResponse = proto3.makeMessageType(
+  "bff.v1.RetryTaskResponse",
+  () => [
+    { no: 1, name: "task", kind: "message", T: Task },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.CancelTaskRequest
+ */
+export const CancelTaskRequest = proto3.makeMessageType(
+  "bff.v1.CancelTaskRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.CancelTaskResponse
+ */
+export const CancelTaskResponse = proto3.makeMessageType(
+  "bff.v1.CancelTaskResponse",
+  () => [
+    { no: 1, name: "task", kind: "message", T: Task },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.RefreshActorProfileRequest
+ */
+export const RefreshActorProfileRequest = proto3.makeMessageType(
+  "bff.v1.RefreshActorProfileRequest",
+  () => [
+    { no: 1, name: "actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.RefreshActorProfileResponse
+ */
+export const RefreshActorProfileResponse = proto3.makeMessageType(
+  "bff.v1.RefreshActorProfileResponse",
+  () => [
+    { no: 1, name: "task", kind: "message", T: Task },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.DeadLetterEvent
+ */
+export const DeadLetterEvent = proto3.makeMessageType(
+  "bff.v1.DeadLetterEvent",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+    { no: 7, name: "last_attempt_at", kind: "message", T: Timestamp },
+    { no: 8, name: "next_attempt_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ListDeadLetterEventsRequest
+ */
+export const ListDeadLetterEventsRequest = proto3.makeMessageType(
+  "bff.v1.ListDeadLetterEventsRequest",
+  () => [
+    { no: 1, name: "filter_actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ListDeadLetterEventsResponse
+ */
+export const ListDeadLetterEventsResponse = proto3.makeMessageType(
+  "bff.v1.ListDeadLetterEventsResponse",
+  () => [
+    { no: 1, name: "events", kind: "message", T: DeadLetterEvent, repeated: true },
+    { no: 2, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.GetDeadLetterEventRequest
+ */
+export const GetDeadLetterEventRequest = proto3.makeMessageType(
+  "bff.v1.GetDeadLetterEventRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.GetDeadLetterEventResponse
+ */
+export const GetDeadLetterEventResponse = proto3.makeMessageType(
+  "bff.v1.GetDeadLetterEventResponse",
+  () => [
+    { no: 1, name: "event", kind: "message", T: DeadLetterEvent },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ReplayDeadLetterEventRequest
+ */
+export const ReplayDeadLetterEventRequest = proto3.makeMessageType(
+  "bff.v1.ReplayDeadLetterEventRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ReplayDeadLetterEventResponse
+ */
+export const ReplayDeadLetterEventResponse = proto3.makeMessageType(
+  "bff.v1.ReplayDeadLetterEventResponse",
+  () => [
+    { no: 1, name: "event", kind: "message", T: DeadLetterEvent },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.DiscardDeadLetterEventRequest
+ */
+export const DiscardDeadLetterEventRequest = proto3.makeMessageType(
+  "bff.v1.DiscardDeadLetterEventRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.DiscardDeadLetterEventResponse
+ */
+export const DiscardDeadLetterEventResponse = proto3.makeMessageType(
+  "bff.v1.DiscardDeadLetterEventResponse",
+  [],
+);
+
+/**
+ * ReciprocalLikers is a pair of actors who have liked many of each other's
+ * posts recently.
+ *
+ * @generated from message bff.v1.ReciprocalLikers
+ */
+export const ReciprocalLikers = proto3.makeMessageType(
+  "bff.v1.ReciprocalLikers",
+  () => [
+    { no: 1, name: "actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "partner_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "likes_given", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "likes_received", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * DampenedPost is a recent post whose likes count for less in scoring.
+ *
+ * @generated from message bff.v1.DampenedPost
+ */
+export const DampenedPost = proto3.makeMessageType(
+  "bff.v1.DampenedPost",
+  () => [
+    { no: 1, name: "uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "actor_did", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "likes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "weighted_likes", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ListScoringFlagsRequest
+ */
+export const ListScoringFlagsRequest = proto3.makeMessageType(
+  "bff.v1.ListScoringFlagsRequest",
+  () => [
+    { no: 1, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ListScoringFlagsResponse
+ */
+export const ListScoringFlagsResponse = proto3.makeMessageType(
+  "bff.v1.ListScoringFlagsResponse",
+  () => [
+    { no: 1, name: "reciprocal_likers", kind: "message", T: ReciprocalLikers, repeated: true },
+    { no: 2, name: "dampened_posts", kind: "message", T: DampenedPost, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ExplainPostRequest
+ */
+export const ExplainPostRequest = proto3.makeMessageType(
+  "bff.v1.ExplainPostRequest",
+  () => [
+    { no: 1, name: "uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "feed_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * PostFilterResult is whether a post passes one of a feed's filters.
+ *
+ * @generated from message bff.v1.PostFilterResult
+ */
+export const PostFilterResult = proto3.makeMessageType(
+  "bff.v1.PostFilterResult",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "detail", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * PostScoreExplanation breaks down a post's score in the latest generation of
+ * a scored feed's algorithm.
+ *
+ * @generated from message bff.v1.PostScoreExplanation
+ */
+export const PostScoreExplanation = proto3.makeMessageType(
+  "bff.v1.PostScoreExplanation",
+  () => [
+    { no: 1, name: "alg", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "generation_seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "generated_at", kind: "message", T: Timestamp },
+    { no: 4, name: "score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "likes", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "age_hours", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "age_penalty", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "rank", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "formula", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "rank_approximate", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message bff.v1.ExplainPostResponse
+ */
+export const ExplainPostResponse = proto3.makeMessageType(
+  "bff.v1.ExplainPostResponse",
+  () => [
+    { no: 1, name: "included", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "filters", kind: "message", T: PostFilterResult, repeated: true },
+    { no: 3, name: "score", kind: "message", T: PostScoreExplanation },
+  ],
+);
+
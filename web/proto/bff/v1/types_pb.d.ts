@@ -36,6 +36,35 @@ export declare enum ActorStatus {
   NONE = 4,
 }
 
+/**
+ * ActorNSFWOverride overrides whether an actor's posts are NSFW.
+ *
+ * @generated from enum bff.v1.ActorNSFWOverride
+ */
+export declare enum ActorNSFWOverride {
+  /**
+   * ACTOR_NSFW_OVERRIDE_UNSPECIFIED means the actor's posts are classified by
+   * the NSFW rules.
+   *
+   * @generated from enum value: ACTOR_NSFW_OVERRIDE_UNSPECIFIED = 0;
+   */
+  ACTOR_NSFW_OVERRIDE_UNSPECIFIED = 0,
+
+  /**
+   * ACTOR_NSFW_OVERRIDE_NSFW treats all of the actor's posts as NSFW.
+   *
+   * @generated from enum value: ACTOR_NSFW_OVERRIDE_NSFW = 1;
+   */
+  ACTOR_NSFW_OVERRIDE_NSFW = 1,
+
+  /**
+   * ACTOR_NSFW_OVERRIDE_SFW treats none of the actor's posts as NSFW.
+   *
+   * @generated from enum value: ACTOR_NSFW_OVERRIDE_SFW = 2;
+   */
+  ACTOR_NSFW_OVERRIDE_SFW = 2,
+}
+
 /**
  * @generated from message bff.v1.Actor
  */
@@ -50,7 +79,7 @@ export declare class Actor extends Message<Actor> {
 
   /**
    * is_artist is a flag indicating this account is primarily an artist. It
-   * does not currently control any feed placement.
+   * controls placement in the artist feeds.
    *
    * @generated from field: bool is_artist = 3;
    */
@@ -96,6 +125,54 @@ export declare class Actor extends Message<Actor> {
    */
   heldUntil?: Timestamp;
 
+  /**
+   * handle is the actor's handle as last seen on the network. It may be empty
+   * if the actor has not changed their handle since we started tracking them.
+   *
+   * @generated from field: string handle = 9;
+   */
+  handle: string;
+
+  /**
+   * account_status is set when the actor's account is inactive on the
+   * network, e.g. "deactivated" or "takendown". Posts by actors with inactive
+   * accounts are hidden from feeds.
+   *
+   * @generated from field: string account_status = 10;
+   */
+  accountStatus: string;
+
+  /**
+   * deleted_at is set when the actor's data has been deleted, e.g. because
+   * their account was deleted. Their data is hidden, and is purged after a
+   * grace period.
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 11;
+   */
+  deletedAt?: Timestamp;
+
+  /**
+   * purged_at is set once the actor's data has been permanently deleted.
+   *
+   * @generated from field: google.protobuf.Timestamp purged_at = 12;
+   */
+  purgedAt?: Timestamp;
+
+  /**
+   * approved_at is when the actor was most recently approved.
+   *
+   * @generated from field: google.protobuf.Timestamp approved_at = 13;
+   */
+  approvedAt?: Timestamp;
+
+  /**
+   * nsfw_override is set by moderators to override whether the actor's posts
+   * are NSFW.
+   *
+   * @generated from field: bff.v1.ActorNSFWOverride nsfw_override = 14;
+   */
+  nsfwOverride: ActorNSFWOverride;
+
   constructor(data?: PartialMessage<Actor>);
 
   static readonly runtime: typeof proto3;
@@ -19,6 +19,20 @@ export const ActorStatus = proto3.makeEnum(
   ],
 );
 
+/**
+ * ActorNSFWOverride overrides whether an actor's posts are NSFW.
+ *
+ * @generated from enum bff.v1.ActorNSFWOverride
+ */
+export const ActorNSFWOverride = proto3.makeEnum(
+  "bff.v1.ActorNSFWOverride",
+  [
+    {no: 0, name: "ACTOR_NSFW_OVERRIDE_UNSPECIFIED"},
+    {no: 1, name: "ACTOR_NSFW_OVERRIDE_NSFW"},
+    {no: 2, name: "ACTOR_NSFW_OVERRIDE_SFW"},
+  ],
+);
+
 /**
  * @generated from message bff.v1.Actor
  */
@@ -32,6 +46,12 @@ export const Actor = proto3.makeMessageType(
     { no: 6, name: "created_at", kind: "message", T: Timestamp },
     { no: 7, name: "roles", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 8, name: "held_until", kind: "message", T: Timestamp },
+    { no: 9, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "account_status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "deleted_at", kind: "message", T: Timestamp },
+    { no: 12, name: "purged_at", kind: "message", T: Timestamp },
+    { no: 13, name: "approved_at", kind: "message", T: Timestamp },
+    { no: 14, name: "nsfw_override", kind: "enum", T: proto3.getEnumType(ActorNSFWOverride) },
   ],
 );
 
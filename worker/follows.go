@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/api/bsky"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+const followCollection = "app.bsky.graph.follow"
+
+// RecordLister lists the records within a collection of the authenticated
+// account's repository.
+type RecordLister interface {
+	ListRecords(ctx context.Context, collection string) ([]*atproto.RepoListRecords_Record, error)
+}
+
+// FollowReconciliation describes how the accounts followed by the feed
+// account have drifted from the set of approved actors.
+type FollowReconciliation struct {
+	// ToFollow are approved actors who are not followed.
+	ToFollow []string
+	// ToUnfollow are followed actors who are not approved.
+	ToUnfollow []string
+	// Untracked are followed accounts which are not known actors. We leave
+	// these alone, as they may have been followed deliberately.
+	Untracked []string
+}
+
+// ComputeFollowReconciliation compares the actual follows of the account
+// against the actors in the store. It makes no changes.
+func ComputeFollowReconciliation(
+	ctx context.Context, pgxStore *store.PGXStore, lister RecordLister,
+) (*FollowReconciliation, error) {
+	actors, err := pgxStore.ListActors(ctx, store.ListActorsOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("listing actors: %w", err)
+	}
+
+	records, err := lister.ListRecords(ctx, followCollection)
+	if err != nil {
+		return nil, fmt.Errorf("listing follows: %w", err)
+	}
+	followed := []string{}
+	for _, r := range records {
+		if r.Value == nil {
+			continue
+		}
+		if follow, ok := r.Value.Val.(*bsky.GraphFollow); ok {
+			followed = append(followed, follow.Subject)
+		}
+	}
+
+	return diffFollows(actors, followed), nil
+}
+
+func diffFollows(actors []*v1.Actor, followed []string) *FollowReconciliation {
+	followedSet := map[string]bool{}
+	for _, did := range followed {
+		followedSet[did] = true
+	}
+
+	out := &FollowReconciliation{
+		ToFollow:   []string{},
+		ToUnfollow: []string{},
+		Untracked:  []string{},
+	}
+	known := map[string]bool{}
+	for _, actor := range actors {
+		known[actor.Did] = true
+		approved := actor.Status == v1.ActorStatus_ACTOR_STATUS_APPROVED
+		switch {
+		case approved && !followedSet[actor.Did]:
+			out.ToFollow = append(out.ToFollow, actor.Did)
+		case !approved && followedSet[actor.Did]:
+			out.ToUnfollow = append(out.ToUnfollow, actor.Did)
+		}
+	}
+	for did := range followedSet {
+		if !known[did] {
+			out.Untracked = append(out.Untracked, did)
+		}
+	}
+
+	slices.Sort(out.ToFollow)
+	slices.Sort(out.ToUnfollow)
+	slices.Sort(out.Untracked)
+	return out
+}
+
+// reconcileFollows enqueues follow and unfollow tasks to correct any drift
+// between the account's follows and the approved actors. Actors which already
+// have a pending task are skipped, so that repeated reconciliation does not
+// pile up duplicate tasks.
+func (w *Worker) reconcileFollows(ctx context.Context) error {
+	report, err := ComputeFollowReconciliation(ctx, w.store, w.listClient)
+	if err != nil {
+		return fmt.Errorf("computing reconciliation: %w", err)
+	}
+
+	pendingDIDs, err := w.store.ListPendingTaskActorDIDs(ctx, store.TaskTypeFollow, store.TaskTypeUnfollow)
+	if err != nil {
+		return fmt.Errorf("listing pending tasks: %w", err)
+	}
+	pending := map[string]bool{}
+	for _, did := range pendingDIDs {
+		pending[did] = true
+	}
+
+	enqueued := 0
+	for _, did := range report.ToFollow {
+		if pending[did] {
+			continue
+		}
+		if err := w.store.EnqueueFollow(ctx, did); err != nil {
+			return fmt.Errorf("enqueueing follow for %q: %w", did, err)
+		}
+		enqueued++
+	}
+	for _, did := range report.ToUnfollow {
+		if pending[did] {
+			continue
+		}
+		if err := w.store.EnqueueUnfollow(ctx, did); err != nil {
+			return fmt.Errorf("enqueueing unfollow for %q: %w", did, err)
+		}
+		enqueued++
+	}
+
+	w.log.Info(
+		"reconciled follows",
+		slog.Int("to_follow", len(report.ToFollow)),
+		slog.Int("to_unfollow", len(report.ToUnfollow)),
+		slog.Int("untracked", len(report.Untracked)),
+		slog.Int("enqueued", enqueued),
+	)
+	return nil
+}
@@ -0,0 +1,31 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+)
+
+func TestDiffFollows(t *testing.T) {
+	t.Parallel()
+
+	actors := []*v1.Actor{
+		{Did: "did:example:approved-followed", Status: v1.ActorStatus_ACTOR_STATUS_APPROVED},
+		{Did: "did:example:approved-unfollowed", Status: v1.ActorStatus_ACTOR_STATUS_APPROVED},
+		{Did: "did:example:banned-followed", Status: v1.ActorStatus_ACTOR_STATUS_BANNED},
+		{Did: "did:example:pending-unfollowed", Status: v1.ActorStatus_ACTOR_STATUS_PENDING},
+	}
+	followed := []string{
+		"did:example:approved-followed",
+		"did:example:banned-followed",
+		"did:example:unknown",
+	}
+
+	got := diffFollows(actors, followed)
+	assert.Equal(t, &FollowReconciliation{
+		ToFollow:   []string{"did:example:approved-unfollowed"},
+		ToUnfollow: []string{"did:example:banned-followed"},
+		Untracked:  []string{"did:example:unknown"},
+	}, got)
+}
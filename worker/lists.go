@@ -0,0 +1,329 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/indigo/atproto/syntax"
+	"github.com/bluesky-social/indigo/repo"
+	indigoUtils "github.com/bluesky-social/indigo/util"
+	"github.com/strideynet/bsky-furry-feed/bluesky"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+)
+
+const (
+	listCollection     = "app.bsky.graph.list"
+	listItemCollection = "app.bsky.graph.listitem"
+	curateListPurpose  = "app.bsky.graph.defs#curatelist"
+
+	// maxListWritesPerReconcile limits how many records we write in a single
+	// reconciliation, so that a large backlog (e.g. on first run) is spread
+	// across several runs rather than exhausting the PDS write rate limit.
+	maxListWritesPerReconcile = 200
+)
+
+// listItemTIDs generates record keys for new listitem records. Using a clock,
+// rather than syntax.NewTIDNow, ensures keys are unique even when we write in
+// quick succession.
+var listItemTIDs = syntax.NewTIDClock(0)
+
+// listItemStore records the listitems in our lists, so that an actor's
+// membership can be looked up without listing every listitem.
+type listItemStore interface {
+	ReserveCuratedListItem(ctx context.Context, listURI string, subjectDID string, rkey string) (string, error)
+	ListCuratedListItems(ctx context.Context, listURI string) ([]gen.CuratedListItem, error)
+	ListCuratedListItemsBySubject(ctx context.Context, subjectDID string) ([]gen.CuratedListItem, error)
+	DeleteCuratedListItem(ctx context.Context, listURI string, subjectDID string) error
+	ReplaceCuratedListItems(ctx context.Context, listURI string, items map[string]string) error
+}
+
+type listClient interface {
+	DID() string
+	ListRecords(ctx context.Context, collection string) ([]*atproto.RepoListRecords_Record, error)
+	PutRecord(ctx context.Context, collection, rkey string, record repo.CborMarshaler) error
+	DeleteRecord(ctx context.Context, uri *indigoUtils.ParsedUri) error
+}
+
+// curatedList is an app.bsky.graph.list owned by the authenticated account,
+// which is kept in sync with the set of approved actors matching include.
+type curatedList struct {
+	rkey        string
+	name        string
+	description string
+	include     func(actor *v1.Actor) bool
+}
+
+var furriesList = curatedList{
+	rkey:        "furries",
+	name:        "furryli.st",
+	description: "Furries approved for the furryli.st feeds.",
+	include: func(actor *v1.Actor) bool {
+		return true
+	},
+}
+
+var artistsList = curatedList{
+	rkey:        "artists",
+	name:        "furryli.st artists",
+	description: "Furry artists approved for the furryli.st feeds.",
+	include: func(actor *v1.Actor) bool {
+		return actor.IsArtist
+	},
+}
+
+func listURI(ownerDID string, rkey string) string {
+	return fmt.Sprintf("at://%s/%s/%s", ownerDID, listCollection, rkey)
+}
+
+// addToLists adds a newly approved actor to each list which should include
+// them. Moderation decisions enqueue this, so that lists update promptly,
+// whilst reconcileLists corrects anything missed.
+func (w *Worker) addToLists(ctx context.Context, actorDID string) error {
+	actor, err := w.store.GetActorByDID(ctx, actorDID)
+	if err != nil {
+		return fmt.Errorf("fetching actor: %w", err)
+	}
+	return w.addActorToLists(ctx, actor)
+}
+
+func (w *Worker) addActorToLists(ctx context.Context, actor *v1.Actor) error {
+	// The actor may have been unapproved since the task was enqueued.
+	if actor.Status != v1.ActorStatus_ACTOR_STATUS_APPROVED {
+		return nil
+	}
+
+	for _, l := range w.lists {
+		if !l.include(actor) {
+			continue
+		}
+		uri := listURI(w.listClient.DID(), l.rkey)
+		// If the actor's listitem is already recorded, we write it again
+		// under the same record key, so that retries and concurrent tasks
+		// can't add the actor twice.
+		rkey, err := w.listItems.ReserveCuratedListItem(ctx, uri, actor.Did, listItemTIDs.Next().String())
+		if err != nil {
+			return fmt.Errorf("reserving listitem: %w", err)
+		}
+		err = w.listClient.PutRecord(ctx, listItemCollection, rkey, &bsky.GraphListitem{
+			LexiconTypeID: listItemCollection,
+			CreatedAt:     bluesky.FormatTime(time.Now()),
+			List:          uri,
+			Subject:       actor.Did,
+		})
+		if err != nil {
+			return fmt.Errorf("adding %q to list %q: %w", actor.Did, l.rkey, err)
+		}
+		w.log.Info("added actor to list", slog.String("list", uri), slog.String("actor_did", actor.Did))
+	}
+	return nil
+}
+
+// removeFromLists removes an actor from every list. Moderation decisions
+// which unapprove or ban an actor enqueue this.
+func (w *Worker) removeFromLists(ctx context.Context, actorDID string) error {
+	items, err := w.listItems.ListCuratedListItemsBySubject(ctx, actorDID)
+	if err != nil {
+		return fmt.Errorf("listing listitems: %w", err)
+	}
+	for _, item := range items {
+		err := w.listClient.DeleteRecord(ctx, &indigoUtils.ParsedUri{
+			Did:        w.listClient.DID(),
+			Collection: listItemCollection,
+			Rkey:       item.Rkey,
+		})
+		if err != nil {
+			return fmt.Errorf("removing listitem %q: %w", item.Rkey, err)
+		}
+		if err := w.listItems.DeleteCuratedListItem(ctx, item.ListUri, actorDID); err != nil {
+			return fmt.Errorf("deleting listitem %q: %w", item.Rkey, err)
+		}
+		w.log.Info("removed actor from list", slog.String("list", item.ListUri), slog.String("actor_did", actorDID))
+	}
+	return nil
+}
+
+// reconcileLists ensures each configured list exists and contains exactly the
+// approved actors which should be on it, adding and removing listitem
+// records to correct any drift. It's the safety net for the list tasks
+// enqueued by moderation decisions.
+func (w *Worker) reconcileLists(ctx context.Context) error {
+	approved, err := w.store.ListActors(ctx, store.ListActorsOpts{
+		FilterStatus: v1.ActorStatus_ACTOR_STATUS_APPROVED,
+	})
+	if err != nil {
+		return fmt.Errorf("listing approved actors: %w", err)
+	}
+
+	existingLists, err := w.listClient.ListRecords(ctx, listCollection)
+	if err != nil {
+		return fmt.Errorf("listing lists: %w", err)
+	}
+	items, err := w.listClient.ListRecords(ctx, listItemCollection)
+	if err != nil {
+		return fmt.Errorf("listing list items: %w", err)
+	}
+
+	writesRemaining := maxListWritesPerReconcile
+	for _, l := range w.lists {
+		if err := w.ensureList(ctx, l, existingLists); err != nil {
+			return fmt.Errorf("ensuring list %q exists: %w", l.rkey, err)
+		}
+
+		desired := []string{}
+		for _, actor := range approved {
+			if l.include(actor) {
+				desired = append(desired, actor.Did)
+			}
+		}
+
+		uri := listURI(w.listClient.DID(), l.rkey)
+		recordedItems, err := w.listItems.ListCuratedListItems(ctx, uri)
+		if err != nil {
+			return fmt.Errorf("listing recorded listitems: %w", err)
+		}
+		recorded := map[string]string{}
+		for _, item := range recordedItems {
+			recorded[item.SubjectDid] = item.Rkey
+		}
+
+		members, writes, err := w.reconcileListItems(ctx, l, desired, items, recorded, writesRemaining)
+		if err != nil {
+			return fmt.Errorf("reconciling list %q: %w", l.rkey, err)
+		}
+		writesRemaining -= writes
+		if err := w.listItems.ReplaceCuratedListItems(ctx, uri, members); err != nil {
+			return fmt.Errorf("recording listitems of %q: %w", l.rkey, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *Worker) ensureList(
+	ctx context.Context,
+	l curatedList,
+	existing []*atproto.RepoListRecords_Record,
+) error {
+	uri := listURI(w.listClient.DID(), l.rkey)
+	for _, r := range existing {
+		if r.Uri == uri {
+			return nil
+		}
+	}
+
+	w.log.Info("creating list", slog.String("list", uri))
+	purpose := curateListPurpose
+	return w.listClient.PutRecord(ctx, listCollection, l.rkey, &bsky.GraphList{
+		LexiconTypeID: listCollection,
+		CreatedAt:     bluesky.FormatTime(time.Now()),
+		Name:          l.name,
+		Description:   &l.description,
+		Purpose:       &purpose,
+	})
+}
+
+// reconcileListItems adds listitem records for desired actors missing from the
+// list, and removes listitem records for actors who should no longer be on it
+// (or which are duplicates). Missing actors are added under their recorded
+// record key, if they have one, so that a concurrent addition can't add them
+// twice. It returns the record keys of the list's listitems, by subject, and
+// the number of writes made, which will not exceed maxWrites.
+func (w *Worker) reconcileListItems(
+	ctx context.Context,
+	l curatedList,
+	desired []string,
+	items []*atproto.RepoListRecords_Record,
+	recorded map[string]string,
+	maxWrites int,
+) (map[string]string, int, error) {
+	uri := listURI(w.listClient.DID(), l.rkey)
+	log := w.log.With(slog.String("list", uri))
+
+	desiredSet := map[string]bool{}
+	for _, did := range desired {
+		desiredSet[did] = true
+	}
+
+	// members are the listitems which will be left once we're done.
+	members := map[string]string{}
+	type listItem struct {
+		subject string
+		uri     *indigoUtils.ParsedUri
+	}
+	toDelete := []listItem{}
+	for _, r := range items {
+		if r.Value == nil {
+			continue
+		}
+		item, ok := r.Value.Val.(*bsky.GraphListitem)
+		if !ok || item.List != uri {
+			continue
+		}
+		parsed, err := indigoUtils.ParseAtUri(r.Uri)
+		if err != nil {
+			return nil, 0, fmt.Errorf("parsing listitem uri: %w", err)
+		}
+		if _, ok := members[item.Subject]; !desiredSet[item.Subject] || ok {
+			toDelete = append(toDelete, listItem{subject: item.Subject, uri: parsed})
+			continue
+		}
+		members[item.Subject] = parsed.Rkey
+	}
+
+	writes := 0
+	// deferred records the listitems we've run out of writes to delete,
+	// which are still members of the list.
+	deferred := func(remaining []listItem) map[string]string {
+		log.Warn("list write limit reached, deferring remaining changes")
+		for _, item := range remaining {
+			if _, ok := members[item.subject]; !ok {
+				members[item.subject] = item.uri.Rkey
+			}
+		}
+		return members
+	}
+	for _, did := range desired {
+		if _, ok := members[did]; ok {
+			continue
+		}
+		if writes >= maxWrites {
+			return deferred(toDelete), writes, nil
+		}
+		rkey, ok := recorded[did]
+		if !ok {
+			rkey = listItemTIDs.Next().String()
+		}
+		err := w.listClient.PutRecord(ctx, listItemCollection, rkey, &bsky.GraphListitem{
+			LexiconTypeID: listItemCollection,
+			CreatedAt:     bluesky.FormatTime(time.Now()),
+			List:          uri,
+			Subject:       did,
+		})
+		if err != nil {
+			return nil, writes, fmt.Errorf("adding %q to list: %w", did, err)
+		}
+		members[did] = rkey
+		writes++
+	}
+
+	for i, item := range toDelete {
+		if writes >= maxWrites {
+			return deferred(toDelete[i:]), writes, nil
+		}
+		if err := w.listClient.DeleteRecord(ctx, item.uri); err != nil {
+			return nil, writes, fmt.Errorf("removing listitem %q: %w", item.uri.Rkey, err)
+		}
+		writes++
+	}
+
+	if writes > 0 {
+		log.Info("reconciled list", slog.Int("writes", writes))
+	}
+	return members, writes, nil
+}
@@ -0,0 +1,245 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/indigo/lex/util"
+	"github.com/bluesky-social/indigo/repo"
+	indigoUtils "github.com/bluesky-social/indigo/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+)
+
+type mockRepoClient struct {
+	sync.Mutex
+	did     string
+	records map[string]repo.CborMarshaler
+}
+
+func (m *mockRepoClient) DID() string {
+	return m.did
+}
+
+func (m *mockRepoClient) ListRecords(_ context.Context, collection string) ([]*atproto.RepoListRecords_Record, error) {
+	m.Lock()
+	defer m.Unlock()
+	out := []*atproto.RepoListRecords_Record{}
+	for uri, record := range m.records {
+		parsed, err := indigoUtils.ParseAtUri(uri)
+		if err != nil {
+			return nil, err
+		}
+		if parsed.Collection != collection {
+			continue
+		}
+		out = append(out, &atproto.RepoListRecords_Record{
+			Uri:   uri,
+			Value: &util.LexiconTypeDecoder{Val: record},
+		})
+	}
+	return out, nil
+}
+
+func (m *mockRepoClient) PutRecord(_ context.Context, collection, rkey string, record repo.CborMarshaler) error {
+	m.Lock()
+	defer m.Unlock()
+	m.records[fmt.Sprintf("at://%s/%s/%s", m.did, collection, rkey)] = record
+	return nil
+}
+
+func (m *mockRepoClient) DeleteRecord(_ context.Context, uri *indigoUtils.ParsedUri) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.records, fmt.Sprintf("at://%s/%s/%s", uri.Did, uri.Collection, uri.Rkey))
+	return nil
+}
+
+func (m *mockRepoClient) listSubjects(list string) []string {
+	m.Lock()
+	defer m.Unlock()
+	subjects := []string{}
+	for _, record := range m.records {
+		if item, ok := record.(*bsky.GraphListitem); ok && item.List == list {
+			subjects = append(subjects, item.Subject)
+		}
+	}
+	return subjects
+}
+
+type fakeListItemStore struct {
+	sync.Mutex
+	// items maps list URIs to the record key of each subject's listitem.
+	items map[string]map[string]string
+}
+
+func (f *fakeListItemStore) ReserveCuratedListItem(_ context.Context, listURI string, subjectDID string, rkey string) (string, error) {
+	f.Lock()
+	defer f.Unlock()
+	if f.items[listURI] == nil {
+		f.items[listURI] = map[string]string{}
+	}
+	if existing, ok := f.items[listURI][subjectDID]; ok {
+		return existing, nil
+	}
+	f.items[listURI][subjectDID] = rkey
+	return rkey, nil
+}
+
+func (f *fakeListItemStore) ListCuratedListItems(_ context.Context, listURI string) ([]gen.CuratedListItem, error) {
+	f.Lock()
+	defer f.Unlock()
+	out := []gen.CuratedListItem{}
+	for did, rkey := range f.items[listURI] {
+		out = append(out, gen.CuratedListItem{ListUri: listURI, SubjectDid: did, Rkey: rkey})
+	}
+	return out, nil
+}
+
+func (f *fakeListItemStore) ListCuratedListItemsBySubject(_ context.Context, subjectDID string) ([]gen.CuratedListItem, error) {
+	f.Lock()
+	defer f.Unlock()
+	out := []gen.CuratedListItem{}
+	for listURI, items := range f.items {
+		if rkey, ok := items[subjectDID]; ok {
+			out = append(out, gen.CuratedListItem{ListUri: listURI, SubjectDid: subjectDID, Rkey: rkey})
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeListItemStore) DeleteCuratedListItem(_ context.Context, listURI string, subjectDID string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.items[listURI], subjectDID)
+	return nil
+}
+
+func (f *fakeListItemStore) ReplaceCuratedListItems(_ context.Context, listURI string, items map[string]string) error {
+	f.Lock()
+	defer f.Unlock()
+	f.items[listURI] = items
+	return nil
+}
+
+func TestWorker_ReconcileListItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	client := &mockRepoClient{
+		did:     "did:example:furrylist",
+		records: map[string]repo.CborMarshaler{},
+	}
+	uri := listURI(client.did, furriesList.rkey)
+	otherURI := listURI(client.did, "other")
+	// Pre-populate the repo with drift: a stale entry, a duplicate and an
+	// entry on an unrelated list, which must be left alone.
+	for rkey, item := range map[string]*bsky.GraphListitem{
+		"1": {List: uri, Subject: "did:example:banned"},
+		"2": {List: uri, Subject: "did:example:approved-1"},
+		"3": {List: uri, Subject: "did:example:approved-1"},
+		"4": {List: otherURI, Subject: "did:example:banned"},
+	} {
+		require.NoError(t, client.PutRecord(ctx, listItemCollection, rkey, item))
+	}
+
+	w := &Worker{
+		log:        slog.Default(),
+		listClient: client,
+	}
+	desired := []string{"did:example:approved-1", "did:example:approved-2"}
+
+	// approved-2 has a recorded listitem which hasn't been written yet, so
+	// it should be added under that record key.
+	recorded := map[string]string{"did:example:approved-2": "5"}
+
+	items, err := client.ListRecords(ctx, listItemCollection)
+	require.NoError(t, err)
+	members, writes, err := w.reconcileListItems(ctx, furriesList, desired, items, recorded, maxListWritesPerReconcile)
+	require.NoError(t, err)
+	assert.Equal(t, 3, writes)
+	assert.ElementsMatch(t, desired, client.listSubjects(uri))
+	assert.ElementsMatch(t, []string{"did:example:banned"}, client.listSubjects(otherURI))
+	assert.Len(t, members, 2)
+	assert.Contains(t, []string{"2", "3"}, members["did:example:approved-1"])
+	assert.Equal(t, "5", members["did:example:approved-2"])
+	assert.Contains(t, client.records, fmt.Sprintf("at://%s/%s/5", client.did, listItemCollection))
+
+	// Reconciling again should be a no-op.
+	items, err = client.ListRecords(ctx, listItemCollection)
+	require.NoError(t, err)
+	again, writes, err := w.reconcileListItems(ctx, furriesList, desired, items, members, maxListWritesPerReconcile)
+	require.NoError(t, err)
+	assert.Equal(t, 0, writes)
+	assert.Equal(t, members, again)
+}
+
+func TestWorker_ReconcileListItems_WriteLimit(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	client := &mockRepoClient{
+		did:     "did:example:furrylist",
+		records: map[string]repo.CborMarshaler{},
+	}
+	w := &Worker{
+		log:        slog.Default(),
+		listClient: client,
+	}
+	desired := []string{"did:example:1", "did:example:2", "did:example:3"}
+
+	members, writes, err := w.reconcileListItems(ctx, furriesList, desired, nil, nil, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, writes)
+	assert.Len(t, client.listSubjects(listURI(client.did, furriesList.rkey)), 2)
+	assert.Len(t, members, 2)
+}
+
+func TestWorker_AddAndRemoveFromLists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	client := &mockRepoClient{
+		did:     "did:example:furrylist",
+		records: map[string]repo.CborMarshaler{},
+	}
+	w := &Worker{
+		log:        slog.Default(),
+		listClient: client,
+		listItems:  &fakeListItemStore{items: map[string]map[string]string{}},
+		lists:      []curatedList{furriesList, artistsList},
+	}
+	furriesURI := listURI(client.did, furriesList.rkey)
+	artistsURI := listURI(client.did, artistsList.rkey)
+
+	artist := &v1.Actor{
+		Did:      "did:example:artist",
+		Status:   v1.ActorStatus_ACTOR_STATUS_APPROVED,
+		IsArtist: true,
+	}
+	require.NoError(t, w.addActorToLists(ctx, artist))
+	// Adding again, e.g. on a retry or from a concurrent task, must not
+	// duplicate the listitems.
+	require.NoError(t, w.addActorToLists(ctx, artist))
+	require.NoError(t, w.addActorToLists(ctx, &v1.Actor{
+		Did:    "did:example:furry",
+		Status: v1.ActorStatus_ACTOR_STATUS_APPROVED,
+	}))
+	require.NoError(t, w.addActorToLists(ctx, &v1.Actor{
+		Did:    "did:example:banned",
+		Status: v1.ActorStatus_ACTOR_STATUS_BANNED,
+	}))
+	assert.ElementsMatch(t, []string{"did:example:artist", "did:example:furry"}, client.listSubjects(furriesURI))
+	assert.ElementsMatch(t, []string{"did:example:artist"}, client.listSubjects(artistsURI))
+
+	require.NoError(t, w.removeFromLists(ctx, "did:example:artist"))
+	assert.ElementsMatch(t, []string{"did:example:furry"}, client.listSubjects(furriesURI))
+	assert.Empty(t, client.listSubjects(artistsURI))
+}
@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+const (
+	// reclassifyNSFWPostsBatchSize is how many posts are reclassified in each
+	// query.
+	reclassifyNSFWPostsBatchSize = 1000
+	// reclassifyNSFWPostsBudget is how long a reclassification task runs
+	// before handing over to a new task, so that it finishes well within
+	// taskTimeout.
+	reclassifyNSFWPostsBudget = taskTimeout / 2
+)
+
+// reclassifyNSFWPosts reclassifies whether every post is NSFW, a batch at a
+// time. If it runs out of time, it enqueues another task to carry on from
+// where it stopped.
+func (w *Worker) reclassifyNSFWPosts(ctx context.Context, payload []byte) error {
+	p := store.ReclassifyNSFWPostsTaskPayload{}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("unmarshalling payload: %w", err)
+	}
+
+	start := time.Now()
+	afterURI := p.AfterURI
+	var total int64
+	for {
+		lastURI, reclassified, err := w.store.ReclassifyNSFWPosts(ctx, afterURI, reclassifyNSFWPostsBatchSize)
+		if err != nil {
+			return fmt.Errorf("reclassifying posts after %q: %w", afterURI, err)
+		}
+		total += reclassified
+		if lastURI == "" {
+			w.log.Info("reclassified nsfw posts", slog.Int64("reclassified", total))
+			return nil
+		}
+		afterURI = lastURI
+
+		if time.Since(start) > reclassifyNSFWPostsBudget {
+			_, err := w.store.EnqueueTask(ctx, store.EnqueueTaskOpts{
+				Type:    store.TaskTypeReclassifyNSFWPosts,
+				Payload: store.ReclassifyNSFWPostsTaskPayload{AfterURI: afterURI},
+			})
+			if err != nil {
+				return fmt.Errorf("enqueuing continuation: %w", err)
+			}
+			w.log.Info(
+				"reclassified nsfw posts, continuing in another task",
+				slog.Int64("reclassified", total),
+				slog.String("after_uri", afterURI),
+			)
+			return nil
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bffv1pb "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+	"github.com/strideynet/bsky-furry-feed/tristate"
+)
+
+func TestWorker_reclassifyNSFWPosts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+	w := &Worker{log: slog.Default(), store: harness.Store}
+
+	const actorDID = "did:plc:furry"
+	const labelledDID = "did:plc:labelled"
+	for _, did := range []string{actorDID, labelledDID} {
+		_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+			Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+			DID:    did,
+		})
+		require.NoError(t, err)
+	}
+	now := time.Now()
+	require.NoError(t, harness.Store.CreateLatestActorProfile(ctx, store.CreateLatestActorProfileOpts{
+		ActorDID:   labelledDID,
+		CommitCID:  "rev",
+		CreatedAt:  now,
+		IndexedAt:  now,
+		SelfLabels: []string{"porn"},
+	}))
+
+	posts := map[string]store.CreatePostOpts{
+		"nsfw":     {ActorDID: actorDID, Hashtags: []string{"furryart", "nsfw"}},
+		"labelled": {ActorDID: actorDID, SelfLabels: []string{"sexual"}},
+		"spicy":    {ActorDID: actorDID, Hashtags: []string{"spicy"}},
+		"sfw":      {ActorDID: actorDID, Hashtags: []string{"furryart"}},
+		"profile":  {ActorDID: labelledDID},
+	}
+	for rkey, opts := range posts {
+		opts.URI = "at://" + opts.ActorDID + "/app.bsky.feed.post/" + rkey
+		opts.CreatedAt = now
+		opts.IndexedAt = now
+		posts[rkey] = opts
+		require.NoError(t, harness.Store.CreatePost(ctx, opts))
+	}
+	assertNSFW := func(t *testing.T, want map[string]bool) {
+		t.Helper()
+		for rkey, nsfw := range want {
+			post, err := harness.Store.GetPostByURI(ctx, posts[rkey].URI)
+			require.NoError(t, err)
+			assert.Equal(t, nsfw, post.IsNSFW, rkey)
+		}
+	}
+
+	// Posts are classified as they're created.
+	assertNSFW(t, map[string]bool{
+		"nsfw":     true,
+		"labelled": true,
+		"spicy":    false,
+		"sfw":      false,
+		"profile":  true,
+	})
+
+	// Existing posts are reclassified once the rules change.
+	require.NoError(t, harness.Store.CreateNSFWRule(ctx, gen.NSFWRuleKindHashtag, "spicy"))
+	require.NoError(t, harness.Store.DeleteNSFWRule(ctx, gen.NSFWRuleKindProfileLabel, "porn"))
+	assertNSFW(t, map[string]bool{"spicy": false, "profile": true})
+	require.NoError(t, w.reclassifyNSFWPosts(ctx, []byte("{}")))
+	assertNSFW(t, map[string]bool{
+		"nsfw":     true,
+		"labelled": true,
+		"spicy":    true,
+		"sfw":      false,
+		"profile":  false,
+	})
+
+	// A moderator's override takes precedence over the rules.
+	setOverride := func(override tristate.Tristate) {
+		tx, err := harness.Store.TX(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback()
+		_, err = tx.SetActorNSFWOverride(ctx, actorDID, override)
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit(ctx))
+	}
+	setOverride(tristate.False)
+	assertNSFW(t, map[string]bool{"nsfw": false, "labelled": false, "sfw": false})
+	setOverride(tristate.True)
+	assertNSFW(t, map[string]bool{"nsfw": true, "labelled": true, "sfw": true})
+	setOverride(tristate.Maybe)
+	assertNSFW(t, map[string]bool{"nsfw": true, "labelled": true, "sfw": false})
+
+	// Posts are reclassified when the actor's self labels change, but not on
+	// other profile updates.
+	updateProfile := func(commitCID string, selfLabels ...string) {
+		require.NoError(t, harness.Store.CreateLatestActorProfile(ctx, store.CreateLatestActorProfileOpts{
+			ActorDID:   labelledDID,
+			CommitCID:  commitCID,
+			CreatedAt:  now,
+			IndexedAt:  now,
+			SelfLabels: selfLabels,
+		}))
+	}
+	updateProfile("rev2", "sexual", "porn")
+	assertNSFW(t, map[string]bool{"profile": true})
+	require.NoError(t, harness.Store.DeleteNSFWRule(ctx, gen.NSFWRuleKindProfileLabel, "sexual"))
+	updateProfile("rev3", "porn", "sexual")
+	assertNSFW(t, map[string]bool{"profile": true})
+	updateProfile("rev4", "porn")
+	assertNSFW(t, map[string]bool{"profile": false})
+}
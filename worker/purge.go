@@ -0,0 +1,66 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	v1 "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+)
+
+// purgeActor permanently deletes the data of an actor once the grace period
+// of their deletion has passed. If the actor was restored in the meantime,
+// nothing is deleted.
+func (w *Worker) purgeActor(ctx context.Context, payload []byte) error {
+	p := store.PurgeActorTaskPayload{}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("unmarshalling payload: %w", err)
+	}
+	if p.ActorDID == "" {
+		return fmt.Errorf("payload missing actor_did")
+	}
+	log := w.log.With(bfflog.ActorDID(p.ActorDID))
+
+	tx, err := w.store.TX(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.PurgeActorData(ctx, p.ActorDID, p.DeletedAt)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Info("actor was restored or already purged, skipping purge")
+			return nil
+		}
+		return fmt.Errorf("purging actor data: %w", err)
+	}
+
+	_, err = tx.CreateAuditEvent(ctx, store.CreateAuditEventOpts{
+		ActorDID:   p.ActorDID,
+		SubjectDID: p.ActorDID,
+		Payload: &v1.ActorDataPurgedAuditPayload{
+			Posts:   res.Posts,
+			Likes:   res.Likes,
+			Follows: res.Follows,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("creating audit event: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	log.Info(
+		"purged actor data",
+		slog.Int64("posts", res.Posts),
+		slog.Int64("likes", res.Likes),
+		slog.Int64("follows", res.Follows),
+	)
+	return nil
+}
@@ -0,0 +1,157 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bffv1pb "github.com/strideynet/bsky-furry-feed/proto/bff/v1"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/testenv"
+)
+
+func TestWorker_purgeActor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	harness := testenv.StartHarness(ctx, t)
+	w := &Worker{log: slog.Default(), store: harness.Store}
+
+	const actorDID = "did:plc:furry"
+	const otherDID = "did:plc:other"
+	for _, did := range []string{actorDID, otherDID} {
+		_, err := harness.Store.CreateActor(ctx, store.CreateActorOpts{
+			Status: bffv1pb.ActorStatus_ACTOR_STATUS_APPROVED,
+			DID:    did,
+		})
+		require.NoError(t, err)
+	}
+
+	now := time.Now()
+	postURI := "at://" + actorDID + "/app.bsky.feed.post/paws"
+	deletedPostURI := "at://" + actorDID + "/app.bsky.feed.post/deleted"
+	otherPostURI := "at://" + otherDID + "/app.bsky.feed.post/paws"
+	for uri, did := range map[string]string{
+		postURI:        actorDID,
+		deletedPostURI: actorDID,
+		otherPostURI:   otherDID,
+	} {
+		require.NoError(t, harness.Store.CreatePost(ctx, store.CreatePostOpts{
+			URI:       uri,
+			ActorDID:  did,
+			CreatedAt: now,
+			IndexedAt: now,
+		}))
+	}
+	require.NoError(t, harness.Store.DeletePost(ctx, store.DeletePostOpts{URI: deletedPostURI}))
+	require.NoError(t, harness.Store.CreateLike(ctx, store.CreateLikeOpts{
+		URI:        "at://" + actorDID + "/app.bsky.feed.like/1",
+		ActorDID:   actorDID,
+		SubjectURI: otherPostURI,
+		CreatedAt:  now,
+		IndexedAt:  now,
+	}))
+	require.NoError(t, harness.Store.CreateLatestActorProfile(ctx, store.CreateLatestActorProfileOpts{
+		ActorDID:    actorDID,
+		CommitCID:   "rev",
+		CreatedAt:   now,
+		IndexedAt:   now,
+		DisplayName: "Furry",
+	}))
+	_, err := harness.Store.UpdateActorHandle(ctx, actorDID, "furry.example.com", now)
+	require.NoError(t, err)
+	scored, err := harness.Store.RescoreClassicPostScores(ctx, now.Add(-time.Hour), 0.05)
+	require.NoError(t, err)
+	seq := scored.GenerationSeq
+
+	deleteData := func() *bffv1pb.Actor {
+		tx, err := harness.Store.TX(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback()
+		actor, err := tx.DeleteActorData(ctx, actorDID)
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit(ctx))
+		return actor
+	}
+	purge := func(deletedAt time.Time) {
+		payload, err := json.Marshal(store.PurgeActorTaskPayload{
+			ActorDID:  actorDID,
+			DeletedAt: deletedAt,
+		})
+		require.NoError(t, err)
+		require.NoError(t, w.purgeActor(ctx, payload))
+	}
+
+	// Deleting hides their data, but it can be restored.
+	firstDeletion := deleteData()
+	require.NotNil(t, firstDeletion.DeletedAt)
+	post, err := harness.Store.GetPostByURI(ctx, postURI)
+	require.NoError(t, err)
+	assert.True(t, post.DeletedAt.Valid)
+
+	tx, err := harness.Store.TX(ctx)
+	require.NoError(t, err)
+	_, err = tx.RestoreActorData(ctx, actorDID)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+	post, err = harness.Store.GetPostByURI(ctx, postURI)
+	require.NoError(t, err)
+	assert.False(t, post.DeletedAt.Valid)
+	// Posts they deleted themselves stay deleted.
+	post, err = harness.Store.GetPostByURI(ctx, deletedPostURI)
+	require.NoError(t, err)
+	assert.True(t, post.DeletedAt.Valid)
+
+	// The purge scheduled by the first deletion does nothing.
+	secondDeletion := deleteData()
+	purge(firstDeletion.DeletedAt.AsTime())
+	_, err = harness.Store.GetPostByURI(ctx, postURI)
+	require.NoError(t, err)
+
+	purge(secondDeletion.DeletedAt.AsTime())
+	for _, uri := range []string{postURI, deletedPostURI} {
+		_, err = harness.Store.GetPostByURI(ctx, uri)
+		assert.ErrorIs(t, err, store.ErrNotFound)
+	}
+	_, err = harness.Store.GetPostByURI(ctx, otherPostURI)
+	require.NoError(t, err)
+
+	actor, err := harness.Store.GetActorByDID(ctx, actorDID)
+	require.NoError(t, err)
+	assert.NotNil(t, actor.PurgedAt)
+	assert.Empty(t, actor.Handle)
+	handles, err := harness.Store.ListActorHandles(ctx, actorDID)
+	require.NoError(t, err)
+	assert.Empty(t, handles)
+	profiles, err := harness.Store.GetActorProfileHistory(ctx, actorDID)
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	// Only the other actor's post still has a score.
+	_, err = harness.Store.GetPostScore(ctx, postURI, "classic", seq)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	_, err = harness.Store.GetPostScore(ctx, otherPostURI, "classic", seq)
+	require.NoError(t, err)
+
+	events, err := harness.Store.ListAuditEvents(ctx, store.ListAuditEventsOpts{
+		FilterSubjectDID: actorDID,
+		FilterTypes:      []bffv1pb.AuditEventType{bffv1pb.AuditEventType_ACTOR_DATA_PURGED},
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	payload := &bffv1pb.ActorDataPurgedAuditPayload{}
+	require.NoError(t, events[0].Payload.UnmarshalTo(payload))
+	assert.Equal(t, int64(2), payload.Posts)
+	assert.Equal(t, int64(1), payload.Likes)
+
+	// Purging again is a no-op.
+	purge(secondDeletion.DeletedAt.AsTime())
+}
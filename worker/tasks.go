@@ -0,0 +1,240 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/strideynet/bsky-furry-feed/bfflog"
+	"github.com/strideynet/bsky-furry-feed/store"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+)
+
+const (
+	TaskTypeRefreshProfile   = "refresh_profile"
+	TaskTypeReconcileLists   = "reconcile_lists"
+	TaskTypeReconcileFollows = "reconcile_follows"
+	// TaskTypeBackfillActor replays an approved actor's recent records into
+	// the feeds.
+	TaskTypeBackfillActor = "backfill_actor"
+)
+
+const (
+	defaultTaskPollInterval = 30 * time.Second
+	// taskLease is how long a claimed task is reserved for a worker. If the
+	// worker dies, another may claim the task after this. It must exceed
+	// taskTimeout, so that a task is never run twice concurrently.
+	taskLease   = 10 * time.Minute
+	taskTimeout = 5 * time.Minute
+)
+
+var tasksGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bff_worker_tasks",
+	Help: "The number of tasks in the queue, by type and state (pending, running or failed).",
+}, []string{"type", "state"})
+
+var taskFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bff_worker_task_failures_total",
+	Help: "The total number of task attempts that have failed, by type.",
+}, []string{"type"})
+
+// TaskHandler processes a task given its JSON payload. If an error is returned,
+// the task will be retried with backoff until it runs out of tries.
+type TaskHandler func(ctx context.Context, payload []byte) error
+
+// RegisterTaskHandler registers the handler for a task type. A worker will
+// only claim tasks of types it has handlers for.
+func (w *Worker) RegisterTaskHandler(taskType string, h TaskHandler) {
+	if w.handlers == nil {
+		w.handlers = map[string]TaskHandler{}
+	}
+	w.handlers[taskType] = h
+}
+
+// actorTaskHandler adapts a function acting on a single actor to a
+// TaskHandler accepting a store.ActorTaskPayload.
+func actorTaskHandler(fn func(ctx context.Context, actorDID string) error) TaskHandler {
+	return func(ctx context.Context, payload []byte) error {
+		p := store.ActorTaskPayload{}
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return fmt.Errorf("unmarshalling payload: %w", err)
+		}
+		if p.ActorDID == "" {
+			return fmt.Errorf("payload missing actor_did")
+		}
+		return fn(ctx, p.ActorDID)
+	}
+}
+
+// Backoff is an exponential backoff policy for retrying failed tasks.
+type Backoff struct {
+	// Initial is the delay before the first retry. Defaults to 30 seconds.
+	Initial time.Duration
+	// Max caps the delay between retries. Defaults to one hour.
+	Max time.Duration
+}
+
+// Delay returns how long to wait before retrying a task which has been tried
+// the given number of times.
+func (b Backoff) Delay(tries int32) time.Duration {
+	initial := b.Initial
+	if initial == 0 {
+		initial = 30 * time.Second
+	}
+	maxDelay := b.Max
+	if maxDelay == 0 {
+		maxDelay = time.Hour
+	}
+
+	delay := initial
+	for i := int32(1); i < tries; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return min(delay, maxDelay)
+}
+
+// listenForTasks wakes the worker when a task is enqueued, re-establishing
+// the listener if the connection fails.
+func (w *Worker) listenForTasks(ctx context.Context, wake chan<- struct{}) {
+	for {
+		err := w.store.ListenForTasks(ctx, wake)
+		if ctx.Err() != nil {
+			return
+		}
+		w.log.Error("task listener failed, reconnecting", bfflog.Err(err))
+		// We may have missed notifications, so wake up the worker to check.
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+// enqueuePeriodicTask enqueues a task which takes no payload, unless one is
+// already pending. This allows several workers to run the same schedule.
+func (w *Worker) enqueuePeriodicTask(ctx context.Context, taskType string) {
+	_, err := w.store.EnqueueTask(ctx, store.EnqueueTaskOpts{
+		Type:      taskType,
+		Payload:   struct{}{},
+		DedupeKey: taskType,
+	})
+	if err != nil && !errors.Is(err, store.ErrTaskAlreadyPending) {
+		w.log.Error(
+			"failed to enqueue periodic task",
+			slog.String("task_type", taskType),
+			bfflog.Err(err),
+		)
+	}
+}
+
+// processTasks claims and runs tasks until no more are runnable.
+func (w *Worker) processTasks(ctx context.Context) {
+	types := slices.Sorted(maps.Keys(w.handlers))
+	for ctx.Err() == nil {
+		task, err := w.store.ClaimNextTask(ctx, types, taskLease)
+		if errors.Is(err, store.ErrNotFound) {
+			return
+		}
+		if err != nil {
+			w.log.Error("claiming task", bfflog.Err(err))
+			return
+		}
+		w.processTask(ctx, task)
+	}
+}
+
+func (w *Worker) processTask(ctx context.Context, task gen.Task) {
+	log := w.log.With(
+		slog.Int64("task_id", task.ID),
+		slog.String("task_type", task.Type),
+		slog.Int("tries", int(task.Tries)),
+	)
+
+	// A previous worker may have died whilst running the final try.
+	if task.Tries > task.MaxTries {
+		log.Warn("task exceeded max tries, moving to dead-letter")
+		if err := w.store.MarkTaskAsDead(ctx, task.ID, fmt.Errorf("exceeded max tries")); err != nil {
+			log.Error("failed to mark task as dead", bfflog.Err(err))
+		}
+		return
+	}
+
+	log.Info("processing task")
+	err := w.runTask(ctx, task)
+	if err == nil {
+		log.Info("processed task")
+		if err := w.store.MarkTaskAsDone(ctx, task.ID); err != nil {
+			log.Error("marking task as done", bfflog.Err(err))
+		}
+		return
+	}
+
+	taskFailures.WithLabelValues(task.Type).Inc()
+	if task.Tries >= task.MaxTries {
+		log.Error("task failed on final try, moving to dead-letter", bfflog.Err(err))
+		if err := w.store.MarkTaskAsDead(ctx, task.ID, err); err != nil {
+			log.Error("failed to mark task as dead", bfflog.Err(err))
+		}
+		return
+	}
+
+	retryAt := time.Now().Add(w.taskBackoff.Delay(task.Tries))
+	log.Error("failed to process task", slog.Time("retry_at", retryAt), bfflog.Err(err))
+	if err := w.store.MarkTaskAsErrored(ctx, task.ID, err, retryAt); err != nil {
+		log.Error("failed to mark task as errored", bfflog.Err(err))
+	}
+}
+
+func (w *Worker) runTask(ctx context.Context, task gen.Task) error {
+	h, ok := w.handlers[task.Type]
+	if !ok {
+		// Shouldn't happen, as we only claim tasks we have handlers for.
+		return fmt.Errorf("no handler registered for task type %q", task.Type)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+	return h(ctx, task.Payload)
+}
+
+// updateTaskMetrics refreshes the queue depth gauges from the database.
+func (w *Worker) updateTaskMetrics(ctx context.Context) {
+	counts, err := w.store.CountTasks(ctx)
+	if err != nil {
+		w.log.Error("failed to count tasks", bfflog.Err(err))
+		return
+	}
+
+	// Reset so that type and state combinations that have drained to zero
+	// are no longer reported.
+	tasksGauge.Reset()
+	for _, c := range counts {
+		tasksGauge.WithLabelValues(c.Type, taskMetricState(c)).Set(float64(c.Count))
+	}
+}
+
+func taskMetricState(c gen.CountTasksRow) string {
+	switch {
+	case c.Status == gen.TaskStatusDead:
+		return "failed"
+	case c.Running:
+		return "running"
+	default:
+		return "pending"
+	}
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/strideynet/bsky-furry-feed/store/gen"
+)
+
+func TestBackoff_Delay(t *testing.T) {
+	t.Parallel()
+
+	b := Backoff{Initial: time.Second, Max: 10 * time.Second}
+	tests := []struct {
+		tries int32
+		want  time.Duration
+	}{
+		{tries: 1, want: time.Second},
+		{tries: 2, want: 2 * time.Second},
+		{tries: 3, want: 4 * time.Second},
+		{tries: 4, want: 8 * time.Second},
+		{tries: 5, want: 10 * time.Second},
+		{tries: 50, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, b.Delay(tt.tries), "tries=%d", tt.tries)
+	}
+
+	assert.Equal(t, 30*time.Second, Backoff{}.Delay(1))
+	assert.Equal(t, time.Hour, Backoff{}.Delay(100))
+}
+
+func TestTaskMetricState(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "pending", taskMetricState(gen.CountTasksRow{Status: gen.TaskStatusPending}))
+	assert.Equal(t, "running", taskMetricState(gen.CountTasksRow{Status: gen.TaskStatusPending, Running: true}))
+	assert.Equal(t, "failed", taskMetricState(gen.CountTasksRow{Status: gen.TaskStatusDead}))
+}
@@ -9,12 +9,12 @@ import (
 
 	"github.com/bluesky-social/indigo/api/bsky"
 	"github.com/bluesky-social/indigo/xrpc"
-	"github.com/jackc/pgx/v5"
 	"github.com/strideynet/bsky-furry-feed/bfflog"
 	"github.com/strideynet/bsky-furry-feed/bluesky"
+	"github.com/strideynet/bsky-furry-feed/ingester"
 	"github.com/strideynet/bsky-furry-feed/store"
-	"github.com/strideynet/bsky-furry-feed/store/gen"
 	typegen "github.com/whyrusleeping/cbor-gen"
+	"golang.org/x/sync/errgroup"
 )
 
 type pdsClient interface {
@@ -32,6 +32,38 @@ type Worker struct {
 	pdsClient pdsClient
 	bgsClient bgsClient
 	store     *store.PGXStore
+
+	// backfiller replays the recent records of newly approved actors. If nil,
+	// backfill tasks are not handled.
+	backfiller *ingester.Backfiller
+
+	// listClient is used to mirror approved actors into curated lists, and
+	// to reconcile follows. If nil, neither is kept in sync.
+	listClient              listClient
+	listItems               listItemStore
+	lists                   []curatedList
+	listReconcileInterval   time.Duration
+	followReconcileInterval time.Duration
+
+	handlers     map[string]TaskHandler
+	taskBackoff  Backoff
+	pollInterval time.Duration
+	replicaID    string
+}
+
+type Opts struct {
+	// ArtistListEnabled additionally mirrors approved artists into their own
+	// curated list.
+	ArtistListEnabled       bool
+	ListReconcileInterval   time.Duration
+	FollowReconcileInterval time.Duration
+	// TaskBackoff controls how long to wait before retrying a failed task.
+	TaskBackoff Backoff
+	// ReplicaID identifies this replica in leader election. If set, the
+	// periodic reconciliation tasks are only enqueued whilst this replica is
+	// the leader, so the worker can be enabled on several replicas. Tasks are
+	// processed on every replica regardless.
+	ReplicaID string
 }
 
 func New(
@@ -40,6 +72,7 @@ func New(
 	pdsHost string,
 	bskyCredentials *bluesky.Credentials,
 	pgxStore *store.PGXStore,
+	opts Opts,
 ) (*Worker, error) {
 	client, err := bluesky.ClientFromCredentials(ctx, pdsHost, bskyCredentials)
 	if err != nil {
@@ -47,64 +80,142 @@ func New(
 	}
 	bgs := &bluesky.BGSClient{}
 
+	lists := []curatedList{furriesList}
+	if opts.ArtistListEnabled {
+		lists = append(lists, artistsList)
+	}
+
 	return &Worker{
-		log:       log,
-		pdsHost:   pdsHost,
-		pdsClient: client,
-		store:     pgxStore,
-		bgsClient: bgs,
+		log:                     log,
+		pdsHost:                 pdsHost,
+		pdsClient:               client,
+		store:                   pgxStore,
+		bgsClient:               bgs,
+		backfiller:              ingester.NewBackfiller(bfflog.ChildLogger(log, "backfiller"), pgxStore, bgs),
+		listClient:              client,
+		listItems:               pgxStore,
+		lists:                   lists,
+		listReconcileInterval:   opts.ListReconcileInterval,
+		followReconcileInterval: opts.FollowReconcileInterval,
+		taskBackoff:             opts.TaskBackoff,
+		replicaID:               opts.ReplicaID,
 	}, nil
 }
 
+// Run processes tasks until ctx is cancelled. Tasks are claimed with a lease,
+// so every replica processes them. Only the periodic reconciliation tasks are
+// enqueued by a single replica, the leader.
 func (w *Worker) Run(ctx context.Context) error {
-	ticker := time.NewTicker(time.Second * 5)
-	defer ticker.Stop()
+	w.registerDefaultTaskHandlers()
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		w.runTasks(ctx)
+		return nil
+	})
+	eg.Go(func() error {
+		if w.replicaID == "" {
+			return w.enqueuePeriodicTasks(ctx)
+		}
+		elector := store.NewLeaderElector(
+			w.log, w.store, "background_worker", store.LeaderElectorOpts{Holder: w.replicaID},
+		)
+		return elector.Run(ctx, w.enqueuePeriodicTasks)
+	})
+	return eg.Wait()
+}
 
+// runTasks processes tasks as they become runnable, until ctx is cancelled.
+func (w *Worker) runTasks(ctx context.Context) {
+	// Tasks are usually picked up as soon as they're enqueued via a
+	// notification. We still poll occasionally to pick up retries whose backoff
+	// has elapsed, and any notifications missed whilst reconnecting.
+	pollInterval := w.pollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultTaskPollInterval
+	}
+	poll := time.NewTicker(pollInterval)
+	defer poll.Stop()
+
+	wake := make(chan struct{}, 1)
+	listenDone := make(chan struct{})
+	go func() {
+		defer close(listenDone)
+		w.listenForTasks(ctx, wake)
+	}()
+	defer func() { <-listenDone }()
+
+	w.updateTaskMetrics(ctx)
 	for {
+		w.processTasks(ctx)
+
 		select {
 		case <-ctx.Done():
-			return nil
-		case <-ticker.C:
-			task, err := w.store.GetNextFollowTask(ctx)
-			if errors.Is(err, pgx.ErrNoRows) {
-				continue
-			}
-			if err != nil {
-				w.log.Error("loading task", bfflog.Err(err))
-				continue
-			}
-			log := w.log.With(
-				slog.Int64("task_id", task.ID),
-				bfflog.ActorDID(task.ActorDID),
-			)
-			log.Info("processing task")
-
-			err = w.runTask(ctx, task)
-			if err != nil {
-				log.Error("failed to process task", bfflog.Err(err))
-				err = w.store.MarkFollowTaskAsErrored(ctx, task.ID, err)
-				if err != nil {
-					log.Error("failed to mark task as errored", bfflog.Err(err))
-				}
-
-				continue
-			}
-
-			log.Info("processed task")
-			err = w.store.MarkFollowTaskAsDone(ctx, task.ID)
-			if err != nil {
-				log.Error("marking task as done", bfflog.Err(err))
-			}
+			return
+		case <-wake:
+		case <-poll.C:
+			w.updateTaskMetrics(ctx)
 		}
 	}
 }
 
-func (w *Worker) runTask(ctx context.Context, task gen.FollowTask) error {
-	if task.ShouldUnfollow {
-		return w.pdsClient.Unfollow(ctx, task.ActorDID)
+// enqueuePeriodicTasks enqueues the reconciliation tasks on their intervals,
+// until ctx is cancelled. Whichever replica claims them runs them.
+func (w *Worker) enqueuePeriodicTasks(ctx context.Context) error {
+	// A nil channel blocks forever, so reconciliation is skipped unless
+	// configured.
+	var listTicker, followTicker <-chan time.Time
+	if w.listClient != nil && w.listReconcileInterval > 0 {
+		t := time.NewTicker(w.listReconcileInterval)
+		defer t.Stop()
+		listTicker = t.C
+	}
+	if w.listClient != nil && w.followReconcileInterval > 0 {
+		t := time.NewTicker(w.followReconcileInterval)
+		defer t.Stop()
+		followTicker = t.C
 	}
 
-	return w.updateProfileAndFollow(ctx, task.ActorDID)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-listTicker:
+			w.enqueuePeriodicTask(ctx, TaskTypeReconcileLists)
+		case <-followTicker:
+			w.enqueuePeriodicTask(ctx, TaskTypeReconcileFollows)
+		}
+	}
+}
+
+func (w *Worker) registerDefaultTaskHandlers() {
+	defaults := map[string]TaskHandler{
+		store.TaskTypeFollow: actorTaskHandler(w.updateProfileAndFollow),
+		store.TaskTypeUnfollow: actorTaskHandler(func(ctx context.Context, did string) error {
+			return w.pdsClient.Unfollow(ctx, did)
+		}),
+		TaskTypeRefreshProfile:            actorTaskHandler(w.updateProfile),
+		store.TaskTypePurgeActor:          w.purgeActor,
+		store.TaskTypeReclassifyNSFWPosts: w.reclassifyNSFWPosts,
+	}
+	if w.backfiller != nil {
+		defaults[TaskTypeBackfillActor] = actorTaskHandler(w.backfiller.BackfillActor)
+	}
+	if w.listClient != nil {
+		defaults[store.TaskTypeAddToLists] = actorTaskHandler(w.addToLists)
+		defaults[store.TaskTypeRemoveFromLists] = actorTaskHandler(w.removeFromLists)
+		defaults[TaskTypeReconcileLists] = func(ctx context.Context, _ []byte) error {
+			return w.reconcileLists(ctx)
+		}
+		defaults[TaskTypeReconcileFollows] = func(ctx context.Context, _ []byte) error {
+			return w.reconcileFollows(ctx)
+		}
+	}
+	for taskType, h := range defaults {
+		if _, ok := w.handlers[taskType]; !ok {
+			w.RegisterTaskHandler(taskType, h)
+		}
+	}
 }
 
 func (w *Worker) updateProfileAndFollow(ctx context.Context, actorDid string) error {
@@ -142,6 +253,7 @@ func (w *Worker) updateProfile(ctx context.Context, actorDid string) error {
 
 	displayName := ""
 	description := ""
+	selfLabels := []string{}
 
 	if profile != nil {
 		if profile.DisplayName != nil {
@@ -151,6 +263,12 @@ func (w *Worker) updateProfile(ctx context.Context, actorDid string) error {
 		if profile.Description != nil {
 			description = *profile.Description
 		}
+
+		if profile.Labels != nil && profile.Labels.LabelDefs_SelfLabels != nil {
+			for _, label := range profile.Labels.LabelDefs_SelfLabels.Values {
+				selfLabels = append(selfLabels, label.Val)
+			}
+		}
 	}
 
 	if err := w.store.CreateLatestActorProfile(ctx, store.CreateLatestActorProfileOpts{
@@ -160,6 +278,7 @@ func (w *Worker) updateProfile(ctx context.Context, actorDid string) error {
 		IndexedAt:   time.Now(),
 		DisplayName: displayName,
 		Description: description,
+		SelfLabels:  selfLabels,
 	}); err != nil {
 		return fmt.Errorf("updating actor profile: %w", err)
 	}